@@ -0,0 +1,296 @@
+// Command abacctl administers a DBServer through its HTTP API: users,
+// devices, Rego policies, object-action hierarchies, table grants and
+// access requests, plus signed grant tokens for the /jwt flow.
+package main
+
+import (
+	"RemoteTestServer/pkg/dbclient"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"time"
+)
+
+const usage = `usage: abacctl [-server URL] [-token TOKEN] [-timeout DURATION] [-o table|json] COMMAND
+
+  user create -id ID [-password PWD] [-attrs JSON]
+  user update -id ID -attrs JSON
+  user get ID                      attributes over the defaults of the user's roles
+  user roles ID                    roles held directly or through groups
+  user list [-prefix P] [-attr-key K [-attr-value V]] [-limit N] [-cursor C]
+
+  device create -id ID -type TYPE [-actions A] [-token T] [-attrs JSON]
+  device get ID
+  device list [-prefix P] [-type TYPE] [-limit N] [-cursor C]
+
+  policy upload -ref REF FILE      create or replace a policy from a .rego file
+        replacing one needs its stored tests to pass (see test run)
+  policy diff -ref REF FILE        compare a local file with the stored policy; exits 1 if they differ
+  policy simulate [-ref REF FILE] [-user USER -attrs JSON] [-tuples USER:OBJ:ACTION[,...]] [-flipped]
+        report which decisions the file or the attributes would flip; nothing is stored;
+        needs -token to be an admin token
+  policy get REF
+  policy list [-prefix P] [-limit N] [-cursor C]
+
+  test set -ref REF -name NAME FILE   store a Rego test module with a policy
+  test list REF
+  test delete -ref REF -name NAME
+  test run -ref REF [FILE]         run the policy's tests, against FILE if given; exits 1 if any fail
+        test commands need -token to be an admin token
+
+  hierarchy set -obj OBJ -action ACTION -hierarchy H
+  hierarchy get OBJ ACTION
+  hierarchy list [-obj OBJ] [-action ACTION] [-limit N] [-cursor C]
+
+  object set -id ID [-parent P] [-kind K]   create or move an object in the object tree
+  object get ID                    the object with its policies and children
+  object list [-prefix P] [-parent P] [-kind K] [-root] [-limit N] [-cursor C]
+  object delete ID                 only objects without children
+  object attach -id ID -action ACTION -policies REF[,REF...]   replace the policies for an action
+  object chain ID ACTION           policies the object inherits, from the root down
+
+  grant allow -user USER -table TABLE (-days N | -until YYYY-MM-DD)
+  grant revoke -user USER -table TABLE
+  grant get USER TABLE
+  grant list [-user USER] [-table TABLE] [-limit N] [-cursor C]
+  grant history [-user USER] [-table TABLE] [-limit N] [-cursor C]   grants archived after expiring
+
+  request submit -user USER [-password PWD] -table TABLE -days N [-reason TEXT]
+        signs in as USER; the password defaults to $ABACCTL_PASSWORD
+  request get ID                   the request and its history
+  request list [-user USER] [-table TABLE] [-status pending|approved|rejected] [-limit N] [-cursor C]
+  request approve ID [-comment TEXT]
+  request reject ID [-comment TEXT]
+        approving and rejecting need -token to be a grant issuer token
+
+  role set -name NAME [-description D] [-attrs JSON] [-grant TABLE[:YYYY-MM-DD][,...]]
+        create or replace a role; grants without a date do not expire
+  role get NAME
+  role list [-prefix P] [-limit N] [-cursor C]
+  role delete NAME
+  role assign -role NAME (-user USER | -group GROUP)
+  role unassign -role NAME (-user USER | -group GROUP)
+
+  group add -group GROUP -user USER
+  group remove -group GROUP -user USER
+  group get GROUP
+
+  token issue -user USER -grant TABLE:RULE[,TABLE:RULE...] [-ttl DURATION] [-key KEY] [-submit]
+  token request -user USER -grant TABLE:RULE[,TABLE:RULE...] [-ttl DURATION] [-submit]
+        RULE is e.g. "allow 7 days", "allow always", "allow once" or "deny 1 day"
+        issue signs locally with the shared key; request has the server sign
+        within the limits of the grant issuer whose token is -token
+
+The server URL, API token and signing key default to $ABACCTL_SERVER,
+$ABACCTL_TOKEN and $ABACCTL_JWT_KEY.
+`
+
+// errUsage makes main print the usage text.
+var errUsage = errors.New("invalid usage")
+
+// errDiffers is returned by policy diff to exit 1 without a message.
+var errDiffers = errors.New("policies differ")
+
+// errTestsFailed is returned by test run to exit 1 once the report is out.
+var errTestsFailed = errors.New("policy tests failed")
+
+// cli carries the global flags into the commands.
+type cli struct {
+	client  dbclient.API
+	output  string
+	timeout time.Duration
+}
+
+func main() {
+	err := run(os.Args[1:])
+	switch {
+	case err == nil:
+	case errors.Is(err, errDiffers), errors.Is(err, errTestsFailed):
+		os.Exit(1)
+	case errors.Is(err, errUsage), errors.Is(err, flag.ErrHelp):
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	default:
+		fmt.Fprintf(os.Stderr, "abacctl: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("abacctl", flag.ContinueOnError)
+	fs.Usage = func() {}
+	server := fs.String("server", envOr("ABACCTL_SERVER", "http://localhost:3333"), "DBServer base URL")
+	token := fs.String("token", os.Getenv("ABACCTL_TOKEN"), "bearer token sent with every request")
+	timeout := fs.Duration("timeout", 30*time.Second, "per-command timeout")
+	output := fs.String("o", "table", "output format: table or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "table" && *output != "json" {
+		return fmt.Errorf("-o must be table or json, not %q", *output)
+	}
+	if fs.NArg() < 2 {
+		return errUsage
+	}
+
+	client, err := dbclient.New(dbclient.Config{BaseURL: *server, Token: *token, Timeout: *timeout})
+	if err != nil {
+		return err
+	}
+	c := &cli{client: client, output: *output, timeout: *timeout}
+
+	resource, verb, rest := fs.Arg(0), fs.Arg(1), fs.Args()[2:]
+	var cmd func(ctx context.Context, args []string) error
+	switch resource + " " + verb {
+	case "user create":
+		cmd = c.userCreate
+	case "user update":
+		cmd = c.userUpdate
+	case "user get":
+		cmd = c.userGet
+	case "user list":
+		cmd = c.userList
+	case "user roles":
+		cmd = c.userRoles
+	case "device create":
+		cmd = c.deviceCreate
+	case "device get":
+		cmd = c.deviceGet
+	case "device list":
+		cmd = c.deviceList
+	case "policy upload":
+		cmd = c.policyUpload
+	case "policy diff":
+		cmd = c.policyDiff
+	case "policy simulate":
+		cmd = c.policySimulate
+	case "policy get":
+		cmd = c.policyGet
+	case "policy list":
+		cmd = c.policyList
+	case "test set":
+		cmd = c.testSet
+	case "test list":
+		cmd = c.testList
+	case "test delete":
+		cmd = c.testDelete
+	case "test run":
+		cmd = c.testRun
+	case "hierarchy set":
+		cmd = c.hierarchySet
+	case "hierarchy get":
+		cmd = c.hierarchyGet
+	case "hierarchy list":
+		cmd = c.hierarchyList
+	case "object set":
+		cmd = c.objectSet
+	case "object get":
+		cmd = c.objectGet
+	case "object list":
+		cmd = c.objectList
+	case "object delete":
+		cmd = c.objectDelete
+	case "object attach":
+		cmd = c.objectAttach
+	case "object chain":
+		cmd = c.objectChain
+	case "grant allow":
+		cmd = c.grantAllow
+	case "grant revoke":
+		cmd = c.grantRevoke
+	case "grant get":
+		cmd = c.grantGet
+	case "grant list":
+		cmd = c.grantList
+	case "grant history":
+		cmd = c.grantHistory
+	case "request submit":
+		cmd = c.requestSubmit
+	case "request get":
+		cmd = c.requestGet
+	case "request list":
+		cmd = c.requestList
+	case "request approve":
+		cmd = c.requestDecide(true)
+	case "request reject":
+		cmd = c.requestDecide(false)
+	case "role set":
+		cmd = c.roleSet
+	case "role get":
+		cmd = c.roleGet
+	case "role list":
+		cmd = c.roleList
+	case "role delete":
+		cmd = c.roleDelete
+	case "role assign":
+		cmd = c.roleAssign(true)
+	case "role unassign":
+		cmd = c.roleAssign(false)
+	case "group add":
+		cmd = c.groupMember(true)
+	case "group remove":
+		cmd = c.groupMember(false)
+	case "group get":
+		cmd = c.groupGet
+	case "token issue":
+		cmd = c.tokenIssue
+	case "token request":
+		cmd = c.tokenRequest
+	default:
+		return errUsage
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return cmd(ctx, rest)
+}
+
+// subcommand returns a flag set for one command that reports errors
+// instead of exiting.
+func subcommand(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	return fs
+}
+
+// required fails when any of the named string flags is empty.
+func required(fs *flag.FlagSet, names ...string) error {
+	var missing []string
+	for _, name := range names {
+		if f := fs.Lookup(name); f == nil || f.Value.String() == "" {
+			missing = append(missing, "-"+name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s: missing %s", fs.Name(), strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// positional fails unless exactly n arguments follow the flags.
+func positional(fs *flag.FlagSet, n int, names string) error {
+	if fs.NArg() != n {
+		return fmt.Errorf("%s: expected %s", fs.Name(), names)
+	}
+	return nil
+}
+
+func envOr(key string, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// cliActor names the operator, e.g. as the issuer of a token, the way the
+// server's own subcommands name themselves in the audit log.
+func cliActor() string {
+	if u, err := user.Current(); err == nil {
+		return "cli:" + u.Username
+	}
+	return "cli"
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"RemoteTestServer/pkg/dbclient"
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestCLI returns a cli over an in-memory server, with its output
+// discarded for the rest of the test.
+func newTestCLI(t *testing.T) (*cli, *dbclient.Mock) {
+	t.Helper()
+	null, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = null, null
+	t.Cleanup(func() {
+		os.Stdout, os.Stderr = stdout, stderr
+		null.Close()
+	})
+	m := &dbclient.Mock{}
+	return &cli{client: m, output: "table", timeout: time.Second}, m
+}
+
+func TestParseTuple(t *testing.T) {
+	got, err := parseTuple("alice:db:orders:read")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Subject != "alice" || got.Object != "db:orders" || got.Action != "read" {
+		t.Errorf("tuple = %+v", got)
+	}
+	for _, bad := range []string{"alice", "alice:read", ":db:read", "alice:db:", "alice::"} {
+		if _, err := parseTuple(bad); err == nil {
+			t.Errorf("%q was accepted", bad)
+		}
+	}
+}
+
+func TestParseGrantSpec(t *testing.T) {
+	spec, err := parseGrantSpec("orders:allow 30 days")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.TableName != "orders" || spec.Decision != "allow" || spec.Days == nil || *spec.Days != 30 {
+		t.Errorf("spec = %+v", spec)
+	}
+	if spec, err := parseGrantSpec("orders:allow once"); err != nil || spec.Once == nil || !*spec.Once {
+		t.Errorf("allow once = %+v, %v", spec, err)
+	}
+	if spec, err := parseGrantSpec("orders:deny always"); err != nil || spec.Always == nil || spec.Decision != "deny" {
+		t.Errorf("deny always = %+v, %v", spec, err)
+	}
+	for _, bad := range []string{"orders", ":allow 3", "orders:allow", "orders:grant 3 days", "orders:allow -1 days", "orders:allow soon"} {
+		if _, err := parseGrantSpec(bad); err == nil {
+			t.Errorf("%q was accepted", bad)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := "package p\n\ndefault allow = false\n\nallow {\n  input.user == \"alice\"\n}\n"
+	b := "package p\n\ndefault allow = false\n\nallow {\n  input.user == \"bob\"\n}\n"
+	want := strings.Join([]string{
+		"--- server:p",
+		"+++ p.rego",
+		"@@ -3,5 +3,5 @@",
+		" default allow = false",
+		" ",
+		" allow {",
+		`-  input.user == "alice"`,
+		`+  input.user == "bob"`,
+		" }",
+		"",
+	}, "\n")
+	if got := unifiedDiff("server:p", "p.rego", a, b); got != want {
+		t.Errorf("diff:\n%s\nwant:\n%s", got, want)
+	}
+	if got := unifiedDiff("a", "b", "", "x\n"); !strings.HasSuffix(got, "@@ -1,0 +1,1 @@\n+x\n") {
+		t.Errorf("diff against an empty policy:\n%s", got)
+	}
+}
+
+func TestRequiredAndPositional(t *testing.T) {
+	fs := subcommand("grant allow")
+	fs.String("user", "", "")
+	fs.String("table", "", "")
+	if err := fs.Parse([]string{"-user", "alice", "extra"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := required(fs, "user", "table"); err == nil || err.Error() != "grant allow: missing -table" {
+		t.Errorf("required = %v", err)
+	}
+	if err := positional(fs, 1, "ID"); err != nil {
+		t.Errorf("positional = %v", err)
+	}
+	if err := positional(fs, 0, ""); err == nil {
+		t.Error("an extra argument was accepted")
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	newTestCLI(t)
+	for _, args := range [][]string{nil, {"user"}, {"user", "explode"}} {
+		if err := run(args); !errors.Is(err, errUsage) {
+			t.Errorf("run(%q) = %v, want errUsage", args, err)
+		}
+	}
+	if err := run([]string{"-o", "yaml", "user", "list"}); err == nil || errors.Is(err, errUsage) {
+		t.Errorf("-o yaml: %v", err)
+	}
+}
+
+// grant allow creates a missing grant and moves an existing one.
+func TestGrantAllow(t *testing.T) {
+	c, m := newTestCLI(t)
+	ctx := context.Background()
+	if err := c.grantAllow(ctx, []string{"-user", "alice", "-table", "orders", "-until", "2031-01-02"}); err != nil {
+		t.Fatal(err)
+	}
+	g, err := m.GetGrant(ctx, "alice", "orders")
+	if err != nil || g.DbAccessDate != "2031-01-02" {
+		t.Fatalf("grant = %+v, %v", g, err)
+	}
+	if err := c.grantAllow(ctx, []string{"-user", "alice", "-table", "orders", "-until", "2032-01-02"}); err != nil {
+		t.Fatal(err)
+	}
+	if g, _ := m.GetGrant(ctx, "alice", "orders"); g.DbAccessDate != "2032-01-02" {
+		t.Errorf("access date = %s after the second allow", g.DbAccessDate)
+	}
+	for _, args := range [][]string{
+		{"-user", "alice", "-table", "orders"},
+		{"-user", "alice", "-table", "orders", "-days", "3", "-until", "2031-01-02"},
+		{"-user", "alice", "-table", "orders", "-until", "tomorrow"},
+		{"-table", "orders", "-days", "3"},
+	} {
+		if err := c.grantAllow(ctx, args); err == nil {
+			t.Errorf("grant allow %q was accepted", args)
+		}
+	}
+}
+
+func TestPolicyDiffExitsWhenDifferent(t *testing.T) {
+	c, m := newTestCLI(t)
+	ctx := context.Background()
+	path := t.TempDir() + "/p.rego"
+	if err := os.WriteFile(path, []byte("package p\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.policyDiff(ctx, []string{"-ref", "p", path}); !errors.Is(err, errDiffers) {
+		t.Errorf("diff against a missing policy = %v, want errDiffers", err)
+	}
+	if _, err := m.CreatePolicy(ctx, dbclient.Policy{Ref: "p", Content: "package p\n"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.policyDiff(ctx, []string{"-ref", "p", path}); err != nil {
+		t.Errorf("diff against the same policy = %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"RemoteTestServer/pkg/dbclient"
+	"context"
+	"errors"
+	"strings"
+)
+
+var objectHeader = []string{"OBJECT", "PARENT", "KIND", "UPDATED_AT"}
+
+func objectRow(o dbclient.ObjectNode) []string {
+	return []string{o.ObjId, deref(o.ParentId), deref(o.Kind), deref(o.UpdatedAt)}
+}
+
+// objectSet creates an object or moves an existing one.
+func (c *cli) objectSet(ctx context.Context, args []string) error {
+	fs := subcommand("object set")
+	id := fs.String("id", "", "object id")
+	parent := fs.String("parent", "", "parent object; empty for a root")
+	kind := fs.String("kind", "", "e.g. building, floor, room or device")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "id"); err != nil {
+		return err
+	}
+	o := dbclient.ObjectNode{ObjId: *id}
+	if *parent != "" {
+		o.ParentId = parent
+	}
+	if *kind != "" {
+		o.Kind = kind
+	}
+	saved, err := c.client.UpdateObject(ctx, *id, o)
+	if errors.Is(err, dbclient.ErrNotFound) {
+		saved, err = c.client.CreateObject(ctx, o)
+	}
+	if err != nil {
+		return err
+	}
+	return c.showObject(saved)
+}
+
+func (c *cli) objectGet(ctx context.Context, args []string) error {
+	fs := subcommand("object get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 1, "ID"); err != nil {
+		return err
+	}
+	o, err := c.client.GetObject(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	return c.showObject(o)
+}
+
+func (c *cli) objectList(ctx context.Context, args []string) error {
+	fs := subcommand("object list")
+	opts := pageFlags(fs)
+	filter(fs, opts, "prefix", "prefix", "object id prefix")
+	filter(fs, opts, "parent", "parent_id", "only children of this object")
+	filter(fs, opts, "kind", "kind", "only objects of this kind")
+	root := fs.Bool("root", false, "only objects without a parent")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *root {
+		opts.Filters["root"] = "true"
+	}
+	page, err := c.client.ListObjects(ctx, *opts)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, o := range page.Items {
+		rows = append(rows, objectRow(o))
+	}
+	return c.showPage(page, objectHeader, rows, page.NextCursor)
+}
+
+func (c *cli) objectDelete(ctx context.Context, args []string) error {
+	fs := subcommand("object delete")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 1, "ID"); err != nil {
+		return err
+	}
+	if err := c.client.DeleteObject(ctx, fs.Arg(0)); err != nil {
+		return err
+	}
+	return c.written("deleted", 1)
+}
+
+// objectAttach replaces the policies attached to an object for one action.
+func (c *cli) objectAttach(ctx context.Context, args []string) error {
+	fs := subcommand("object attach")
+	id := fs.String("id", "", "object id")
+	action := fs.String("action", "", "action the policies apply to")
+	policies := fs.String("policies", "", "policy refs as REF[,REF...] in evaluation order; empty detaches all")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "id", "action"); err != nil {
+		return err
+	}
+	refs := []string{}
+	if *policies != "" {
+		refs = strings.Split(*policies, ",")
+	}
+	o, err := c.client.SetObjectPolicies(ctx, *id, *action, refs)
+	if err != nil {
+		return err
+	}
+	return c.showObject(o)
+}
+
+// objectChain prints the policies an object inherits for an action, one
+// row per object from the root down.
+func (c *cli) objectChain(ctx context.Context, args []string) error {
+	fs := subcommand("object chain")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 2, "ID ACTION"); err != nil {
+		return err
+	}
+	chain, err := c.client.GetPolicyChain(ctx, fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, link := range chain.Chain {
+		rows = append(rows, []string{link.ObjId, strings.Join(link.PolicyRefs, ",")})
+	}
+	return c.show(chain, []string{"OBJECT", "POLICIES"}, rows)
+}
+
+// showObject prints an object followed by its policies and children.
+func (c *cli) showObject(o *dbclient.ObjectNode) error {
+	if c.output == "json" {
+		return c.show(o, nil, nil)
+	}
+	rows := [][]string{objectRow(*o)}
+	if o.Policies != nil && len(*o.Policies) > 0 {
+		rows = append(rows, []string{}, []string{"ACTION", "POLICIES"})
+		for _, p := range *o.Policies {
+			rows = append(rows, []string{p.Action, strings.Join(p.PolicyRefs, ",")})
+		}
+	}
+	if o.Children != nil && len(*o.Children) > 0 {
+		rows = append(rows, []string{}, []string{"CHILDREN"})
+		for _, child := range *o.Children {
+			rows = append(rows, []string{child})
+		}
+	}
+	return c.show(o, objectHeader, rows)
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// show prints v as indented JSON with -o json, otherwise as a table of
+// header columns and rows.
+func (c *cli) show(v interface{}, header []string, rows [][]string) error {
+	if c.output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// showPage is show for one page of a list, with the cursor for the next.
+func (c *cli) showPage(v interface{}, header []string, rows [][]string, next *string) error {
+	if err := c.show(v, header, rows); err != nil {
+		return err
+	}
+	if c.output != "json" && next != nil {
+		fmt.Fprintf(os.Stderr, "more results: -cursor %s\n", *next)
+	}
+	return nil
+}
+
+// written reports the result of a write.
+func (c *cli) written(verb string, rows int64) error {
+	return c.show(map[string]interface{}{"result": verb, "rows": rows}, []string{"RESULT", "ROWS"},
+		[][]string{{verb, fmt.Sprint(rows)}})
+}
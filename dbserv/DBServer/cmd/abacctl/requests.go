@@ -0,0 +1,138 @@
+package main
+
+import (
+	"RemoteTestServer/pkg/dbclient"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+var requestHeader = []string{"ID", "USER", "TABLE", "DAYS", "STATUS", "DECIDED_BY", "CREATED_AT"}
+
+func requestRow(r dbclient.AccessRequest) []string {
+	return []string{strconv.FormatInt(r.Id, 10), r.UserId, r.TableName, strconv.Itoa(r.Days), string(r.Status), deref(r.DecidedBy), r.CreatedAt}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func (c *cli) requestSubmit(ctx context.Context, args []string) error {
+	fs := subcommand("request submit")
+	var req dbclient.NewAccessRequest
+	user := fs.String("user", "", "user asking for access")
+	password := fs.String("password", os.Getenv("ABACCTL_PASSWORD"), "the user's password")
+	fs.StringVar(&req.TableName, "table", "", "table name")
+	fs.IntVar(&req.Days, "days", 0, "days of access asked for")
+	reason := fs.String("reason", "", "why access is needed, shown to approvers")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "user", "table"); err != nil {
+		return err
+	}
+	if req.Days <= 0 {
+		return fmt.Errorf("request submit: -days must be positive")
+	}
+	if *reason != "" {
+		req.Reason = reason
+	}
+	r, err := c.client.SubmitAccessRequest(ctx, *user, *password, req)
+	if err != nil {
+		return err
+	}
+	return c.show(r, requestHeader, [][]string{requestRow(*r)})
+}
+
+func (c *cli) requestGet(ctx context.Context, args []string) error {
+	fs := subcommand("request get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	id, err := requestID(fs.Name(), fs.Args())
+	if err != nil {
+		return err
+	}
+	r, err := c.client.GetAccessRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+	return c.showRequest(r)
+}
+
+func (c *cli) requestList(ctx context.Context, args []string) error {
+	fs := subcommand("request list")
+	opts := pageFlags(fs)
+	filter(fs, opts, "user", "user_id", "user id")
+	filter(fs, opts, "table", "table_name", "table name")
+	filter(fs, opts, "status", "status", "pending, approved or rejected")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	page, err := c.client.ListAccessRequests(ctx, *opts)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, r := range page.Items {
+		rows = append(rows, requestRow(r))
+	}
+	return c.showPage(page, requestHeader, rows, page.NextCursor)
+}
+
+// requestDecide approves or rejects a request. The global -token must be a
+// grant issuer token whose limits cover the request.
+func (c *cli) requestDecide(approve bool) func(ctx context.Context, args []string) error {
+	name := "request reject"
+	if approve {
+		name = "request approve"
+	}
+	return func(ctx context.Context, args []string) error {
+		fs := subcommand(name)
+		comment := fs.String("comment", "", "note kept in the request's history")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		id, err := requestID(fs.Name(), fs.Args())
+		if err != nil {
+			return err
+		}
+		var r *dbclient.AccessRequest
+		if approve {
+			r, err = c.client.ApproveAccessRequest(ctx, id, *comment)
+		} else {
+			r, err = c.client.RejectAccessRequest(ctx, id, *comment)
+		}
+		if err != nil {
+			return err
+		}
+		return c.showRequest(r)
+	}
+}
+
+// showRequest prints a request followed by its history.
+func (c *cli) showRequest(r *dbclient.AccessRequest) error {
+	if c.output == "json" || r.History == nil {
+		return c.show(r, requestHeader, [][]string{requestRow(*r)})
+	}
+	rows := [][]string{requestRow(*r), {}, {"TS", "ACTOR", "ACTION", "COMMENT"}}
+	for _, ev := range *r.History {
+		rows = append(rows, []string{ev.Ts, ev.Actor, string(ev.Action), deref(ev.Comment)})
+	}
+	return c.show(r, requestHeader, rows)
+}
+
+func requestID(name string, args []string) (int64, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("%s: expected ID", name)
+	}
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%s: ID must be a positive integer", name)
+	}
+	return id, nil
+}
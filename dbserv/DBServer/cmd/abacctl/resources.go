@@ -0,0 +1,462 @@
+package main
+
+import (
+	"RemoteTestServer/pkg/dbclient"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// pageFlags adds -limit and -cursor to fs.
+func pageFlags(fs *flag.FlagSet) *dbclient.ListOptions {
+	opts := &dbclient.ListOptions{Filters: map[string]string{}}
+	fs.IntVar(&opts.Limit, "limit", 0, "page size")
+	fs.StringVar(&opts.Cursor, "cursor", "", "cursor printed by the previous page")
+	return opts
+}
+
+// filter adds a string flag whose value, when set, is sent as query
+// parameter param.
+func filter(fs *flag.FlagSet, opts *dbclient.ListOptions, name string, param string, usage string) {
+	fs.Func(name, usage, func(v string) error {
+		opts.Filters[param] = v
+		return nil
+	})
+}
+
+func (c *cli) userCreate(ctx context.Context, args []string) error {
+	fs := subcommand("user create")
+	var u dbclient.NewUser
+	fs.StringVar(&u.UserId, "id", "", "user id")
+	fs.StringVar(&u.Password, "password", "", "password")
+	fs.StringVar(&u.Attrs, "attrs", "{}", "attributes as a JSON object")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "id"); err != nil {
+		return err
+	}
+	rows, err := c.client.CreateUser(ctx, u)
+	if err != nil {
+		return err
+	}
+	return c.written("created", rows)
+}
+
+func (c *cli) userUpdate(ctx context.Context, args []string) error {
+	fs := subcommand("user update")
+	id := fs.String("id", "", "user id")
+	attrs := fs.String("attrs", "", "attributes as a JSON object, replacing the stored ones")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "id", "attrs"); err != nil {
+		return err
+	}
+	rows, err := c.client.UpdateUserAttrs(ctx, *id, *attrs)
+	if err != nil {
+		return err
+	}
+	return c.written("updated", rows)
+}
+
+func (c *cli) userGet(ctx context.Context, args []string) error {
+	fs := subcommand("user get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 1, "ID"); err != nil {
+		return err
+	}
+	u, err := c.client.GetUser(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	return c.show(u, []string{"USER", "ATTRS"}, [][]string{{u.UserId, u.Attrs}})
+}
+
+func (c *cli) userList(ctx context.Context, args []string) error {
+	fs := subcommand("user list")
+	opts := pageFlags(fs)
+	filter(fs, opts, "prefix", "prefix", "user id prefix")
+	filter(fs, opts, "attr-key", "attr_key", "only users with this attribute")
+	filter(fs, opts, "attr-value", "attr_value", "with -attr-key: only users whose attribute has this value")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	page, err := c.client.ListUsers(ctx, *opts)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, u := range page.Items {
+		rows = append(rows, []string{u.UserId, u.Attrs})
+	}
+	return c.showPage(page, []string{"USER", "ATTRS"}, rows, page.NextCursor)
+}
+
+func (c *cli) deviceCreate(ctx context.Context, args []string) error {
+	fs := subcommand("device create")
+	var d dbclient.NewDevice
+	fs.StringVar(&d.DevId, "id", "", "device id")
+	fs.StringVar(&d.DevType, "type", "", "device type")
+	fs.StringVar(&d.Action, "actions", "", "actions the device offers")
+	fs.StringVar(&d.Token, "token", "", "device token")
+	fs.StringVar(&d.Attrs, "attrs", "{}", "attributes as a JSON object")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "id", "type"); err != nil {
+		return err
+	}
+	rows, err := c.client.CreateDevice(ctx, d)
+	if err != nil {
+		return err
+	}
+	return c.written("created", rows)
+}
+
+func (c *cli) deviceGet(ctx context.Context, args []string) error {
+	fs := subcommand("device get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 1, "ID"); err != nil {
+		return err
+	}
+	d, err := c.client.GetDevice(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	actions, err := c.client.GetDeviceActions(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	v := map[string]string{"dev_id": d.DevId, "actions": actions.Actions, "attrs": d.Attrs}
+	return c.show(v, []string{"DEVICE", "ACTIONS", "ATTRS"}, [][]string{{d.DevId, actions.Actions, d.Attrs}})
+}
+
+func (c *cli) deviceList(ctx context.Context, args []string) error {
+	fs := subcommand("device list")
+	opts := pageFlags(fs)
+	filter(fs, opts, "prefix", "prefix", "device id prefix")
+	filter(fs, opts, "type", "dev_type", "device type")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	page, err := c.client.ListDevices(ctx, *opts)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, d := range page.Items {
+		rows = append(rows, []string{d.DevId, d.DevType, d.Actions, d.Attrs})
+	}
+	return c.showPage(page, []string{"DEVICE", "TYPE", "ACTIONS", "ATTRS"}, rows, page.NextCursor)
+}
+
+// readPolicyFile reads the file named by the single positional argument.
+func readPolicyFile(fs *flag.FlagSet) (string, error) {
+	if err := positional(fs, 1, "FILE"); err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (c *cli) policyUpload(ctx context.Context, args []string) error {
+	fs := subcommand("policy upload")
+	ref := fs.String("ref", "", "policy ref")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "ref"); err != nil {
+		return err
+	}
+	content, err := readPolicyFile(fs)
+	if err != nil {
+		return err
+	}
+
+	current, err := c.client.GetPolicy(ctx, *ref)
+	switch {
+	case errors.Is(err, dbclient.ErrNotFound):
+		rows, err := c.client.CreatePolicy(ctx, dbclient.Policy{Ref: *ref, Content: content})
+		if err != nil {
+			return err
+		}
+		return c.written("created", rows)
+	case err != nil:
+		return err
+	case current.Content == content:
+		return c.written("unchanged", 0)
+	}
+	rows, err := c.client.UpdatePolicy(ctx, *ref, content)
+	if err != nil {
+		return err
+	}
+	return c.written("updated", rows)
+}
+
+func (c *cli) policyDiff(ctx context.Context, args []string) error {
+	fs := subcommand("policy diff")
+	ref := fs.String("ref", "", "policy ref")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "ref"); err != nil {
+		return err
+	}
+	local, err := readPolicyFile(fs)
+	if err != nil {
+		return err
+	}
+	var stored string
+	current, err := c.client.GetPolicy(ctx, *ref)
+	switch {
+	case errors.Is(err, dbclient.ErrNotFound):
+	case err != nil:
+		return err
+	default:
+		stored = current.Content
+	}
+	if stored == local {
+		return nil
+	}
+	fmt.Print(unifiedDiff("server:"+*ref, fs.Arg(0), stored, local))
+	return errDiffers
+}
+
+func (c *cli) policyGet(ctx context.Context, args []string) error {
+	fs := subcommand("policy get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 1, "REF"); err != nil {
+		return err
+	}
+	p, err := c.client.GetPolicy(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if c.output == "json" {
+		return c.show(p, nil, nil)
+	}
+	// the Rego source is what an operator wants to see, not a table
+	fmt.Print(p.Content)
+	return nil
+}
+
+func (c *cli) policyList(ctx context.Context, args []string) error {
+	fs := subcommand("policy list")
+	opts := pageFlags(fs)
+	filter(fs, opts, "prefix", "prefix", "ref prefix")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	page, err := c.client.ListPolicies(ctx, *opts)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, p := range page.Items {
+		rows = append(rows, []string{p.Ref, fmt.Sprint(len(p.Content))})
+	}
+	return c.showPage(page, []string{"REF", "BYTES"}, rows, page.NextCursor)
+}
+
+func (c *cli) hierarchySet(ctx context.Context, args []string) error {
+	fs := subcommand("hierarchy set")
+	var h dbclient.Hierarchy
+	fs.StringVar(&h.ObjId, "obj", "", "object id")
+	fs.StringVar(&h.Action, "action", "", "action")
+	fs.StringVar(&h.Hierarchy, "hierarchy", "", "hierarchy of policy refs")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "obj", "action", "hierarchy"); err != nil {
+		return err
+	}
+	_, err := c.client.GetHierarchy(ctx, h.ObjId, h.Action)
+	if errors.Is(err, dbclient.ErrNotFound) {
+		rows, err := c.client.CreateHierarchy(ctx, h)
+		if err != nil {
+			return err
+		}
+		return c.written("created", rows)
+	}
+	if err != nil {
+		return err
+	}
+	rows, err := c.client.UpdateHierarchy(ctx, h.ObjId, h.Action, h.Hierarchy)
+	if err != nil {
+		return err
+	}
+	return c.written("updated", rows)
+}
+
+func (c *cli) hierarchyGet(ctx context.Context, args []string) error {
+	fs := subcommand("hierarchy get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 2, "OBJ ACTION"); err != nil {
+		return err
+	}
+	h, err := c.client.GetHierarchy(ctx, fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	return c.show(h, []string{"OBJECT", "ACTION", "HIERARCHY"}, [][]string{{h.ObjId, h.Action, h.Hierarchy}})
+}
+
+func (c *cli) hierarchyList(ctx context.Context, args []string) error {
+	fs := subcommand("hierarchy list")
+	opts := pageFlags(fs)
+	filter(fs, opts, "obj", "obj_id", "object id")
+	filter(fs, opts, "action", "action", "action")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	page, err := c.client.ListHierarchies(ctx, *opts)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, h := range page.Items {
+		rows = append(rows, []string{h.ObjId, h.Action, h.Hierarchy})
+	}
+	return c.showPage(page, []string{"OBJECT", "ACTION", "HIERARCHY"}, rows, page.NextCursor)
+}
+
+// setGrant moves a grant's access date, creating the grant if the user has
+// none for the table yet.
+func (c *cli) setGrant(ctx context.Context, user string, table string, access string, deny string) error {
+	_, err := c.client.GetGrant(ctx, user, table)
+	if errors.Is(err, dbclient.ErrNotFound) {
+		rows, err := c.client.CreateGrant(ctx, dbclient.Grant{UserId: user, TableName: table, DbAccessDate: access, DbDenyDate: deny})
+		if err != nil {
+			return err
+		}
+		return c.written("created", rows)
+	}
+	if err != nil {
+		return err
+	}
+	update := dbclient.GrantUpdate{DbAccessDate: &access}
+	if deny != "" {
+		update.DbDenyDate = &deny
+	}
+	rows, err := c.client.UpdateGrant(ctx, user, table, update)
+	if err != nil {
+		return err
+	}
+	return c.written("updated", rows)
+}
+
+// A user may read a table while today is before its access date, so
+// allowing moves that date forward and revoking moves it (and the deny
+// date) to today.
+func (c *cli) grantAllow(ctx context.Context, args []string) error {
+	fs := subcommand("grant allow")
+	user := fs.String("user", "", "user id")
+	table := fs.String("table", "", "table name")
+	days := fs.Int("days", 0, "allow for this many days from today")
+	until := fs.String("until", "", "allow until this date (YYYY-MM-DD)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "user", "table"); err != nil {
+		return err
+	}
+	var access string
+	switch {
+	case *until != "" && *days != 0:
+		return fmt.Errorf("grant allow: -days and -until are exclusive")
+	case *until != "":
+		if _, err := time.Parse(dateLayout, *until); err != nil {
+			return fmt.Errorf("grant allow: -until: %w", err)
+		}
+		access = *until
+	case *days > 0:
+		access = time.Now().AddDate(0, 0, *days).Format(dateLayout)
+	default:
+		return fmt.Errorf("grant allow: -days or -until is required")
+	}
+	return c.setGrant(ctx, *user, *table, access, time.Now().Format(dateLayout))
+}
+
+func (c *cli) grantRevoke(ctx context.Context, args []string) error {
+	fs := subcommand("grant revoke")
+	user := fs.String("user", "", "user id")
+	table := fs.String("table", "", "table name")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "user", "table"); err != nil {
+		return err
+	}
+	today := time.Now().Format(dateLayout)
+	return c.setGrant(ctx, *user, *table, today, today)
+}
+
+func (c *cli) grantGet(ctx context.Context, args []string) error {
+	fs := subcommand("grant get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 2, "USER TABLE"); err != nil {
+		return err
+	}
+	g, err := c.client.GetGrant(ctx, fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	return c.show(g, []string{"USER", "TABLE", "ACCESS_DATE", "DENY_DATE"}, [][]string{{g.UserId, g.TableName, g.DbAccessDate, g.DbDenyDate}})
+}
+
+func (c *cli) grantList(ctx context.Context, args []string) error {
+	fs := subcommand("grant list")
+	opts := pageFlags(fs)
+	filter(fs, opts, "user", "user_id", "user id")
+	filter(fs, opts, "table", "table_name", "table name")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	page, err := c.client.ListGrants(ctx, *opts)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, g := range page.Items {
+		rows = append(rows, []string{g.UserId, g.TableName, g.DbAccessDate, g.DbDenyDate})
+	}
+	return c.showPage(page, []string{"USER", "TABLE", "ACCESS_DATE", "DENY_DATE"}, rows, page.NextCursor)
+}
+
+func (c *cli) grantHistory(ctx context.Context, args []string) error {
+	fs := subcommand("grant history")
+	opts := pageFlags(fs)
+	filter(fs, opts, "user", "user_id", "user id")
+	filter(fs, opts, "table", "table_name", "table name")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	page, err := c.client.ListGrantHistory(ctx, *opts)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, g := range page.Items {
+		rows = append(rows, []string{g.UserId, g.TableName, g.DbAccessDate, g.DbDenyDate, g.ArchivedAt})
+	}
+	return c.showPage(page, []string{"USER", "TABLE", "ACCESS_DATE", "DENY_DATE", "ARCHIVED_AT"}, rows, page.NextCursor)
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"RemoteTestServer/pkg/dbclient"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var roleHeader = []string{"ROLE", "DESCRIPTION", "ATTRS", "UPDATED_AT"}
+
+func roleRow(r dbclient.Role) []string {
+	return []string{r.Name, deref(r.Description), deref(r.Attrs), deref(r.UpdatedAt)}
+}
+
+// roleSet creates a role or replaces an existing one's description,
+// attribute defaults and grants.
+func (c *cli) roleSet(ctx context.Context, args []string) error {
+	fs := subcommand("role set")
+	name := fs.String("name", "", "role name")
+	description := fs.String("description", "", "what the role is for")
+	attrs := fs.String("attrs", "", "JSON object of attribute defaults")
+	grant := fs.String("grant", "", "tables as TABLE[:YYYY-MM-DD][,...]; without a date the grant does not expire")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "name"); err != nil {
+		return err
+	}
+	r := dbclient.Role{Name: *name}
+	if *description != "" {
+		r.Description = description
+	}
+	if *attrs != "" {
+		r.Attrs = attrs
+	}
+	grants := []dbclient.RoleGrant{}
+	if *grant != "" {
+		for _, pair := range strings.Split(*grant, ",") {
+			table, until, dated := strings.Cut(pair, ":")
+			if table == "" {
+				return fmt.Errorf("role set: grant %q has no table", pair)
+			}
+			g := dbclient.RoleGrant{TableName: table}
+			if dated {
+				g.DbAccessDate = &until
+			}
+			grants = append(grants, g)
+		}
+	}
+	r.Grants = &grants
+
+	saved, err := c.client.UpdateRole(ctx, *name, r)
+	if errors.Is(err, dbclient.ErrNotFound) {
+		saved, err = c.client.CreateRole(ctx, r)
+	}
+	if err != nil {
+		return err
+	}
+	return c.showRole(saved)
+}
+
+func (c *cli) roleGet(ctx context.Context, args []string) error {
+	fs := subcommand("role get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 1, "NAME"); err != nil {
+		return err
+	}
+	r, err := c.client.GetRole(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	return c.showRole(r)
+}
+
+func (c *cli) roleList(ctx context.Context, args []string) error {
+	fs := subcommand("role list")
+	opts := pageFlags(fs)
+	filter(fs, opts, "prefix", "prefix", "role name prefix")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	page, err := c.client.ListRoles(ctx, *opts)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, r := range page.Items {
+		rows = append(rows, roleRow(r))
+	}
+	return c.showPage(page, roleHeader, rows, page.NextCursor)
+}
+
+func (c *cli) roleDelete(ctx context.Context, args []string) error {
+	fs := subcommand("role delete")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 1, "NAME"); err != nil {
+		return err
+	}
+	if err := c.client.DeleteRole(ctx, fs.Arg(0)); err != nil {
+		return err
+	}
+	return c.written("deleted", 1)
+}
+
+// roleAssign gives a role to, or with assign false takes it from, a user or
+// a group.
+func (c *cli) roleAssign(assign bool) func(ctx context.Context, args []string) error {
+	name := "role unassign"
+	if assign {
+		name = "role assign"
+	}
+	return func(ctx context.Context, args []string) error {
+		fs := subcommand(name)
+		role := fs.String("role", "", "role name")
+		user := fs.String("user", "", "user to assign")
+		group := fs.String("group", "", "group to assign")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if err := required(fs, "role"); err != nil {
+			return err
+		}
+		kind, id := dbclient.MemberUser, *user
+		switch {
+		case (*user == "") == (*group == ""):
+			return fmt.Errorf("%s: give one of -user and -group", name)
+		case *group != "":
+			kind, id = dbclient.MemberGroup, *group
+		}
+		var rows int64
+		var err error
+		if assign {
+			rows, err = c.client.AssignRole(ctx, *role, kind, id)
+		} else {
+			rows, err = c.client.UnassignRole(ctx, *role, kind, id)
+		}
+		if err != nil {
+			return err
+		}
+		return c.written(strings.TrimPrefix(name, "role "), rows)
+	}
+}
+
+// showRole prints a role followed by its grants and members.
+func (c *cli) showRole(r *dbclient.Role) error {
+	if c.output == "json" {
+		return c.show(r, nil, nil)
+	}
+	rows := [][]string{roleRow(*r)}
+	if r.Grants != nil && len(*r.Grants) > 0 {
+		rows = append(rows, []string{}, []string{"TABLE", "UNTIL"})
+		for _, g := range *r.Grants {
+			rows = append(rows, []string{g.TableName, deref(g.DbAccessDate)})
+		}
+	}
+	if r.Members != nil && len(*r.Members) > 0 {
+		rows = append(rows, []string{}, []string{"MEMBER", "KIND"})
+		for _, m := range *r.Members {
+			rows = append(rows, []string{m.Id, string(m.Kind)})
+		}
+	}
+	return c.show(r, roleHeader, rows)
+}
+
+// groupMember adds a user to, or with add false removes them from, a group.
+func (c *cli) groupMember(add bool) func(ctx context.Context, args []string) error {
+	name := "group remove"
+	if add {
+		name = "group add"
+	}
+	return func(ctx context.Context, args []string) error {
+		fs := subcommand(name)
+		group := fs.String("group", "", "group name")
+		user := fs.String("user", "", "user id")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if err := required(fs, "group", "user"); err != nil {
+			return err
+		}
+		var rows int64
+		var err error
+		if add {
+			rows, err = c.client.AddGroupMember(ctx, *group, *user)
+		} else {
+			rows, err = c.client.RemoveGroupMember(ctx, *group, *user)
+		}
+		if err != nil {
+			return err
+		}
+		return c.written(strings.TrimPrefix(name, "group "), rows)
+	}
+}
+
+func (c *cli) groupGet(ctx context.Context, args []string) error {
+	fs := subcommand("group get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 1, "GROUP"); err != nil {
+		return err
+	}
+	g, err := c.client.GetGroup(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, u := range g.Members {
+		rows = append(rows, []string{g.Group, u})
+	}
+	return c.show(g, []string{"GROUP", "USER"}, rows)
+}
+
+func (c *cli) userRoles(ctx context.Context, args []string) error {
+	fs := subcommand("user roles")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 1, "ID"); err != nil {
+		return err
+	}
+	roles, err := c.client.GetUserRoles(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, r := range roles {
+		rows = append(rows, []string{r.Role, r.Via})
+	}
+	return c.show(roles, []string{"ROLE", "VIA"}, rows)
+}
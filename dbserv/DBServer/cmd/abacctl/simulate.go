@@ -0,0 +1,84 @@
+package main
+
+import (
+	"RemoteTestServer/pkg/dbclient"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// policySimulate asks the server which decisions a policy file, a change to
+// a user's attributes, or both would flip. Nothing is stored.
+func (c *cli) policySimulate(ctx context.Context, args []string) error {
+	fs := subcommand("policy simulate")
+	ref := fs.String("ref", "", "policy ref the FILE argument would replace")
+	user := fs.String("user", "", "user whose attributes would change")
+	attrs := fs.String("attrs", "", "proposed attributes for -user as JSON")
+	tuples := fs.String("tuples", "", "decisions to try as USER:OBJECT:ACTION[,...]")
+	flipped := fs.Bool("flipped", false, "list only flipped and failed decisions")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	var req dbclient.SimulateRequest
+	if *ref != "" {
+		content, err := readPolicyFile(fs)
+		if err != nil {
+			return err
+		}
+		req.Policies = []dbclient.Policy{{Ref: *ref, Content: content}}
+	} else if fs.NArg() > 0 {
+		return fmt.Errorf("policy simulate: FILE needs -ref")
+	}
+	if (*user == "") != (*attrs == "") {
+		return fmt.Errorf("policy simulate: -user and -attrs go together")
+	}
+	if *user != "" {
+		req.Users = []dbclient.UserAttrs{{UserId: *user, Attrs: *attrs}}
+	}
+	if *tuples != "" {
+		for _, t := range strings.Split(*tuples, ",") {
+			tuple, err := parseTuple(t)
+			if err != nil {
+				return err
+			}
+			req.Tuples = append(req.Tuples, tuple)
+		}
+	}
+
+	report, err := c.client.Simulate(ctx, req, *flipped)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, r := range report.Results {
+		rows = append(rows, []string{r.Subject, r.Object, r.Action, str(r.Before), str(r.After),
+			strconv.FormatBool(r.Flipped), deref(r.Error)})
+	}
+	if err := c.show(report, []string{"SUBJECT", "OBJECT", "ACTION", "BEFORE", "AFTER", "FLIPPED", "ERROR"}, rows); err != nil {
+		return err
+	}
+	if c.output != "json" {
+		fmt.Fprintf(os.Stderr, "%d evaluated, %d flipped, %d errors\n", report.Evaluated, report.Flipped, report.Errors)
+	}
+	return nil
+}
+
+// parseTuple splits USER:OBJECT:ACTION on its first and last colons, so
+// object ids may contain colons.
+func parseTuple(s string) (dbclient.SimTuple, error) {
+	first, last := strings.Index(s, ":"), strings.LastIndex(s, ":")
+	if first <= 0 || last == first || last == len(s)-1 {
+		return dbclient.SimTuple{}, fmt.Errorf("tuple %q is not USER:OBJECT:ACTION", s)
+	}
+	return dbclient.SimTuple{Subject: s[:first], Object: s[first+1 : last], Action: s[last+1:]}, nil
+}
+
+// str prints an optional enum field.
+func str[T ~string](v *T) string {
+	if v == nil {
+		return ""
+	}
+	return string(*v)
+}
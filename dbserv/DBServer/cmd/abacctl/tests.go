@@ -0,0 +1,126 @@
+package main
+
+import (
+	"RemoteTestServer/pkg/dbclient"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// testSet stores a Rego test module with a policy.
+func (c *cli) testSet(ctx context.Context, args []string) error {
+	fs := subcommand("test set")
+	ref := fs.String("ref", "", "policy ref")
+	name := fs.String("name", "", "test name")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "ref", "name"); err != nil {
+		return err
+	}
+	content, err := readPolicyFile(fs)
+	if err != nil {
+		return err
+	}
+	t, err := c.client.PutPolicyTest(ctx, *ref, *name, content)
+	if err != nil {
+		return err
+	}
+	return c.show(t, []string{"POLICY", "TEST", "UPDATED_AT"}, [][]string{{*ref, deref(t.Name), deref(t.UpdatedAt)}})
+}
+
+func (c *cli) testList(ctx context.Context, args []string) error {
+	fs := subcommand("test list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := positional(fs, 1, "REF"); err != nil {
+		return err
+	}
+	tests, err := c.client.ListPolicyTests(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, t := range tests {
+		rows = append(rows, []string{deref(t.PolicyRef), deref(t.Name), deref(t.UpdatedAt)})
+	}
+	return c.show(tests, []string{"POLICY", "TEST", "UPDATED_AT"}, rows)
+}
+
+func (c *cli) testDelete(ctx context.Context, args []string) error {
+	fs := subcommand("test delete")
+	ref := fs.String("ref", "", "policy ref")
+	name := fs.String("name", "", "test name")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "ref", "name"); err != nil {
+		return err
+	}
+	if err := c.client.DeletePolicyTest(ctx, *ref, *name); err != nil {
+		return err
+	}
+	return c.written("deleted", 1)
+}
+
+// testRun runs a policy's tests, against FILE instead of the stored policy
+// when one is given, and exits 1 when they do not pass.
+func (c *cli) testRun(ctx context.Context, args []string) error {
+	fs := subcommand("test run")
+	ref := fs.String("ref", "", "policy ref")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "ref"); err != nil {
+		return err
+	}
+	var content string
+	if fs.NArg() > 0 {
+		var err error
+		if content, err = readPolicyFile(fs); err != nil {
+			return err
+		}
+	}
+	report, err := c.client.RunPolicyTests(ctx, *ref, content)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, r := range report.Results {
+		rows = append(rows, []string{r.Test, r.Package, r.Name, string(r.Result), deref(r.Error)})
+	}
+	if err := c.show(report, []string{"TEST", "PACKAGE", "RULE", "RESULT", "ERROR"}, rows); err != nil {
+		return err
+	}
+	if c.output != "json" {
+		if report.Error != nil {
+			fmt.Fprintf(os.Stderr, "tests could not run: %s\n", *report.Error)
+		} else {
+			fmt.Fprintf(os.Stderr, "%d/%d passed, coverage %s%%%s\n", report.Total-report.Failed, report.Total,
+				strconv.FormatFloat(float64(report.Coverage), 'f', 1, 32), notCovered(report.NotCovered))
+		}
+	}
+	if !report.Passed {
+		return errTestsFailed
+	}
+	return nil
+}
+
+// notCovered lists the policy lines no test reaches, e.g. " (not covered: 4-6, 9)".
+func notCovered(ranges *[]dbclient.LineRange) string {
+	if ranges == nil || len(*ranges) == 0 {
+		return ""
+	}
+	var spans []string
+	for _, r := range *ranges {
+		if r.Start == r.End {
+			spans = append(spans, strconv.Itoa(r.Start))
+		} else {
+			spans = append(spans, fmt.Sprintf("%d-%d", r.Start, r.End))
+		}
+	}
+	return " (not covered: " + strings.Join(spans, ", ") + ")"
+}
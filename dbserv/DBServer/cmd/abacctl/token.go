@@ -0,0 +1,135 @@
+package main
+
+import (
+	"RemoteTestServer/pkg/dbclient"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// tokenIssue signs a grant token in the form /jwt expects: HS256 with the
+// server's key, the user in "user" and the grants in "sub" as
+// table:rule pairs separated by commas.
+func (c *cli) tokenIssue(ctx context.Context, args []string) error {
+	fs := subcommand("token issue")
+	user := fs.String("user", "", "user the grant is for")
+	grant := fs.String("grant", "", `grants as TABLE:RULE[,TABLE:RULE...], e.g. "orders:allow 7 days"`)
+	ttl := fs.Duration("ttl", 10*time.Minute, "how long the token is valid")
+	key := fs.String("key", os.Getenv("ABACCTL_JWT_KEY"), "HS256 signing key shared with the server")
+	submit := fs.Bool("submit", false, "send the token to the server instead of printing it")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "user", "grant", "key"); err != nil {
+		return err
+	}
+	for _, pair := range strings.Split(*grant, ",") {
+		table, rule, ok := strings.Cut(pair, ":")
+		if !ok || table == "" || !(strings.Contains(rule, "allow") || strings.Contains(rule, "deny")) {
+			return fmt.Errorf("token issue: grant %q is not TABLE:allow ... or TABLE:deny ...", pair)
+		}
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"user": *user,
+		"sub":  *grant,
+		"iss":  cliActor(),
+		"iat":  now.Unix(),
+		"exp":  now.Add(*ttl).Unix(),
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(*key))
+	if err != nil {
+		return err
+	}
+
+	if *submit {
+		if err := c.client.SubmitGrantToken(ctx, signed); err != nil {
+			return err
+		}
+		return c.show(map[string]interface{}{"result": "submitted", "claims": claims}, []string{"RESULT", "USER", "GRANT"},
+			[][]string{{"submitted", *user, *grant}})
+	}
+	if c.output == "json" {
+		return c.show(map[string]interface{}{"token": signed, "claims": claims}, nil, nil)
+	}
+	// bare, so that it can be captured by a shell
+	fmt.Println(signed)
+	return nil
+}
+
+// tokenRequest has the server mint a grant token. The global -token must be
+// one of the server's grant issuer tokens.
+func (c *cli) tokenRequest(ctx context.Context, args []string) error {
+	fs := subcommand("token request")
+	user := fs.String("user", "", "user the grant is for")
+	grant := fs.String("grant", "", `grants as TABLE:RULE[,TABLE:RULE...], e.g. "orders:allow 7 days"`)
+	ttl := fs.Duration("ttl", 10*time.Minute, "how long the token is valid")
+	submit := fs.Bool("submit", false, "send the token to the server instead of printing it")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := required(fs, "user", "grant"); err != nil {
+		return err
+	}
+	req := dbclient.IssueGrantRequest{UserId: *user}
+	for _, pair := range strings.Split(*grant, ",") {
+		spec, err := parseGrantSpec(pair)
+		if err != nil {
+			return fmt.Errorf("token request: %v", err)
+		}
+		req.Grants = append(req.Grants, spec)
+	}
+	seconds := int(ttl.Seconds())
+	req.TtlSeconds = &seconds
+
+	issued, err := c.client.IssueGrantToken(ctx, req)
+	if err != nil {
+		return err
+	}
+	if *submit {
+		if err := c.client.SubmitGrantToken(ctx, issued.Token); err != nil {
+			return err
+		}
+		return c.show(map[string]interface{}{"result": "submitted", "jti": issued.Jti, "sub": issued.Sub}, []string{"RESULT", "USER", "GRANT", "JTI"},
+			[][]string{{"submitted", issued.UserId, issued.Sub, issued.Jti}})
+	}
+	if c.output == "json" {
+		return c.show(issued, nil, nil)
+	}
+	fmt.Println(issued.Token)
+	return nil
+}
+
+// parseGrantSpec reads TABLE:RULE, where RULE is "allow N days", "allow
+// always", "allow once" or "deny N days".
+func parseGrantSpec(pair string) (dbclient.GrantSpec, error) {
+	table, rule, ok := strings.Cut(pair, ":")
+	words := strings.Fields(rule)
+	if !ok || table == "" || len(words) < 2 {
+		return dbclient.GrantSpec{}, fmt.Errorf("grant %q is not TABLE:RULE", pair)
+	}
+	spec := dbclient.GrantSpec{TableName: table, Decision: dbclient.GrantSpecDecision(words[0])}
+	if words[0] != "allow" && words[0] != "deny" {
+		return dbclient.GrantSpec{}, fmt.Errorf("grant %q: rule must start with allow or deny", pair)
+	}
+	yes := true
+	switch {
+	case words[1] == "always":
+		spec.Always = &yes
+	case words[1] == "once":
+		spec.Once = &yes
+	default:
+		days, err := strconv.Atoi(words[1])
+		if err != nil || days < 0 {
+			return dbclient.GrantSpec{}, fmt.Errorf("grant %q: expected a number of days, always or once", pair)
+		}
+		spec.Days = &days
+	}
+	return spec, nil
+}
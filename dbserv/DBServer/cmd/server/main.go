@@ -2,17 +2,45 @@ package main
 
 import (
 	"RemoteTestServer/pkg/app"
+	"context"
+	"crypto/ed25519"
 	"database/sql"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"os/signal"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	_ "github.com/go-sql-driver/mysql"
 )
 
+const defaultConnectionString = "root:123456@tcp(localhost:3306)/abac"
+
+const usage = `usage:
+  server [serve] [-dsn DSN] [-grpc-addr ADDR] [-log-format text|json] [-log-level LEVEL] [-shutdown-timeout DURATION] [-faults FILE]
+         [-cache-ttl DURATION] [-cache-size N] [-webhooks FILE] [-jwt-key FILE] [-grant-issuers FILE] [-admin-tokens FILE]
+         [-sweep-interval DURATION] [-expiry-window-days N] [-archive-after-days N] [-opa PATH]
+         [-trace-exporter none|otlp|file] [-trace-target ADDR|FILE] [-audit-file FILE] [-audit-key FILE] [-audit-checkpoint-interval DURATION]
+  server import -entity NAME [-format jsonl|csv] [-dry-run] [-dsn DSN] [FILE]
+  server export -entity NAME [-format jsonl|csv] [-o FILE] [-dsn DSN]
+  server audit-keygen -o FILE
+  server verify-audit [-pubkey HEX | -audit-key FILE] [-dsn DSN]
+
+entities: %s
+FILE defaults to stdin/stdout; the format defaults to the file extension, else jsonl.
+`
+
 func main() {
-	if err := run(); err != nil {
+	if err := run(os.Args[1:]); err != nil {
 		_, err := fmt.Fprintf(os.Stderr, "this is the startup error: %s\\n", err)
 		if err != nil {
 			return
@@ -25,15 +53,82 @@ type memberRef struct {
 	ref string
 }
 
-// func run will be responsible for setting up db connections, routers etc
-func run() error {
+// func run will be responsible for dispatching subcommands, by default it starts the server
+func run(args []string) error {
+	cmd := "serve"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		cmd, args = args[0], args[1:]
+	}
+	switch cmd {
+	case "serve":
+		return serve(args)
+	case "import":
+		return importCmd(args)
+	case "export":
+		return exportCmd(args)
+	case "audit-keygen":
+		return auditKeygenCmd(args)
+	case "verify-audit":
+		return verifyAuditCmd(args)
+	default:
+		fmt.Fprintf(os.Stderr, usage, strings.Join(app.BulkEntityNames(), ", "))
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+// func serve will be responsible for setting up db connections, routers etc
+func serve(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
 	//// I'm used to working with postgres, but feel free to use any db you like. You just have to change the driver
 	//// I'm not going to cover how to create a database here but create a database
 	//// and call it something along the lines of "weight tracker"
-	connectionString := "root:123456@tcp(localhost:3306)/abac"
+	connectionString := fs.String("dsn", defaultConnectionString, "MySQL data source name")
+	var cfg app.Config
+	fs.StringVar(&cfg.AuditFile, "audit-file", "", "also append audit records to this JSON Lines file")
+	fs.StringVar(&cfg.AuditKeyFile, "audit-key", "", "file with the hex ed25519 seed used to sign audit checkpoints")
+	fs.DurationVar(&cfg.CacheTTL, "cache-ttl", 30*time.Second, "how long policies, hierarchies and user attributes stay cached")
+	fs.IntVar(&cfg.CacheSize, "cache-size", 1000, "entries per cache, 0 disables caching")
+	fs.StringVar(&cfg.GRPCAddr, "grpc-addr", "", "listen address of the gRPC API, which needs -admin-tokens; off when empty")
+	fs.StringVar(&cfg.WebhookFile, "webhooks", "", "JSON list of endpoints notified when grants extend or deny access")
+	fs.StringVar(&cfg.JWTKeyFile, "jwt-key", "", "file with the HS256 key grant tokens are signed and verified with")
+	fs.StringVar(&cfg.GrantIssuerFile, "grant-issuers", "", "JSON list of callers allowed to issue grant tokens, and their limits")
+	fs.StringVar(&cfg.AdminTokenFile, "admin-tokens", "", "JSON list of bearer token hashes allowed to use the admin routes")
+	fs.DurationVar(&cfg.SweepInterval, "sweep-interval", time.Hour, "how often to announce expiring grants and archive expired ones, 0 disables it")
+	fs.IntVar(&cfg.ExpiryWindowDays, "expiry-window-days", 7, "announce grants this many days before they expire")
+	fs.IntVar(&cfg.ArchiveAfterDays, "archive-after-days", 30, "archive grants this many days after they expired")
+	fs.StringVar(&cfg.OPABinary, "opa", "opa", "opa executable used to evaluate Rego for simulations")
+	fs.StringVar(&cfg.FaultFile, "faults", "", "JSON fault injection rules for load and reordering experiments")
+	fs.DurationVar(&cfg.ShutdownTimeout, "shutdown-timeout", 15*time.Second, "how long to drain in-flight requests on SIGINT/SIGTERM")
+	fs.DurationVar(&cfg.AuditCheckpointInterval, "audit-checkpoint-interval", time.Hour, "how often to sign the audit chain head")
+	logFormat := fs.String("log-format", "text", "log output format: text or json")
+	logLevel := fs.String("log-level", "info", "minimum log level: debug, info, warn or error")
+	traceExporter := fs.String("trace-exporter", app.TraceNone, "where to send trace spans: none, otlp or file")
+	traceTarget := fs.String("trace-target", "", "OTLP/HTTP collector host:port, or the file for -trace-exporter=file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	logger, err := app.NewLogger(os.Stderr, *logFormat, *logLevel)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+	cfg.Logger = logger
+
+	shutdownTracing, err := app.SetupTracing(context.Background(), *traceExporter, *traceTarget)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Error("flushing trace spans", "err", err)
+		}
+	}()
 
 	// setup database connection
-	db, err := setupSQLDatabase("mysql", connectionString)
+	db, err := setupSQLDatabase("mysql", *connectionString)
 
 	if err != nil {
 		return err
@@ -46,17 +141,202 @@ func run() error {
 		}
 	}(db)
 
-	router := gin.Default()
-	router.Use(cors.Default())
+	if err := app.Migrate(context.Background(), db); err != nil {
+		return err
+	}
+
+	// requests are logged by the server's AccessLog middleware
+	router := gin.New()
+	router.Use(gin.Recovery(), cors.Default())
+
+	server, err := app.NewServer(router, db, cfg)
+	if err != nil {
+		return err
+	}
+	defer server.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return server.Run(ctx)
+}
+
+// formatFor picks the bulk format from an explicit flag or the file extension.
+func formatFor(format string, path string) string {
+	if format != "" {
+		return format
+	}
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return app.FormatCSV
+	}
+	return app.FormatJSONL
+}
+
+func importCmd(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	connectionString := fs.String("dsn", defaultConnectionString, "MySQL data source name")
+	entity := fs.String("entity", "", "dataset to import: "+strings.Join(app.BulkEntityNames(), ", "))
+	format := fs.String("format", "", "jsonl or csv")
+	dryRun := fs.Bool("dry-run", false, "validate and roll back instead of committing")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var in io.Reader = os.Stdin
+	path := fs.Arg(0)
+	if path != "" && path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
 
-	server := app.NewServer(router, db)
-	err = server.Run()
+	db, err := setupSQLDatabase("mysql", *connectionString)
 	if err != nil {
 		return err
 	}
+	defer db.Close()
+
+	auditor, err := app.NewAuditor(db, "")
+	if err != nil {
+		return err
+	}
+	ctx := app.WithActor(context.Background(), cliActor())
+	report, err := app.ImportDataset(ctx, db, auditor, *entity, formatFor(*format, path), in, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	out := json.NewEncoder(os.Stdout)
+	out.SetIndent("", "  ")
+	if err := out.Encode(report); err != nil {
+		return err
+	}
+	if report.Failed > 0 {
+		return fmt.Errorf("%d of %d rows failed, nothing was imported", report.Failed, report.Total)
+	}
 	return nil
 }
 
+func exportCmd(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	connectionString := fs.String("dsn", defaultConnectionString, "MySQL data source name")
+	entity := fs.String("entity", "", "dataset to export: "+strings.Join(app.BulkEntityNames(), ", "))
+	format := fs.String("format", "", "jsonl or csv")
+	output := fs.String("o", "", "output file, stdout if empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if *output != "" && *output != "-" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	db, err := setupSQLDatabase("mysql", *connectionString)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	count, err := app.ExportDataset(context.Background(), db, *entity, formatFor(*format, *output), out, true)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "exported %d %s\n", count, *entity)
+	return nil
+}
+
+func auditKeygenCmd(args []string) error {
+	fs := flag.NewFlagSet("audit-keygen", flag.ContinueOnError)
+	output := fs.String("o", "", "file to write the private seed to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output == "" {
+		return fmt.Errorf("-o is required")
+	}
+	seed, pub, err := app.GenerateAuditKey()
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(*output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(f, seed); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Println(pub)
+	return nil
+}
+
+func verifyAuditCmd(args []string) error {
+	fs := flag.NewFlagSet("verify-audit", flag.ContinueOnError)
+	connectionString := fs.String("dsn", defaultConnectionString, "MySQL data source name")
+	pubHex := fs.String("pubkey", "", "hex ed25519 public key that signed the checkpoints")
+	keyFile := fs.String("audit-key", "", "derive the public key from this seed file instead")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var pub ed25519.PublicKey
+	switch {
+	case *pubHex != "":
+		p, err := app.ParseAuditPublicKey(*pubHex)
+		if err != nil {
+			return err
+		}
+		pub = p
+	case *keyFile != "":
+		key, err := app.LoadAuditKey(*keyFile)
+		if err != nil {
+			return err
+		}
+		pub = key.Public().(ed25519.PublicKey)
+	default:
+		fmt.Fprintln(os.Stderr, "no public key given, checkpoint signatures are not checked")
+	}
+
+	db, err := setupSQLDatabase("mysql", *connectionString)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	report, err := app.VerifyAuditChain(context.Background(), db, pub)
+	if err != nil {
+		return err
+	}
+	out := json.NewEncoder(os.Stdout)
+	out.SetIndent("", "  ")
+	if err := out.Encode(report); err != nil {
+		return err
+	}
+	if !report.Ok {
+		return fmt.Errorf("audit chain broken at record %d: %s", report.Broken_at, report.Reason)
+	}
+	return nil
+}
+
+// cliActor names the local operator in audit records written by subcommands.
+func cliActor() string {
+	if u, err := user.Current(); err == nil {
+		return "cli:" + u.Username
+	}
+	return "cli"
+}
+
 func setupSQLDatabase(driverName string, connString string) (*sql.DB, error) {
 	// change "postgres" for whatever supported database you want to use
 	db, err := sql.Open(driverName, connString)
@@ -0,0 +1,1756 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: abac.proto
+
+// The gRPC face of DBServer. Every call is served by the same code as the
+// matching HTTP endpoint, so reads go through the same caches, permission
+// checks and audit log, and writes publish the same change events.
+//
+// Metadata understood by the server: x-request-id (as the HTTP header),
+// x-cache-bypass on Get calls. Get calls answer with an x-cache
+// header of HIT, MISS or BYPASS.
+
+package abacpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListRequest mirrors the query parameters of the /list_* endpoints.
+// filters takes the same names, e.g. prefix, dev_type or expires_before.
+type ListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort          string                 `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string                 `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Filters       map[string]string      `protobuf:"bytes,5,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_abac_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRequest) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type WriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          int64                  `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteResponse) Reset() {
+	*x = WriteResponse{}
+	mi := &file_abac_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteResponse) ProtoMessage() {}
+
+func (x *WriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
+func (*WriteResponse) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WriteResponse) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type Policy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ref           string                 `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Policy) Reset() {
+	*x = Policy{}
+	mi := &file_abac_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policy) ProtoMessage() {}
+
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Policy) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Policy) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type GetPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ref           string                 `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPolicyRequest) Reset() {
+	*x = GetPolicyRequest{}
+	mi := &file_abac_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPolicyRequest) ProtoMessage() {}
+
+func (x *GetPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPolicyRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+type ListPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Policy              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoliciesResponse) Reset() {
+	*x = ListPoliciesResponse{}
+	mi := &file_abac_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoliciesResponse) ProtoMessage() {}
+
+func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPoliciesResponse) GetItems() []*Policy {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListPoliciesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type Hierarchy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjId         string                 `protobuf:"bytes,1,opt,name=obj_id,json=objId,proto3" json:"obj_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Hierarchy     string                 `protobuf:"bytes,3,opt,name=hierarchy,proto3" json:"hierarchy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hierarchy) Reset() {
+	*x = Hierarchy{}
+	mi := &file_abac_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hierarchy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hierarchy) ProtoMessage() {}
+
+func (x *Hierarchy) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hierarchy.ProtoReflect.Descriptor instead.
+func (*Hierarchy) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Hierarchy) GetObjId() string {
+	if x != nil {
+		return x.ObjId
+	}
+	return ""
+}
+
+func (x *Hierarchy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Hierarchy) GetHierarchy() string {
+	if x != nil {
+		return x.Hierarchy
+	}
+	return ""
+}
+
+type GetHierarchyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjId         string                 `protobuf:"bytes,1,opt,name=obj_id,json=objId,proto3" json:"obj_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHierarchyRequest) Reset() {
+	*x = GetHierarchyRequest{}
+	mi := &file_abac_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHierarchyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHierarchyRequest) ProtoMessage() {}
+
+func (x *GetHierarchyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHierarchyRequest.ProtoReflect.Descriptor instead.
+func (*GetHierarchyRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetHierarchyRequest) GetObjId() string {
+	if x != nil {
+		return x.ObjId
+	}
+	return ""
+}
+
+func (x *GetHierarchyRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type ListHierarchiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Hierarchy           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHierarchiesResponse) Reset() {
+	*x = ListHierarchiesResponse{}
+	mi := &file_abac_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHierarchiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHierarchiesResponse) ProtoMessage() {}
+
+func (x *ListHierarchiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHierarchiesResponse.ProtoReflect.Descriptor instead.
+func (*ListHierarchiesResponse) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListHierarchiesResponse) GetItems() []*Hierarchy {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListHierarchiesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UserAttrs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Attrs         string                 `protobuf:"bytes,2,opt,name=attrs,proto3" json:"attrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserAttrs) Reset() {
+	*x = UserAttrs{}
+	mi := &file_abac_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAttrs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAttrs) ProtoMessage() {}
+
+func (x *UserAttrs) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAttrs.ProtoReflect.Descriptor instead.
+func (*UserAttrs) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserAttrs) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserAttrs) GetAttrs() string {
+	if x != nil {
+		return x.Attrs
+	}
+	return ""
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_abac_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Attrs         string                 `protobuf:"bytes,3,opt,name=attrs,proto3" json:"attrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_abac_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetAttrs() string {
+	if x != nil {
+		return x.Attrs
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*UserAttrs           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_abac_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListUsersResponse) GetItems() []*UserAttrs {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type Device struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevId         string                 `protobuf:"bytes,1,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	DevType       string                 `protobuf:"bytes,2,opt,name=dev_type,json=devType,proto3" json:"dev_type,omitempty"`
+	Actions       string                 `protobuf:"bytes,3,opt,name=actions,proto3" json:"actions,omitempty"`
+	Attrs         string                 `protobuf:"bytes,4,opt,name=attrs,proto3" json:"attrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_abac_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Device) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *Device) GetDevType() string {
+	if x != nil {
+		return x.DevType
+	}
+	return ""
+}
+
+func (x *Device) GetActions() string {
+	if x != nil {
+		return x.Actions
+	}
+	return ""
+}
+
+func (x *Device) GetAttrs() string {
+	if x != nil {
+		return x.Attrs
+	}
+	return ""
+}
+
+type DeviceAttrs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevId         string                 `protobuf:"bytes,1,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	Attrs         string                 `protobuf:"bytes,2,opt,name=attrs,proto3" json:"attrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceAttrs) Reset() {
+	*x = DeviceAttrs{}
+	mi := &file_abac_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceAttrs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceAttrs) ProtoMessage() {}
+
+func (x *DeviceAttrs) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceAttrs.ProtoReflect.Descriptor instead.
+func (*DeviceAttrs) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeviceAttrs) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *DeviceAttrs) GetAttrs() string {
+	if x != nil {
+		return x.Attrs
+	}
+	return ""
+}
+
+type DeviceActions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevId         string                 `protobuf:"bytes,1,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	Actions       string                 `protobuf:"bytes,2,opt,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceActions) Reset() {
+	*x = DeviceActions{}
+	mi := &file_abac_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceActions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceActions) ProtoMessage() {}
+
+func (x *DeviceActions) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceActions.ProtoReflect.Descriptor instead.
+func (*DeviceActions) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeviceActions) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *DeviceActions) GetActions() string {
+	if x != nil {
+		return x.Actions
+	}
+	return ""
+}
+
+type GetDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevId         string                 `protobuf:"bytes,1,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceRequest) Reset() {
+	*x = GetDeviceRequest{}
+	mi := &file_abac_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceRequest) ProtoMessage() {}
+
+func (x *GetDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetDeviceRequest) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+type CreateDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DevId         string                 `protobuf:"bytes,1,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	DevType       string                 `protobuf:"bytes,2,opt,name=dev_type,json=devType,proto3" json:"dev_type,omitempty"`
+	Actions       string                 `protobuf:"bytes,3,opt,name=actions,proto3" json:"actions,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Attrs         string                 `protobuf:"bytes,5,opt,name=attrs,proto3" json:"attrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDeviceRequest) Reset() {
+	*x = CreateDeviceRequest{}
+	mi := &file_abac_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeviceRequest) ProtoMessage() {}
+
+func (x *CreateDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeviceRequest.ProtoReflect.Descriptor instead.
+func (*CreateDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateDeviceRequest) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *CreateDeviceRequest) GetDevType() string {
+	if x != nil {
+		return x.DevType
+	}
+	return ""
+}
+
+func (x *CreateDeviceRequest) GetActions() string {
+	if x != nil {
+		return x.Actions
+	}
+	return ""
+}
+
+func (x *CreateDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateDeviceRequest) GetAttrs() string {
+	if x != nil {
+		return x.Attrs
+	}
+	return ""
+}
+
+type ListDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Device              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	mi := &file_abac_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListDevicesResponse) GetItems() []*Device {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListDevicesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Dates are YYYY-MM-DD.
+type Access struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableName     string                 `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	DbAccessDate  string                 `protobuf:"bytes,3,opt,name=db_access_date,json=dbAccessDate,proto3" json:"db_access_date,omitempty"`
+	DbDenyDate    string                 `protobuf:"bytes,4,opt,name=db_deny_date,json=dbDenyDate,proto3" json:"db_deny_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Access) Reset() {
+	*x = Access{}
+	mi := &file_abac_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Access) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Access) ProtoMessage() {}
+
+func (x *Access) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Access.ProtoReflect.Descriptor instead.
+func (*Access) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Access) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Access) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *Access) GetDbAccessDate() string {
+	if x != nil {
+		return x.DbAccessDate
+	}
+	return ""
+}
+
+func (x *Access) GetDbDenyDate() string {
+	if x != nil {
+		return x.DbDenyDate
+	}
+	return ""
+}
+
+type GetAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableName     string                 `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessRequest) Reset() {
+	*x = GetAccessRequest{}
+	mi := &file_abac_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessRequest) ProtoMessage() {}
+
+func (x *GetAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAccessRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+type ListAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Access              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessResponse) Reset() {
+	*x = ListAccessResponse{}
+	mi := &file_abac_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessResponse) ProtoMessage() {}
+
+func (x *ListAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessResponse) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListAccessResponse) GetItems() []*Access {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAccessResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SetAccessDateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableName     string                 `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	DbAccessDate  string                 `protobuf:"bytes,3,opt,name=db_access_date,json=dbAccessDate,proto3" json:"db_access_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccessDateRequest) Reset() {
+	*x = SetAccessDateRequest{}
+	mi := &file_abac_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccessDateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccessDateRequest) ProtoMessage() {}
+
+func (x *SetAccessDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccessDateRequest.ProtoReflect.Descriptor instead.
+func (*SetAccessDateRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetAccessDateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetAccessDateRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *SetAccessDateRequest) GetDbAccessDate() string {
+	if x != nil {
+		return x.DbAccessDate
+	}
+	return ""
+}
+
+type SetDenyDateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableName     string                 `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	DbDenyDate    string                 `protobuf:"bytes,3,opt,name=db_deny_date,json=dbDenyDate,proto3" json:"db_deny_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDenyDateRequest) Reset() {
+	*x = SetDenyDateRequest{}
+	mi := &file_abac_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDenyDateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDenyDateRequest) ProtoMessage() {}
+
+func (x *SetDenyDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDenyDateRequest.ProtoReflect.Descriptor instead.
+func (*SetDenyDateRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetDenyDateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetDenyDateRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *SetDenyDateRequest) GetDbDenyDate() string {
+	if x != nil {
+		return x.DbDenyDate
+	}
+	return ""
+}
+
+type CheckAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableName     string                 `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAccessRequest) Reset() {
+	*x = CheckAccessRequest{}
+	mi := &file_abac_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessRequest) ProtoMessage() {}
+
+func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CheckAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+type CheckAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAccessResponse) Reset() {
+	*x = CheckAccessResponse{}
+	mi := &file_abac_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessResponse) ProtoMessage() {}
+
+func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckAccessResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+type SubmitGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitGrantRequest) Reset() {
+	*x = SubmitGrantRequest{}
+	mi := &file_abac_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGrantRequest) ProtoMessage() {}
+
+func (x *SubmitGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGrantRequest.ProtoReflect.Descriptor instead.
+func (*SubmitGrantRequest) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SubmitGrantRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SubmitGrantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitGrantResponse) Reset() {
+	*x = SubmitGrantResponse{}
+	mi := &file_abac_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitGrantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGrantResponse) ProtoMessage() {}
+
+func (x *SubmitGrantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_abac_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGrantResponse.ProtoReflect.Descriptor instead.
+func (*SubmitGrantResponse) Descriptor() ([]byte, []int) {
+	return file_abac_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SubmitGrantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_abac_proto protoreflect.FileDescriptor
+
+const file_abac_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"abac.proto\x12\aabac.v1\"\xde\x01\n" +
+	"\vListRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x03 \x01(\tR\x05order\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12;\n" +
+	"\afilters\x18\x05 \x03(\v2!.abac.v1.ListRequest.FiltersEntryR\afilters\x1a:\n" +
+	"\fFiltersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"#\n" +
+	"\rWriteResponse\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\x03R\x04rows\"4\n" +
+	"\x06Policy\x12\x10\n" +
+	"\x03ref\x18\x01 \x01(\tR\x03ref\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"$\n" +
+	"\x10GetPolicyRequest\x12\x10\n" +
+	"\x03ref\x18\x01 \x01(\tR\x03ref\"^\n" +
+	"\x14ListPoliciesResponse\x12%\n" +
+	"\x05items\x18\x01 \x03(\v2\x0f.abac.v1.PolicyR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"X\n" +
+	"\tHierarchy\x12\x15\n" +
+	"\x06obj_id\x18\x01 \x01(\tR\x05objId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1c\n" +
+	"\thierarchy\x18\x03 \x01(\tR\thierarchy\"D\n" +
+	"\x13GetHierarchyRequest\x12\x15\n" +
+	"\x06obj_id\x18\x01 \x01(\tR\x05objId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\"d\n" +
+	"\x17ListHierarchiesResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.abac.v1.HierarchyR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\":\n" +
+	"\tUserAttrs\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05attrs\x18\x02 \x01(\tR\x05attrs\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"^\n" +
+	"\x11CreateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x14\n" +
+	"\x05attrs\x18\x03 \x01(\tR\x05attrs\"^\n" +
+	"\x11ListUsersResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.abac.v1.UserAttrsR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"j\n" +
+	"\x06Device\x12\x15\n" +
+	"\x06dev_id\x18\x01 \x01(\tR\x05devId\x12\x19\n" +
+	"\bdev_type\x18\x02 \x01(\tR\adevType\x12\x18\n" +
+	"\aactions\x18\x03 \x01(\tR\aactions\x12\x14\n" +
+	"\x05attrs\x18\x04 \x01(\tR\x05attrs\":\n" +
+	"\vDeviceAttrs\x12\x15\n" +
+	"\x06dev_id\x18\x01 \x01(\tR\x05devId\x12\x14\n" +
+	"\x05attrs\x18\x02 \x01(\tR\x05attrs\"@\n" +
+	"\rDeviceActions\x12\x15\n" +
+	"\x06dev_id\x18\x01 \x01(\tR\x05devId\x12\x18\n" +
+	"\aactions\x18\x02 \x01(\tR\aactions\")\n" +
+	"\x10GetDeviceRequest\x12\x15\n" +
+	"\x06dev_id\x18\x01 \x01(\tR\x05devId\"\x8d\x01\n" +
+	"\x13CreateDeviceRequest\x12\x15\n" +
+	"\x06dev_id\x18\x01 \x01(\tR\x05devId\x12\x19\n" +
+	"\bdev_type\x18\x02 \x01(\tR\adevType\x12\x18\n" +
+	"\aactions\x18\x03 \x01(\tR\aactions\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x14\n" +
+	"\x05attrs\x18\x05 \x01(\tR\x05attrs\"]\n" +
+	"\x13ListDevicesResponse\x12%\n" +
+	"\x05items\x18\x01 \x03(\v2\x0f.abac.v1.DeviceR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x88\x01\n" +
+	"\x06Access\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\x12$\n" +
+	"\x0edb_access_date\x18\x03 \x01(\tR\fdbAccessDate\x12 \n" +
+	"\fdb_deny_date\x18\x04 \x01(\tR\n" +
+	"dbDenyDate\"J\n" +
+	"\x10GetAccessRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\"\\\n" +
+	"\x12ListAccessResponse\x12%\n" +
+	"\x05items\x18\x01 \x03(\v2\x0f.abac.v1.AccessR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"t\n" +
+	"\x14SetAccessDateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\x12$\n" +
+	"\x0edb_access_date\x18\x03 \x01(\tR\fdbAccessDate\"n\n" +
+	"\x12SetDenyDateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\x12 \n" +
+	"\fdb_deny_date\x18\x03 \x01(\tR\n" +
+	"dbDenyDate\"L\n" +
+	"\x12CheckAccessRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\"/\n" +
+	"\x13CheckAccessResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\"*\n" +
+	"\x12SubmitGrantRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"/\n" +
+	"\x13SubmitGrantResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\xe4\v\n" +
+	"\x04Abac\x127\n" +
+	"\tGetPolicy\x12\x19.abac.v1.GetPolicyRequest\x1a\x0f.abac.v1.Policy\x12C\n" +
+	"\fListPolicies\x12\x14.abac.v1.ListRequest\x1a\x1d.abac.v1.ListPoliciesResponse\x127\n" +
+	"\fCreatePolicy\x12\x0f.abac.v1.Policy\x1a\x16.abac.v1.WriteResponse\x127\n" +
+	"\fUpdatePolicy\x12\x0f.abac.v1.Policy\x1a\x16.abac.v1.WriteResponse\x12@\n" +
+	"\fGetHierarchy\x12\x1c.abac.v1.GetHierarchyRequest\x1a\x12.abac.v1.Hierarchy\x12I\n" +
+	"\x0fListHierarchies\x12\x14.abac.v1.ListRequest\x1a .abac.v1.ListHierarchiesResponse\x12=\n" +
+	"\x0fCreateHierarchy\x12\x12.abac.v1.Hierarchy\x1a\x16.abac.v1.WriteResponse\x12=\n" +
+	"\x0fUpdateHierarchy\x12\x12.abac.v1.Hierarchy\x1a\x16.abac.v1.WriteResponse\x12;\n" +
+	"\fGetUserAttrs\x12\x17.abac.v1.GetUserRequest\x1a\x12.abac.v1.UserAttrs\x12=\n" +
+	"\tListUsers\x12\x14.abac.v1.ListRequest\x1a\x1a.abac.v1.ListUsersResponse\x12@\n" +
+	"\n" +
+	"CreateUser\x12\x1a.abac.v1.CreateUserRequest\x1a\x16.abac.v1.WriteResponse\x12=\n" +
+	"\x0fUpdateUserAttrs\x12\x12.abac.v1.UserAttrs\x1a\x16.abac.v1.WriteResponse\x12A\n" +
+	"\x0eGetDeviceAttrs\x12\x19.abac.v1.GetDeviceRequest\x1a\x14.abac.v1.DeviceAttrs\x12E\n" +
+	"\x10GetDeviceActions\x12\x19.abac.v1.GetDeviceRequest\x1a\x16.abac.v1.DeviceActions\x12A\n" +
+	"\vListDevices\x12\x14.abac.v1.ListRequest\x1a\x1c.abac.v1.ListDevicesResponse\x12D\n" +
+	"\fCreateDevice\x12\x1c.abac.v1.CreateDeviceRequest\x1a\x16.abac.v1.WriteResponse\x127\n" +
+	"\tGetAccess\x12\x19.abac.v1.GetAccessRequest\x1a\x0f.abac.v1.Access\x12?\n" +
+	"\n" +
+	"ListAccess\x12\x14.abac.v1.ListRequest\x1a\x1b.abac.v1.ListAccessResponse\x127\n" +
+	"\fCreateAccess\x12\x0f.abac.v1.Access\x1a\x16.abac.v1.WriteResponse\x12F\n" +
+	"\rSetAccessDate\x12\x1d.abac.v1.SetAccessDateRequest\x1a\x16.abac.v1.WriteResponse\x12B\n" +
+	"\vSetDenyDate\x12\x1b.abac.v1.SetDenyDateRequest\x1a\x16.abac.v1.WriteResponse\x12H\n" +
+	"\vCheckAccess\x12\x1b.abac.v1.CheckAccessRequest\x1a\x1c.abac.v1.CheckAccessResponse\x12H\n" +
+	"\vSubmitGrant\x12\x1b.abac.v1.SubmitGrantRequest\x1a\x1c.abac.v1.SubmitGrantResponseB$Z\"RemoteTestServer/pkg/abacpb;abacpbb\x06proto3"
+
+var (
+	file_abac_proto_rawDescOnce sync.Once
+	file_abac_proto_rawDescData []byte
+)
+
+func file_abac_proto_rawDescGZIP() []byte {
+	file_abac_proto_rawDescOnce.Do(func() {
+		file_abac_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_abac_proto_rawDesc), len(file_abac_proto_rawDesc)))
+	})
+	return file_abac_proto_rawDescData
+}
+
+var file_abac_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_abac_proto_goTypes = []any{
+	(*ListRequest)(nil),             // 0: abac.v1.ListRequest
+	(*WriteResponse)(nil),           // 1: abac.v1.WriteResponse
+	(*Policy)(nil),                  // 2: abac.v1.Policy
+	(*GetPolicyRequest)(nil),        // 3: abac.v1.GetPolicyRequest
+	(*ListPoliciesResponse)(nil),    // 4: abac.v1.ListPoliciesResponse
+	(*Hierarchy)(nil),               // 5: abac.v1.Hierarchy
+	(*GetHierarchyRequest)(nil),     // 6: abac.v1.GetHierarchyRequest
+	(*ListHierarchiesResponse)(nil), // 7: abac.v1.ListHierarchiesResponse
+	(*UserAttrs)(nil),               // 8: abac.v1.UserAttrs
+	(*GetUserRequest)(nil),          // 9: abac.v1.GetUserRequest
+	(*CreateUserRequest)(nil),       // 10: abac.v1.CreateUserRequest
+	(*ListUsersResponse)(nil),       // 11: abac.v1.ListUsersResponse
+	(*Device)(nil),                  // 12: abac.v1.Device
+	(*DeviceAttrs)(nil),             // 13: abac.v1.DeviceAttrs
+	(*DeviceActions)(nil),           // 14: abac.v1.DeviceActions
+	(*GetDeviceRequest)(nil),        // 15: abac.v1.GetDeviceRequest
+	(*CreateDeviceRequest)(nil),     // 16: abac.v1.CreateDeviceRequest
+	(*ListDevicesResponse)(nil),     // 17: abac.v1.ListDevicesResponse
+	(*Access)(nil),                  // 18: abac.v1.Access
+	(*GetAccessRequest)(nil),        // 19: abac.v1.GetAccessRequest
+	(*ListAccessResponse)(nil),      // 20: abac.v1.ListAccessResponse
+	(*SetAccessDateRequest)(nil),    // 21: abac.v1.SetAccessDateRequest
+	(*SetDenyDateRequest)(nil),      // 22: abac.v1.SetDenyDateRequest
+	(*CheckAccessRequest)(nil),      // 23: abac.v1.CheckAccessRequest
+	(*CheckAccessResponse)(nil),     // 24: abac.v1.CheckAccessResponse
+	(*SubmitGrantRequest)(nil),      // 25: abac.v1.SubmitGrantRequest
+	(*SubmitGrantResponse)(nil),     // 26: abac.v1.SubmitGrantResponse
+	nil,                             // 27: abac.v1.ListRequest.FiltersEntry
+}
+var file_abac_proto_depIdxs = []int32{
+	27, // 0: abac.v1.ListRequest.filters:type_name -> abac.v1.ListRequest.FiltersEntry
+	2,  // 1: abac.v1.ListPoliciesResponse.items:type_name -> abac.v1.Policy
+	5,  // 2: abac.v1.ListHierarchiesResponse.items:type_name -> abac.v1.Hierarchy
+	8,  // 3: abac.v1.ListUsersResponse.items:type_name -> abac.v1.UserAttrs
+	12, // 4: abac.v1.ListDevicesResponse.items:type_name -> abac.v1.Device
+	18, // 5: abac.v1.ListAccessResponse.items:type_name -> abac.v1.Access
+	3,  // 6: abac.v1.Abac.GetPolicy:input_type -> abac.v1.GetPolicyRequest
+	0,  // 7: abac.v1.Abac.ListPolicies:input_type -> abac.v1.ListRequest
+	2,  // 8: abac.v1.Abac.CreatePolicy:input_type -> abac.v1.Policy
+	2,  // 9: abac.v1.Abac.UpdatePolicy:input_type -> abac.v1.Policy
+	6,  // 10: abac.v1.Abac.GetHierarchy:input_type -> abac.v1.GetHierarchyRequest
+	0,  // 11: abac.v1.Abac.ListHierarchies:input_type -> abac.v1.ListRequest
+	5,  // 12: abac.v1.Abac.CreateHierarchy:input_type -> abac.v1.Hierarchy
+	5,  // 13: abac.v1.Abac.UpdateHierarchy:input_type -> abac.v1.Hierarchy
+	9,  // 14: abac.v1.Abac.GetUserAttrs:input_type -> abac.v1.GetUserRequest
+	0,  // 15: abac.v1.Abac.ListUsers:input_type -> abac.v1.ListRequest
+	10, // 16: abac.v1.Abac.CreateUser:input_type -> abac.v1.CreateUserRequest
+	8,  // 17: abac.v1.Abac.UpdateUserAttrs:input_type -> abac.v1.UserAttrs
+	15, // 18: abac.v1.Abac.GetDeviceAttrs:input_type -> abac.v1.GetDeviceRequest
+	15, // 19: abac.v1.Abac.GetDeviceActions:input_type -> abac.v1.GetDeviceRequest
+	0,  // 20: abac.v1.Abac.ListDevices:input_type -> abac.v1.ListRequest
+	16, // 21: abac.v1.Abac.CreateDevice:input_type -> abac.v1.CreateDeviceRequest
+	19, // 22: abac.v1.Abac.GetAccess:input_type -> abac.v1.GetAccessRequest
+	0,  // 23: abac.v1.Abac.ListAccess:input_type -> abac.v1.ListRequest
+	18, // 24: abac.v1.Abac.CreateAccess:input_type -> abac.v1.Access
+	21, // 25: abac.v1.Abac.SetAccessDate:input_type -> abac.v1.SetAccessDateRequest
+	22, // 26: abac.v1.Abac.SetDenyDate:input_type -> abac.v1.SetDenyDateRequest
+	23, // 27: abac.v1.Abac.CheckAccess:input_type -> abac.v1.CheckAccessRequest
+	25, // 28: abac.v1.Abac.SubmitGrant:input_type -> abac.v1.SubmitGrantRequest
+	2,  // 29: abac.v1.Abac.GetPolicy:output_type -> abac.v1.Policy
+	4,  // 30: abac.v1.Abac.ListPolicies:output_type -> abac.v1.ListPoliciesResponse
+	1,  // 31: abac.v1.Abac.CreatePolicy:output_type -> abac.v1.WriteResponse
+	1,  // 32: abac.v1.Abac.UpdatePolicy:output_type -> abac.v1.WriteResponse
+	5,  // 33: abac.v1.Abac.GetHierarchy:output_type -> abac.v1.Hierarchy
+	7,  // 34: abac.v1.Abac.ListHierarchies:output_type -> abac.v1.ListHierarchiesResponse
+	1,  // 35: abac.v1.Abac.CreateHierarchy:output_type -> abac.v1.WriteResponse
+	1,  // 36: abac.v1.Abac.UpdateHierarchy:output_type -> abac.v1.WriteResponse
+	8,  // 37: abac.v1.Abac.GetUserAttrs:output_type -> abac.v1.UserAttrs
+	11, // 38: abac.v1.Abac.ListUsers:output_type -> abac.v1.ListUsersResponse
+	1,  // 39: abac.v1.Abac.CreateUser:output_type -> abac.v1.WriteResponse
+	1,  // 40: abac.v1.Abac.UpdateUserAttrs:output_type -> abac.v1.WriteResponse
+	13, // 41: abac.v1.Abac.GetDeviceAttrs:output_type -> abac.v1.DeviceAttrs
+	14, // 42: abac.v1.Abac.GetDeviceActions:output_type -> abac.v1.DeviceActions
+	17, // 43: abac.v1.Abac.ListDevices:output_type -> abac.v1.ListDevicesResponse
+	1,  // 44: abac.v1.Abac.CreateDevice:output_type -> abac.v1.WriteResponse
+	18, // 45: abac.v1.Abac.GetAccess:output_type -> abac.v1.Access
+	20, // 46: abac.v1.Abac.ListAccess:output_type -> abac.v1.ListAccessResponse
+	1,  // 47: abac.v1.Abac.CreateAccess:output_type -> abac.v1.WriteResponse
+	1,  // 48: abac.v1.Abac.SetAccessDate:output_type -> abac.v1.WriteResponse
+	1,  // 49: abac.v1.Abac.SetDenyDate:output_type -> abac.v1.WriteResponse
+	24, // 50: abac.v1.Abac.CheckAccess:output_type -> abac.v1.CheckAccessResponse
+	26, // 51: abac.v1.Abac.SubmitGrant:output_type -> abac.v1.SubmitGrantResponse
+	29, // [29:52] is the sub-list for method output_type
+	6,  // [6:29] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_abac_proto_init() }
+func file_abac_proto_init() {
+	if File_abac_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_abac_proto_rawDesc), len(file_abac_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_abac_proto_goTypes,
+		DependencyIndexes: file_abac_proto_depIdxs,
+		MessageInfos:      file_abac_proto_msgTypes,
+	}.Build()
+	File_abac_proto = out.File
+	file_abac_proto_goTypes = nil
+	file_abac_proto_depIdxs = nil
+}
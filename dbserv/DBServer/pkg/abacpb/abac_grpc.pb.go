@@ -0,0 +1,981 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: abac.proto
+
+// The gRPC face of DBServer. Every call is served by the same code as the
+// matching HTTP endpoint, so reads go through the same caches, permission
+// checks and audit log, and writes publish the same change events.
+//
+// Metadata understood by the server: x-request-id (as the HTTP header),
+// x-cache-bypass on Get calls. Get calls answer with an x-cache
+// header of HIT, MISS or BYPASS.
+
+package abacpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Abac_GetPolicy_FullMethodName        = "/abac.v1.Abac/GetPolicy"
+	Abac_ListPolicies_FullMethodName     = "/abac.v1.Abac/ListPolicies"
+	Abac_CreatePolicy_FullMethodName     = "/abac.v1.Abac/CreatePolicy"
+	Abac_UpdatePolicy_FullMethodName     = "/abac.v1.Abac/UpdatePolicy"
+	Abac_GetHierarchy_FullMethodName     = "/abac.v1.Abac/GetHierarchy"
+	Abac_ListHierarchies_FullMethodName  = "/abac.v1.Abac/ListHierarchies"
+	Abac_CreateHierarchy_FullMethodName  = "/abac.v1.Abac/CreateHierarchy"
+	Abac_UpdateHierarchy_FullMethodName  = "/abac.v1.Abac/UpdateHierarchy"
+	Abac_GetUserAttrs_FullMethodName     = "/abac.v1.Abac/GetUserAttrs"
+	Abac_ListUsers_FullMethodName        = "/abac.v1.Abac/ListUsers"
+	Abac_CreateUser_FullMethodName       = "/abac.v1.Abac/CreateUser"
+	Abac_UpdateUserAttrs_FullMethodName  = "/abac.v1.Abac/UpdateUserAttrs"
+	Abac_GetDeviceAttrs_FullMethodName   = "/abac.v1.Abac/GetDeviceAttrs"
+	Abac_GetDeviceActions_FullMethodName = "/abac.v1.Abac/GetDeviceActions"
+	Abac_ListDevices_FullMethodName      = "/abac.v1.Abac/ListDevices"
+	Abac_CreateDevice_FullMethodName     = "/abac.v1.Abac/CreateDevice"
+	Abac_GetAccess_FullMethodName        = "/abac.v1.Abac/GetAccess"
+	Abac_ListAccess_FullMethodName       = "/abac.v1.Abac/ListAccess"
+	Abac_CreateAccess_FullMethodName     = "/abac.v1.Abac/CreateAccess"
+	Abac_SetAccessDate_FullMethodName    = "/abac.v1.Abac/SetAccessDate"
+	Abac_SetDenyDate_FullMethodName      = "/abac.v1.Abac/SetDenyDate"
+	Abac_CheckAccess_FullMethodName      = "/abac.v1.Abac/CheckAccess"
+	Abac_SubmitGrant_FullMethodName      = "/abac.v1.Abac/SubmitGrant"
+)
+
+// AbacClient is the client API for Abac service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AbacClient interface {
+	// Policies
+	GetPolicy(ctx context.Context, in *GetPolicyRequest, opts ...grpc.CallOption) (*Policy, error)
+	ListPolicies(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error)
+	CreatePolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*WriteResponse, error)
+	UpdatePolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*WriteResponse, error)
+	// Object-action hierarchies
+	GetHierarchy(ctx context.Context, in *GetHierarchyRequest, opts ...grpc.CallOption) (*Hierarchy, error)
+	ListHierarchies(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListHierarchiesResponse, error)
+	CreateHierarchy(ctx context.Context, in *Hierarchy, opts ...grpc.CallOption) (*WriteResponse, error)
+	UpdateHierarchy(ctx context.Context, in *Hierarchy, opts ...grpc.CallOption) (*WriteResponse, error)
+	// Users. GetUserAttrs is subject to the user_attrs access check, like
+	// /find_uesr_attrs.
+	GetUserAttrs(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserAttrs, error)
+	ListUsers(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	UpdateUserAttrs(ctx context.Context, in *UserAttrs, opts ...grpc.CallOption) (*WriteResponse, error)
+	// Devices
+	GetDeviceAttrs(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*DeviceAttrs, error)
+	GetDeviceActions(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*DeviceActions, error)
+	ListDevices(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	CreateDevice(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	// Table access grants
+	GetAccess(ctx context.Context, in *GetAccessRequest, opts ...grpc.CallOption) (*Access, error)
+	ListAccess(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListAccessResponse, error)
+	CreateAccess(ctx context.Context, in *Access, opts ...grpc.CallOption) (*WriteResponse, error)
+	SetAccessDate(ctx context.Context, in *SetAccessDateRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	SetDenyDate(ctx context.Context, in *SetDenyDateRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	// CheckAccess makes the decision the server applies to its own reads.
+	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error)
+	// SubmitGrant applies a signed grant token, as POST /jwt does.
+	SubmitGrant(ctx context.Context, in *SubmitGrantRequest, opts ...grpc.CallOption) (*SubmitGrantResponse, error)
+}
+
+type abacClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAbacClient(cc grpc.ClientConnInterface) AbacClient {
+	return &abacClient{cc}
+}
+
+func (c *abacClient) GetPolicy(ctx context.Context, in *GetPolicyRequest, opts ...grpc.CallOption) (*Policy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Policy)
+	err := c.cc.Invoke(ctx, Abac_GetPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) ListPolicies(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPoliciesResponse)
+	err := c.cc.Invoke(ctx, Abac_ListPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) CreatePolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, Abac_CreatePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) UpdatePolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, Abac_UpdatePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) GetHierarchy(ctx context.Context, in *GetHierarchyRequest, opts ...grpc.CallOption) (*Hierarchy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hierarchy)
+	err := c.cc.Invoke(ctx, Abac_GetHierarchy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) ListHierarchies(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListHierarchiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHierarchiesResponse)
+	err := c.cc.Invoke(ctx, Abac_ListHierarchies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) CreateHierarchy(ctx context.Context, in *Hierarchy, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, Abac_CreateHierarchy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) UpdateHierarchy(ctx context.Context, in *Hierarchy, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, Abac_UpdateHierarchy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) GetUserAttrs(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserAttrs, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserAttrs)
+	err := c.cc.Invoke(ctx, Abac_GetUserAttrs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) ListUsers(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, Abac_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, Abac_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) UpdateUserAttrs(ctx context.Context, in *UserAttrs, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, Abac_UpdateUserAttrs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) GetDeviceAttrs(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*DeviceAttrs, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceAttrs)
+	err := c.cc.Invoke(ctx, Abac_GetDeviceAttrs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) GetDeviceActions(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*DeviceActions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceActions)
+	err := c.cc.Invoke(ctx, Abac_GetDeviceActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) ListDevices(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, Abac_ListDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) CreateDevice(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, Abac_CreateDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) GetAccess(ctx context.Context, in *GetAccessRequest, opts ...grpc.CallOption) (*Access, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Access)
+	err := c.cc.Invoke(ctx, Abac_GetAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) ListAccess(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessResponse)
+	err := c.cc.Invoke(ctx, Abac_ListAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) CreateAccess(ctx context.Context, in *Access, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, Abac_CreateAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) SetAccessDate(ctx context.Context, in *SetAccessDateRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, Abac_SetAccessDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) SetDenyDate(ctx context.Context, in *SetDenyDateRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, Abac_SetDenyDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAccessResponse)
+	err := c.cc.Invoke(ctx, Abac_CheckAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *abacClient) SubmitGrant(ctx context.Context, in *SubmitGrantRequest, opts ...grpc.CallOption) (*SubmitGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitGrantResponse)
+	err := c.cc.Invoke(ctx, Abac_SubmitGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AbacServer is the server API for Abac service.
+// All implementations must embed UnimplementedAbacServer
+// for forward compatibility.
+type AbacServer interface {
+	// Policies
+	GetPolicy(context.Context, *GetPolicyRequest) (*Policy, error)
+	ListPolicies(context.Context, *ListRequest) (*ListPoliciesResponse, error)
+	CreatePolicy(context.Context, *Policy) (*WriteResponse, error)
+	UpdatePolicy(context.Context, *Policy) (*WriteResponse, error)
+	// Object-action hierarchies
+	GetHierarchy(context.Context, *GetHierarchyRequest) (*Hierarchy, error)
+	ListHierarchies(context.Context, *ListRequest) (*ListHierarchiesResponse, error)
+	CreateHierarchy(context.Context, *Hierarchy) (*WriteResponse, error)
+	UpdateHierarchy(context.Context, *Hierarchy) (*WriteResponse, error)
+	// Users. GetUserAttrs is subject to the user_attrs access check, like
+	// /find_uesr_attrs.
+	GetUserAttrs(context.Context, *GetUserRequest) (*UserAttrs, error)
+	ListUsers(context.Context, *ListRequest) (*ListUsersResponse, error)
+	CreateUser(context.Context, *CreateUserRequest) (*WriteResponse, error)
+	UpdateUserAttrs(context.Context, *UserAttrs) (*WriteResponse, error)
+	// Devices
+	GetDeviceAttrs(context.Context, *GetDeviceRequest) (*DeviceAttrs, error)
+	GetDeviceActions(context.Context, *GetDeviceRequest) (*DeviceActions, error)
+	ListDevices(context.Context, *ListRequest) (*ListDevicesResponse, error)
+	CreateDevice(context.Context, *CreateDeviceRequest) (*WriteResponse, error)
+	// Table access grants
+	GetAccess(context.Context, *GetAccessRequest) (*Access, error)
+	ListAccess(context.Context, *ListRequest) (*ListAccessResponse, error)
+	CreateAccess(context.Context, *Access) (*WriteResponse, error)
+	SetAccessDate(context.Context, *SetAccessDateRequest) (*WriteResponse, error)
+	SetDenyDate(context.Context, *SetDenyDateRequest) (*WriteResponse, error)
+	// CheckAccess makes the decision the server applies to its own reads.
+	CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error)
+	// SubmitGrant applies a signed grant token, as POST /jwt does.
+	SubmitGrant(context.Context, *SubmitGrantRequest) (*SubmitGrantResponse, error)
+	mustEmbedUnimplementedAbacServer()
+}
+
+// UnimplementedAbacServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAbacServer struct{}
+
+func (UnimplementedAbacServer) GetPolicy(context.Context, *GetPolicyRequest) (*Policy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicy not implemented")
+}
+func (UnimplementedAbacServer) ListPolicies(context.Context, *ListRequest) (*ListPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies not implemented")
+}
+func (UnimplementedAbacServer) CreatePolicy(context.Context, *Policy) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePolicy not implemented")
+}
+func (UnimplementedAbacServer) UpdatePolicy(context.Context, *Policy) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicy not implemented")
+}
+func (UnimplementedAbacServer) GetHierarchy(context.Context, *GetHierarchyRequest) (*Hierarchy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHierarchy not implemented")
+}
+func (UnimplementedAbacServer) ListHierarchies(context.Context, *ListRequest) (*ListHierarchiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHierarchies not implemented")
+}
+func (UnimplementedAbacServer) CreateHierarchy(context.Context, *Hierarchy) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHierarchy not implemented")
+}
+func (UnimplementedAbacServer) UpdateHierarchy(context.Context, *Hierarchy) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHierarchy not implemented")
+}
+func (UnimplementedAbacServer) GetUserAttrs(context.Context, *GetUserRequest) (*UserAttrs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAttrs not implemented")
+}
+func (UnimplementedAbacServer) ListUsers(context.Context, *ListRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedAbacServer) CreateUser(context.Context, *CreateUserRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedAbacServer) UpdateUserAttrs(context.Context, *UserAttrs) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserAttrs not implemented")
+}
+func (UnimplementedAbacServer) GetDeviceAttrs(context.Context, *GetDeviceRequest) (*DeviceAttrs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceAttrs not implemented")
+}
+func (UnimplementedAbacServer) GetDeviceActions(context.Context, *GetDeviceRequest) (*DeviceActions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceActions not implemented")
+}
+func (UnimplementedAbacServer) ListDevices(context.Context, *ListRequest) (*ListDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedAbacServer) CreateDevice(context.Context, *CreateDeviceRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDevice not implemented")
+}
+func (UnimplementedAbacServer) GetAccess(context.Context, *GetAccessRequest) (*Access, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccess not implemented")
+}
+func (UnimplementedAbacServer) ListAccess(context.Context, *ListRequest) (*ListAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccess not implemented")
+}
+func (UnimplementedAbacServer) CreateAccess(context.Context, *Access) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccess not implemented")
+}
+func (UnimplementedAbacServer) SetAccessDate(context.Context, *SetAccessDateRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccessDate not implemented")
+}
+func (UnimplementedAbacServer) SetDenyDate(context.Context, *SetDenyDateRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenyDate not implemented")
+}
+func (UnimplementedAbacServer) CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
+func (UnimplementedAbacServer) SubmitGrant(context.Context, *SubmitGrantRequest) (*SubmitGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGrant not implemented")
+}
+func (UnimplementedAbacServer) mustEmbedUnimplementedAbacServer() {}
+func (UnimplementedAbacServer) testEmbeddedByValue()              {}
+
+// UnsafeAbacServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AbacServer will
+// result in compilation errors.
+type UnsafeAbacServer interface {
+	mustEmbedUnimplementedAbacServer()
+}
+
+func RegisterAbacServer(s grpc.ServiceRegistrar, srv AbacServer) {
+	// If the following call pancis, it indicates UnimplementedAbacServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Abac_ServiceDesc, srv)
+}
+
+func _Abac_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).GetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_GetPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).GetPolicy(ctx, req.(*GetPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_ListPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).ListPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_ListPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).ListPolicies(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_CreatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Policy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).CreatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_CreatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).CreatePolicy(ctx, req.(*Policy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Policy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).UpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_UpdatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).UpdatePolicy(ctx, req.(*Policy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_GetHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHierarchyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).GetHierarchy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_GetHierarchy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).GetHierarchy(ctx, req.(*GetHierarchyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_ListHierarchies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).ListHierarchies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_ListHierarchies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).ListHierarchies(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_CreateHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hierarchy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).CreateHierarchy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_CreateHierarchy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).CreateHierarchy(ctx, req.(*Hierarchy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_UpdateHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hierarchy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).UpdateHierarchy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_UpdateHierarchy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).UpdateHierarchy(ctx, req.(*Hierarchy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_GetUserAttrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).GetUserAttrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_GetUserAttrs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).GetUserAttrs(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).ListUsers(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_UpdateUserAttrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserAttrs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).UpdateUserAttrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_UpdateUserAttrs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).UpdateUserAttrs(ctx, req.(*UserAttrs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_GetDeviceAttrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).GetDeviceAttrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_GetDeviceAttrs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).GetDeviceAttrs(ctx, req.(*GetDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_GetDeviceActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).GetDeviceActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_GetDeviceActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).GetDeviceActions(ctx, req.(*GetDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).ListDevices(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_CreateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).CreateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_CreateDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).CreateDevice(ctx, req.(*CreateDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_GetAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).GetAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_GetAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).GetAccess(ctx, req.(*GetAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_ListAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).ListAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_ListAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).ListAccess(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_CreateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Access)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).CreateAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_CreateAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).CreateAccess(ctx, req.(*Access))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_SetAccessDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccessDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).SetAccessDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_SetAccessDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).SetAccessDate(ctx, req.(*SetAccessDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_SetDenyDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDenyDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).SetDenyDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_SetDenyDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).SetDenyDate(ctx, req.(*SetDenyDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_CheckAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).CheckAccess(ctx, req.(*CheckAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Abac_SubmitGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbacServer).SubmitGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Abac_SubmitGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbacServer).SubmitGrant(ctx, req.(*SubmitGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Abac_ServiceDesc is the grpc.ServiceDesc for Abac service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Abac_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "abac.v1.Abac",
+	HandlerType: (*AbacServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPolicy",
+			Handler:    _Abac_GetPolicy_Handler,
+		},
+		{
+			MethodName: "ListPolicies",
+			Handler:    _Abac_ListPolicies_Handler,
+		},
+		{
+			MethodName: "CreatePolicy",
+			Handler:    _Abac_CreatePolicy_Handler,
+		},
+		{
+			MethodName: "UpdatePolicy",
+			Handler:    _Abac_UpdatePolicy_Handler,
+		},
+		{
+			MethodName: "GetHierarchy",
+			Handler:    _Abac_GetHierarchy_Handler,
+		},
+		{
+			MethodName: "ListHierarchies",
+			Handler:    _Abac_ListHierarchies_Handler,
+		},
+		{
+			MethodName: "CreateHierarchy",
+			Handler:    _Abac_CreateHierarchy_Handler,
+		},
+		{
+			MethodName: "UpdateHierarchy",
+			Handler:    _Abac_UpdateHierarchy_Handler,
+		},
+		{
+			MethodName: "GetUserAttrs",
+			Handler:    _Abac_GetUserAttrs_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _Abac_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _Abac_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUserAttrs",
+			Handler:    _Abac_UpdateUserAttrs_Handler,
+		},
+		{
+			MethodName: "GetDeviceAttrs",
+			Handler:    _Abac_GetDeviceAttrs_Handler,
+		},
+		{
+			MethodName: "GetDeviceActions",
+			Handler:    _Abac_GetDeviceActions_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _Abac_ListDevices_Handler,
+		},
+		{
+			MethodName: "CreateDevice",
+			Handler:    _Abac_CreateDevice_Handler,
+		},
+		{
+			MethodName: "GetAccess",
+			Handler:    _Abac_GetAccess_Handler,
+		},
+		{
+			MethodName: "ListAccess",
+			Handler:    _Abac_ListAccess_Handler,
+		},
+		{
+			MethodName: "CreateAccess",
+			Handler:    _Abac_CreateAccess_Handler,
+		},
+		{
+			MethodName: "SetAccessDate",
+			Handler:    _Abac_SetAccessDate_Handler,
+		},
+		{
+			MethodName: "SetDenyDate",
+			Handler:    _Abac_SetDenyDate_Handler,
+		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _Abac_CheckAccess_Handler,
+		},
+		{
+			MethodName: "SubmitGrant",
+			Handler:    _Abac_SubmitGrant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "abac.proto",
+}
@@ -0,0 +1,5 @@
+// Package abacpb holds the protobuf messages and gRPC stubs generated from
+// abac.proto. Regenerate with go generate after editing the .proto file.
+package abacpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative abac.proto
@@ -0,0 +1,15022 @@
+// Package apiclient provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	AdminTokenScopes   = "adminToken.Scopes"
+	IssuerTokenScopes  = "issuerToken.Scopes"
+	UserPasswordScopes = "userPassword.Scopes"
+)
+
+// Defines values for AccessRequestStatus.
+const (
+	AccessRequestStatusApproved AccessRequestStatus = "approved"
+	AccessRequestStatusPending  AccessRequestStatus = "pending"
+	AccessRequestStatusRejected AccessRequestStatus = "rejected"
+)
+
+// Defines values for AccessRequestEventAction.
+const (
+	AccessRequestEventActionApproved  AccessRequestEventAction = "approved"
+	AccessRequestEventActionRejected  AccessRequestEventAction = "rejected"
+	AccessRequestEventActionSubmitted AccessRequestEventAction = "submitted"
+)
+
+// Defines values for AuditRecordDecision.
+const (
+	AuditRecordDecisionAllow AuditRecordDecision = "allow"
+	AuditRecordDecisionDeny  AuditRecordDecision = "deny"
+)
+
+// Defines values for ChangeEventEntityType.
+const (
+	ChangeEventEntityTypeDevice ChangeEventEntityType = "device"
+	ChangeEventEntityTypePolicy ChangeEventEntityType = "policy"
+	ChangeEventEntityTypeUser   ChangeEventEntityType = "user"
+)
+
+// Defines values for GrantSpecDecision.
+const (
+	GrantSpecDecisionAllow GrantSpecDecision = "allow"
+	GrantSpecDecisionDeny  GrantSpecDecision = "deny"
+)
+
+// Defines values for PolicyTestResultResult.
+const (
+	PolicyTestResultResultError PolicyTestResultResult = "error"
+	PolicyTestResultResultFail  PolicyTestResultResult = "fail"
+	PolicyTestResultResultPass  PolicyTestResultResult = "pass"
+	PolicyTestResultResultSkip  PolicyTestResultResult = "skip"
+)
+
+// Defines values for RoleMemberKind.
+const (
+	RoleMemberKindGroup RoleMemberKind = "group"
+	RoleMemberKindUser  RoleMemberKind = "user"
+)
+
+// Defines values for SimResultAfter.
+const (
+	SimResultAfterAllow SimResultAfter = "allow"
+	SimResultAfterDeny  SimResultAfter = "deny"
+)
+
+// Defines values for SimResultBefore.
+const (
+	SimResultBeforeAllow SimResultBefore = "allow"
+	SimResultBeforeDeny  SimResultBefore = "deny"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Delivered WebhookDeliveryStatus = "delivered"
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookEndpointEvents.
+const (
+	GrantAllow    WebhookEndpointEvents = "grant.allow"
+	GrantDeny     WebhookEndpointEvents = "grant.deny"
+	GrantExpired  WebhookEndpointEvents = "grant.expired"
+	GrantExpiring WebhookEndpointEvents = "grant.expiring"
+)
+
+// Defines values for Entity.
+const (
+	EntityDevices     Entity = "devices"
+	EntityGrants      Entity = "grants"
+	EntityHierarchies Entity = "hierarchies"
+	EntityPolicies    Entity = "policies"
+	EntityUsers       Entity = "users"
+)
+
+// Defines values for Format.
+const (
+	FormatCsv   Format = "csv"
+	FormatJsonl Format = "jsonl"
+)
+
+// Defines values for Order.
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// Defines values for ListAuditLogParamsOrder.
+const (
+	ListAuditLogParamsOrderAsc  ListAuditLogParamsOrder = "asc"
+	ListAuditLogParamsOrderDesc ListAuditLogParamsOrder = "desc"
+)
+
+// Defines values for ExportDataParamsFormat.
+const (
+	ExportDataParamsFormatCsv   ExportDataParamsFormat = "csv"
+	ExportDataParamsFormatJsonl ExportDataParamsFormat = "jsonl"
+)
+
+// Defines values for ExportDataParamsEntity.
+const (
+	ExportDataParamsEntityDevices     ExportDataParamsEntity = "devices"
+	ExportDataParamsEntityGrants      ExportDataParamsEntity = "grants"
+	ExportDataParamsEntityHierarchies ExportDataParamsEntity = "hierarchies"
+	ExportDataParamsEntityPolicies    ExportDataParamsEntity = "policies"
+	ExportDataParamsEntityUsers       ExportDataParamsEntity = "users"
+)
+
+// Defines values for ImportDataParamsFormat.
+const (
+	Csv   ImportDataParamsFormat = "csv"
+	Jsonl ImportDataParamsFormat = "jsonl"
+)
+
+// Defines values for ImportDataParamsEntity.
+const (
+	Devices     ImportDataParamsEntity = "devices"
+	Grants      ImportDataParamsEntity = "grants"
+	Hierarchies ImportDataParamsEntity = "hierarchies"
+	Policies    ImportDataParamsEntity = "policies"
+	Users       ImportDataParamsEntity = "users"
+)
+
+// Defines values for ListDBAccessParamsOrder.
+const (
+	ListDBAccessParamsOrderAsc  ListDBAccessParamsOrder = "asc"
+	ListDBAccessParamsOrderDesc ListDBAccessParamsOrder = "desc"
+)
+
+// Defines values for ListDevicesParamsOrder.
+const (
+	ListDevicesParamsOrderAsc  ListDevicesParamsOrder = "asc"
+	ListDevicesParamsOrderDesc ListDevicesParamsOrder = "desc"
+)
+
+// Defines values for ListHierarchiesParamsOrder.
+const (
+	ListHierarchiesParamsOrderAsc  ListHierarchiesParamsOrder = "asc"
+	ListHierarchiesParamsOrderDesc ListHierarchiesParamsOrder = "desc"
+)
+
+// Defines values for ListPoliciesParamsOrder.
+const (
+	ListPoliciesParamsOrderAsc  ListPoliciesParamsOrder = "asc"
+	ListPoliciesParamsOrderDesc ListPoliciesParamsOrder = "desc"
+)
+
+// Defines values for ListUsersParamsOrder.
+const (
+	ListUsersParamsOrderAsc  ListUsersParamsOrder = "asc"
+	ListUsersParamsOrderDesc ListUsersParamsOrder = "desc"
+)
+
+// Defines values for ListAccessRequestsV1ParamsOrder.
+const (
+	ListAccessRequestsV1ParamsOrderAsc  ListAccessRequestsV1ParamsOrder = "asc"
+	ListAccessRequestsV1ParamsOrderDesc ListAccessRequestsV1ParamsOrder = "desc"
+)
+
+// Defines values for ListDevicesV1ParamsOrder.
+const (
+	ListDevicesV1ParamsOrderAsc  ListDevicesV1ParamsOrder = "asc"
+	ListDevicesV1ParamsOrderDesc ListDevicesV1ParamsOrder = "desc"
+)
+
+// Defines values for ListGrantsV1ParamsOrder.
+const (
+	ListGrantsV1ParamsOrderAsc  ListGrantsV1ParamsOrder = "asc"
+	ListGrantsV1ParamsOrderDesc ListGrantsV1ParamsOrder = "desc"
+)
+
+// Defines values for ListGrantHistoryV1ParamsOrder.
+const (
+	ListGrantHistoryV1ParamsOrderAsc  ListGrantHistoryV1ParamsOrder = "asc"
+	ListGrantHistoryV1ParamsOrderDesc ListGrantHistoryV1ParamsOrder = "desc"
+)
+
+// Defines values for ListHierarchiesV1ParamsOrder.
+const (
+	ListHierarchiesV1ParamsOrderAsc  ListHierarchiesV1ParamsOrder = "asc"
+	ListHierarchiesV1ParamsOrderDesc ListHierarchiesV1ParamsOrder = "desc"
+)
+
+// Defines values for ListObjectsV1ParamsOrder.
+const (
+	ListObjectsV1ParamsOrderAsc  ListObjectsV1ParamsOrder = "asc"
+	ListObjectsV1ParamsOrderDesc ListObjectsV1ParamsOrder = "desc"
+)
+
+// Defines values for ListPoliciesV1ParamsOrder.
+const (
+	ListPoliciesV1ParamsOrderAsc  ListPoliciesV1ParamsOrder = "asc"
+	ListPoliciesV1ParamsOrderDesc ListPoliciesV1ParamsOrder = "desc"
+)
+
+// Defines values for ListRolesV1ParamsOrder.
+const (
+	ListRolesV1ParamsOrderAsc  ListRolesV1ParamsOrder = "asc"
+	ListRolesV1ParamsOrderDesc ListRolesV1ParamsOrder = "desc"
+)
+
+// Defines values for UnassignRoleV1ParamsKind.
+const (
+	UnassignRoleV1ParamsKindGroup UnassignRoleV1ParamsKind = "group"
+	UnassignRoleV1ParamsKindUser  UnassignRoleV1ParamsKind = "user"
+)
+
+// Defines values for AssignRoleV1ParamsKind.
+const (
+	AssignRoleV1ParamsKindGroup AssignRoleV1ParamsKind = "group"
+	AssignRoleV1ParamsKindUser  AssignRoleV1ParamsKind = "user"
+)
+
+// Defines values for ListUsersV1ParamsOrder.
+const (
+	ListUsersV1ParamsOrderAsc  ListUsersV1ParamsOrder = "asc"
+	ListUsersV1ParamsOrderDesc ListUsersV1ParamsOrder = "desc"
+)
+
+// Defines values for ListWebhookDeliveriesParamsOrder.
+const (
+	Asc  ListWebhookDeliveriesParamsOrder = "asc"
+	Desc ListWebhookDeliveriesParamsOrder = "desc"
+)
+
+// AccessRequest defines model for AccessRequest.
+type AccessRequest struct {
+	CreatedAt string  `json:"created_at"`
+	Days      int     `json:"days"`
+	DecidedAt *string `json:"decided_at,omitempty"`
+	DecidedBy *string `json:"decided_by,omitempty"`
+
+	// History every transition, oldest first; only when reading one request
+	History   *[]AccessRequestEvent `json:"history,omitempty"`
+	Id        int64                 `json:"id"`
+	Reason    *string               `json:"reason,omitempty"`
+	Status    AccessRequestStatus   `json:"status"`
+	TableName string                `json:"table_name"`
+	UserId    string                `json:"user_id"`
+}
+
+// AccessRequestStatus defines model for AccessRequest.Status.
+type AccessRequestStatus string
+
+// AccessRequestDecision defines model for AccessRequestDecision.
+type AccessRequestDecision struct {
+	Comment *string `json:"comment,omitempty"`
+}
+
+// AccessRequestEvent defines model for AccessRequestEvent.
+type AccessRequestEvent struct {
+	Action  AccessRequestEventAction `json:"action"`
+	Actor   string                   `json:"actor"`
+	Comment *string                  `json:"comment,omitempty"`
+	Ts      string                   `json:"ts"`
+}
+
+// AccessRequestEventAction defines model for AccessRequestEvent.Action.
+type AccessRequestEventAction string
+
+// AccessRequestList defines model for AccessRequestList.
+type AccessRequestList struct {
+	Items []AccessRequest `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// ArchivedGrant A grant the expiry sweeper moved out of db_access.
+type ArchivedGrant struct {
+	ArchivedAt string `json:"archived_at"`
+
+	// DbAccessDate YYYY-MM-DD
+	DbAccessDate string `json:"db_access_date"`
+
+	// DbDenyDate YYYY-MM-DD
+	DbDenyDate string `json:"db_deny_date"`
+	Id         int64  `json:"id"`
+	TableName  string `json:"table_name"`
+	UserId     string `json:"user_id"`
+}
+
+// ArchivedGrantList defines model for ArchivedGrantList.
+type ArchivedGrantList struct {
+	Items []ArchivedGrant `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// AuditList defines model for AuditList.
+type AuditList struct {
+	Items []AuditRecord `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// AuditRecord defines model for AuditRecord.
+type AuditRecord struct {
+	Action     string               `json:"action"`
+	Actor      string               `json:"actor"`
+	After      *string              `json:"after,omitempty"`
+	Before     *string              `json:"before,omitempty"`
+	Decision   *AuditRecordDecision `json:"decision,omitempty"`
+	Hash       string               `json:"hash"`
+	Id         int64                `json:"id"`
+	PrevHash   string               `json:"prev_hash"`
+	RequestId  string               `json:"request_id"`
+	TargetId   string               `json:"target_id"`
+	TargetType string               `json:"target_type"`
+	Ts         string               `json:"ts"`
+}
+
+// AuditRecordDecision defines model for AuditRecord.Decision.
+type AuditRecordDecision string
+
+// ChangeEvent defines model for ChangeEvent.
+type ChangeEvent struct {
+	EntityId   string                `json:"entity_id"`
+	EntityType ChangeEventEntityType `json:"entity_type"`
+	Seq        int64                 `json:"seq"`
+	Ts         string                `json:"ts"`
+	Version    int64                 `json:"version"`
+}
+
+// ChangeEventEntityType defines model for ChangeEvent.EntityType.
+type ChangeEventEntityType string
+
+// DBAccess defines model for DBAccess.
+type DBAccess struct {
+	// DbAccessDate YYYY-MM-DD
+	DbAccessDate string `json:"db_access_date"`
+
+	// DbDenyDate YYYY-MM-DD
+	DbDenyDate string `json:"db_deny_date"`
+	TableName  string `json:"table_name"`
+	UserId     string `json:"user_id"`
+}
+
+// DBAccessList defines model for DBAccessList.
+type DBAccessList struct {
+	Items []DBAccess `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// DevActions defines model for DevActions.
+type DevActions struct {
+	Actions string `json:"actions"`
+	DevId   string `json:"dev_id"`
+}
+
+// DevAttrs Returned with Go field names; the type has no JSON tags.
+type DevAttrs struct {
+	Attrs string `json:"Attrs"`
+	DevId string `json:"Dev_id"`
+}
+
+// DevCheckInfo defines model for DevCheckInfo.
+type DevCheckInfo struct {
+	Attrs   string `json:"attrs"`
+	DevId   string `json:"dev_id"`
+	DevType string `json:"dev_type"`
+	Token   string `json:"token"`
+}
+
+// DevInfo defines model for DevInfo.
+type DevInfo struct {
+	Actions string `json:"actions"`
+	Attrs   string `json:"attrs"`
+	DevId   string `json:"dev_id"`
+	DevType string `json:"dev_type"`
+}
+
+// DeviceList defines model for DeviceList.
+type DeviceList struct {
+	Items []DevInfo `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// GrantSpec An allow takes exactly one of days, always and once; a deny takes days.
+type GrantSpec struct {
+	Always    *bool             `json:"always,omitempty"`
+	Days      *int              `json:"days,omitempty"`
+	Decision  GrantSpecDecision `json:"decision"`
+	Once      *bool             `json:"once,omitempty"`
+	TableName string            `json:"table_name"`
+}
+
+// GrantSpecDecision defines model for GrantSpec.Decision.
+type GrantSpecDecision string
+
+// GrantUpdate Fields left out are not changed; at least one is required.
+type GrantUpdate struct {
+	// DbAccessDate YYYY-MM-DD
+	DbAccessDate *string `json:"db_access_date,omitempty"`
+
+	// DbDenyDate YYYY-MM-DD
+	DbDenyDate *string `json:"db_deny_date,omitempty"`
+}
+
+// GroupMembers defines model for GroupMembers.
+type GroupMembers struct {
+	Group   string   `json:"group"`
+	Members []string `json:"members"`
+}
+
+// Hierarchy defines model for Hierarchy.
+type Hierarchy struct {
+	Action    string `json:"action"`
+	Hierarchy string `json:"hierarchy"`
+	ObjId     string `json:"obj_id"`
+}
+
+// HierarchyList defines model for HierarchyList.
+type HierarchyList struct {
+	Items []Hierarchy `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// HierarchyUpdate defines model for HierarchyUpdate.
+type HierarchyUpdate struct {
+	Hierarchy string `json:"hierarchy"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	Committed bool             `json:"committed"`
+	DryRun    bool             `json:"dry_run"`
+	Entity    string           `json:"entity"`
+	Errors    []ImportRowError `json:"errors"`
+	Failed    int              `json:"failed"`
+	Format    string           `json:"format"`
+	Total     int              `json:"total"`
+	Valid     int              `json:"valid"`
+}
+
+// ImportRowError defines model for ImportRowError.
+type ImportRowError struct {
+	Error string  `json:"error"`
+	Key   *string `json:"key,omitempty"`
+	Row   int     `json:"row"`
+}
+
+// InsertDevInfoFullRequest defines model for InsertDevInfoFullRequest.
+type InsertDevInfoFullRequest struct {
+	Action  string `json:"action"`
+	Attrs   string `json:"attrs"`
+	DevId   string `json:"dev_id"`
+	DevType string `json:"dev_type"`
+	Token   string `json:"token"`
+}
+
+// InsertPermInfoQueryRequest defines model for InsertPermInfoQueryRequest.
+type InsertPermInfoQueryRequest struct {
+	// DbAccessDate YYYY-MM-DD
+	DbAccessDate string `json:"db_access_date"`
+
+	// DbDenyDate YYYY-MM-DD
+	DbDenyDate string `json:"db_deny_date"`
+	TblName    string `json:"tbl_name"`
+	UserId     string `json:"user_id"`
+}
+
+// InsertPolicyRequest defines model for InsertPolicyRequest.
+type InsertPolicyRequest struct {
+	Content string  `json:"content"`
+	Ref     *string `json:"ref,omitempty"`
+}
+
+// InsertUserAttrsRequest defines model for InsertUserAttrsRequest.
+type InsertUserAttrsRequest struct {
+	Attrs    string `json:"attrs"`
+	Password string `json:"password"`
+	UserId   string `json:"user_id"`
+}
+
+// IssueGrantRequest defines model for IssueGrantRequest.
+type IssueGrantRequest struct {
+	Grants []GrantSpec `json:"grants"`
+
+	// TtlSeconds token lifetime, default 600
+	TtlSeconds *int   `json:"ttl_seconds,omitempty"`
+	UserId     string `json:"user_id"`
+}
+
+// IssuedGrant defines model for IssuedGrant.
+type IssuedGrant struct {
+	// ExpiresAt RFC 3339
+	ExpiresAt string `json:"expires_at"`
+
+	// IssuedAt RFC 3339
+	IssuedAt string `json:"issued_at"`
+	Issuer   string `json:"issuer"`
+	Jti      string `json:"jti"`
+
+	// Sub table:rule pairs separated by commas
+	Sub string `json:"sub"`
+
+	// Token HS256 signed grant token for /v1/grants/tokens
+	Token  string `json:"token"`
+	UserId string `json:"user_id"`
+}
+
+// JWTRequest defines model for JWTRequest.
+type JWTRequest struct {
+	// ClientMessage HS256 signed grant token
+	ClientMessage string `json:"client_message"`
+}
+
+// LineRange defines model for LineRange.
+type LineRange struct {
+	End   int `json:"end"`
+	Start int `json:"start"`
+}
+
+// NewAccessRequest defines model for NewAccessRequest.
+type NewAccessRequest struct {
+	Days      int     `json:"days"`
+	Reason    *string `json:"reason,omitempty"`
+	TableName string  `json:"table_name"`
+
+	// UserId the signed in user, who it defaults to; requests for anyone else are refused
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// ObjectList defines model for ObjectList.
+type ObjectList struct {
+	Items []ObjectNode `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// ObjectNode defines model for ObjectNode.
+type ObjectNode struct {
+	// Children only when reading one object
+	Children *[]string `json:"children,omitempty"`
+
+	// Kind e.g. building, floor, room or device
+	Kind  *string `json:"kind,omitempty"`
+	ObjId string  `json:"obj_id"`
+
+	// ParentId absent for a root
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Policies only when reading one object
+	Policies  *[]ObjectPolicy `json:"policies,omitempty"`
+	UpdatedAt *string         `json:"updated_at,omitempty"`
+}
+
+// ObjectPolicy defines model for ObjectPolicy.
+type ObjectPolicy struct {
+	Action string `json:"action"`
+
+	// PolicyRefs in evaluation order
+	PolicyRefs []string `json:"policy_refs"`
+}
+
+// Policy defines model for Policy.
+type Policy struct {
+	// Content Rego source
+	Content string `json:"content"`
+	Ref     string `json:"ref"`
+}
+
+// PolicyChain defines model for PolicyChain.
+type PolicyChain struct {
+	Action string `json:"action"`
+
+	// Chain from the root down to the object
+	Chain []PolicyChainLink `json:"chain"`
+	ObjId string            `json:"obj_id"`
+
+	// PolicyRefs the chain flattened in the same order, each ref once
+	PolicyRefs []string `json:"policy_refs"`
+}
+
+// PolicyChainLink defines model for PolicyChainLink.
+type PolicyChainLink struct {
+	ObjId      string   `json:"obj_id"`
+	PolicyRefs []string `json:"policy_refs"`
+}
+
+// PolicyList defines model for PolicyList.
+type PolicyList struct {
+	Items []Policy `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// PolicyRefs defines model for PolicyRefs.
+type PolicyRefs struct {
+	PolicyRefs []string `json:"policy_refs"`
+}
+
+// PolicyTest defines model for PolicyTest.
+type PolicyTest struct {
+	// Content Rego test module
+	Content string `json:"content"`
+
+	// Name matches ^[A-Za-z0-9_.-]+$, at most 64 characters
+	Name      *string `json:"name,omitempty"`
+	PolicyRef *string `json:"policy_ref,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// PolicyTestFailure defines model for PolicyTestFailure.
+type PolicyTestFailure struct {
+	Error  string           `json:"error"`
+	Report PolicyTestReport `json:"report"`
+}
+
+// PolicyTestReport defines model for PolicyTestReport.
+type PolicyTestReport struct {
+	// Coverage percent of the policy's lines the tests evaluate
+	Coverage     float32 `json:"coverage"`
+	CoveredLines int     `json:"covered_lines"`
+
+	// Error why the tests could not run, e.g. a module that does not compile
+	Error           *string            `json:"error,omitempty"`
+	Failed          int                `json:"failed"`
+	NotCovered      *[]LineRange       `json:"not_covered,omitempty"`
+	NotCoveredLines int                `json:"not_covered_lines"`
+	Passed          bool               `json:"passed"`
+	PolicyRef       string             `json:"policy_ref"`
+	Results         []PolicyTestResult `json:"results"`
+	Total           int                `json:"total"`
+}
+
+// PolicyTestResult defines model for PolicyTestResult.
+type PolicyTestResult struct {
+	DurationMs float32                `json:"duration_ms"`
+	Error      *string                `json:"error,omitempty"`
+	Name       string                 `json:"name"`
+	Package    string                 `json:"package"`
+	Result     PolicyTestResultResult `json:"result"`
+
+	// Test the stored test module the rule is in
+	Test string `json:"test"`
+}
+
+// PolicyTestResultResult defines model for PolicyTestResult.Result.
+type PolicyTestResultResult string
+
+// PolicyUpdate defines model for PolicyUpdate.
+type PolicyUpdate struct {
+	Content string `json:"content"`
+}
+
+// PoolStats defines model for PoolStats.
+type PoolStats struct {
+	Idle              int     `json:"idle"`
+	InUse             int     `json:"in_use"`
+	MaxIdleClosed     int64   `json:"max_idle_closed"`
+	MaxLifetimeClosed int64   `json:"max_lifetime_closed"`
+	MaxOpen           int     `json:"max_open"`
+	Open              int     `json:"open"`
+	WaitCount         int64   `json:"wait_count"`
+	WaitSeconds       float32 `json:"wait_seconds"`
+}
+
+// ReadyReport defines model for ReadyReport.
+type ReadyReport struct {
+	Checks map[string]string `json:"checks"`
+	Status string            `json:"status"`
+}
+
+// Role defines model for Role.
+type Role struct {
+	// Attrs JSON object of attribute defaults; a user's own attributes take precedence
+	Attrs       *string      `json:"attrs,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Grants      *[]RoleGrant `json:"grants,omitempty"`
+
+	// Members only when reading one role; ignored on writes
+	Members   *[]RoleMember `json:"members,omitempty"`
+	Name      string        `json:"name"`
+	UpdatedAt *string       `json:"updated_at,omitempty"`
+}
+
+// RoleGrant defines model for RoleGrant.
+type RoleGrant struct {
+	// DbAccessDate YYYY-MM-DD; the role grants the table until this date, default 9999-12-31
+	DbAccessDate *string `json:"db_access_date,omitempty"`
+	TableName    string  `json:"table_name"`
+}
+
+// RoleList defines model for RoleList.
+type RoleList struct {
+	Items []Role `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// RoleMember defines model for RoleMember.
+type RoleMember struct {
+	Id   string         `json:"id"`
+	Kind RoleMemberKind `json:"kind"`
+}
+
+// RoleMemberKind defines model for RoleMember.Kind.
+type RoleMemberKind string
+
+// ServerMessage defines model for ServerMessage.
+type ServerMessage struct {
+	ServerMessage string `json:"server_message"`
+}
+
+// SimResult defines model for SimResult.
+type SimResult struct {
+	Action  string           `json:"action"`
+	After   *SimResultAfter  `json:"after,omitempty"`
+	Before  *SimResultBefore `json:"before,omitempty"`
+	Error   *string          `json:"error,omitempty"`
+	Flipped bool             `json:"flipped"`
+	Object  string           `json:"object"`
+	Subject string           `json:"subject"`
+}
+
+// SimResultAfter defines model for SimResult.After.
+type SimResultAfter string
+
+// SimResultBefore defines model for SimResult.Before.
+type SimResultBefore string
+
+// SimTuple defines model for SimTuple.
+type SimTuple struct {
+	Action string `json:"action"`
+
+	// Object object id
+	Object string `json:"object"`
+
+	// Subject user id
+	Subject string `json:"subject"`
+}
+
+// SimulateReport defines model for SimulateReport.
+type SimulateReport struct {
+	Errors    int         `json:"errors"`
+	Evaluated int         `json:"evaluated"`
+	Flipped   int         `json:"flipped"`
+	Results   []SimResult `json:"results"`
+}
+
+// SimulateRequest Nothing is stored. At most 100 tuples. Logged access checks cannot be replayed: they decide tables by grant date, and tables are not objects in the tree.
+type SimulateRequest struct {
+	// Policies proposed contents by ref
+	Policies []Policy `json:"policies"`
+
+	Tuples []SimTuple `json:"tuples"`
+
+	// Users proposed attributes by user_id
+	Users []UserAttrs `json:"users"`
+}
+
+// StatusReport defines model for StatusReport.
+type StatusReport struct {
+	Config        map[string]string `json:"config"`
+	GoVersion     string            `json:"go_version"`
+	Policies      int               `json:"policies"`
+	Pool          PoolStats         `json:"pool"`
+	Revision      *string           `json:"revision,omitempty"`
+	SchemaVersion int               `json:"schema_version"`
+
+	// StartedAt RFC 3339
+	StartedAt     string `json:"started_at"`
+	UptimeSeconds int64  `json:"uptime_seconds"`
+	Version       string `json:"version"`
+}
+
+// UpdateSecureDBAllowRequest defines model for UpdateSecureDBAllowRequest.
+type UpdateSecureDBAllowRequest struct {
+	// DbAccessDate YYYY-MM-DD
+	DbAccessDate string `json:"db_access_date"`
+	TblName      string `json:"tbl_name"`
+	UserId       string `json:"user_id"`
+}
+
+// UpdateSecureDBDenyRequest defines model for UpdateSecureDBDenyRequest.
+type UpdateSecureDBDenyRequest struct {
+	// DbDenyDate YYYY-MM-DD
+	DbDenyDate string `json:"db_deny_date"`
+	TblName    string `json:"tbl_name"`
+	UserId     string `json:"user_id"`
+}
+
+// UserAttrs defines model for UserAttrs.
+type UserAttrs struct {
+	// Attrs JSON object of user attributes, stored as text
+	Attrs  string `json:"attrs"`
+	UserId string `json:"user_id"`
+}
+
+// UserAttrsUpdate defines model for UserAttrsUpdate.
+type UserAttrsUpdate struct {
+	Attrs string `json:"attrs"`
+}
+
+// UserCheckInfo defines model for UserCheckInfo.
+type UserCheckInfo struct {
+	Password string `json:"password"`
+	UserId   string `json:"user_id"`
+}
+
+// UserList defines model for UserList.
+type UserList struct {
+	Items []UserAttrs `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// UserRole defines model for UserRole.
+type UserRole struct {
+	Role string `json:"role"`
+
+	// Via user for a direct assignment, group:NAME through a group
+	Via string `json:"via"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts      int     `json:"attempts"`
+	CreatedAt     string  `json:"created_at"`
+	DeliveredAt   *string `json:"delivered_at,omitempty"`
+	EventId       string  `json:"event_id"`
+	EventType     string  `json:"event_type"`
+	Id            int64   `json:"id"`
+	LastError     *string `json:"last_error,omitempty"`
+	LastStatus    *int    `json:"last_status,omitempty"`
+	NextAttemptAt string  `json:"next_attempt_at"`
+
+	// Payload the WebhookEvent JSON that is POSTed
+	Payload string                `json:"payload"`
+	Status  WebhookDeliveryStatus `json:"status"`
+	Webhook string                `json:"webhook"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Items []WebhookDelivery `json:"items"`
+
+	// NextCursor pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// WebhookEndpoint A configured webhook endpoint. The secret is never returned.
+type WebhookEndpoint struct {
+	Events      *[]WebhookEndpointEvents `json:"events,omitempty"`
+	MaxAttempts *int                     `json:"max_attempts,omitempty"`
+	Name        string                   `json:"name"`
+	Url         string                   `json:"url"`
+}
+
+// WebhookEndpointEvents defines model for WebhookEndpoint.Events.
+type WebhookEndpointEvents string
+
+// WriteResult defines model for WriteResult.
+type WriteResult struct {
+	Rows int64 `json:"rows"`
+}
+
+// Cursor defines model for cursor.
+type Cursor = string
+
+// Entity defines model for entity.
+type Entity string
+
+// Format defines model for format.
+type Format string
+
+// Limit defines model for limit.
+type Limit = int
+
+// Order defines model for order.
+type Order string
+
+// Sort defines model for sort.
+type Sort = string
+
+// ListAuditLogParams defines parameters for ListAuditLog.
+type ListAuditLogParams struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListAuditLogParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Actor exact actor
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action exact action
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// TargetType exact target_type
+	TargetType *string `form:"target_type,omitempty" json:"target_type,omitempty"`
+
+	// TargetId exact target_id
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
+
+	// Decision exact decision
+	Decision *string `form:"decision,omitempty" json:"decision,omitempty"`
+
+	// RequestId exact request_id
+	RequestId *string `form:"request_id,omitempty" json:"request_id,omitempty"`
+
+	// Since records at or after this RFC 3339 time or date
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until records before this RFC 3339 time or date
+	Until *string `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// ListAuditLogParamsOrder defines parameters for ListAuditLog.
+type ListAuditLogParamsOrder string
+
+// StreamChangesParams defines parameters for StreamChanges.
+type StreamChangesParams struct {
+	// Since resume after this seq
+	Since *int64 `form:"since,omitempty" json:"since,omitempty"`
+
+	// Entity comma separated entity types: policy, user, device
+	Entity      *string `form:"entity,omitempty" json:"entity,omitempty"`
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// StreamChangesWSParams defines parameters for StreamChangesWS.
+type StreamChangesWSParams struct {
+	// Since resume after this seq
+	Since *int64 `form:"since,omitempty" json:"since,omitempty"`
+
+	// Entity comma separated entity types: policy, user, device
+	Entity *string `form:"entity,omitempty" json:"entity,omitempty"`
+}
+
+// ExportDataParams defines parameters for ExportData.
+type ExportDataParams struct {
+	// Format bulk file format
+	Format *ExportDataParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportDataParamsFormat defines parameters for ExportData.
+type ExportDataParamsFormat string
+
+// ExportDataParamsEntity defines parameters for ExportData.
+type ExportDataParamsEntity string
+
+// ImportDataParams defines parameters for ImportData.
+type ImportDataParams struct {
+	// Format bulk file format
+	Format *ImportDataParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// DryRun validate without writing
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ImportDataParamsFormat defines parameters for ImportData.
+type ImportDataParamsFormat string
+
+// ImportDataParamsEntity defines parameters for ImportData.
+type ImportDataParamsEntity string
+
+// ListDBAccessParams defines parameters for ListDBAccess.
+type ListDBAccessParams struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListDBAccessParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// UserId exact user id
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TableName exact table name
+	TableName *string `form:"table_name,omitempty" json:"table_name,omitempty"`
+
+	// ExpiresBefore db_access_date before this date
+	ExpiresBefore *string `form:"expires_before,omitempty" json:"expires_before,omitempty"`
+
+	// ExpiresAfter db_access_date after this date
+	ExpiresAfter *string `form:"expires_after,omitempty" json:"expires_after,omitempty"`
+
+	// DeniedBefore db_deny_date before this date
+	DeniedBefore *string `form:"denied_before,omitempty" json:"denied_before,omitempty"`
+
+	// DeniedAfter db_deny_date after this date
+	DeniedAfter *string `form:"denied_after,omitempty" json:"denied_after,omitempty"`
+}
+
+// ListDBAccessParamsOrder defines parameters for ListDBAccess.
+type ListDBAccessParamsOrder string
+
+// ListDevicesParams defines parameters for ListDevices.
+type ListDevicesParams struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListDevicesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Prefix dev_id prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// DevType exact device type
+	DevType *string `form:"dev_type,omitempty" json:"dev_type,omitempty"`
+}
+
+// ListDevicesParamsOrder defines parameters for ListDevices.
+type ListDevicesParamsOrder string
+
+// ListHierarchiesParams defines parameters for ListHierarchies.
+type ListHierarchiesParams struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListHierarchiesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// ObjId exact object id
+	ObjId *string `form:"obj_id,omitempty" json:"obj_id,omitempty"`
+
+	// Action exact action
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+}
+
+// ListHierarchiesParamsOrder defines parameters for ListHierarchies.
+type ListHierarchiesParamsOrder string
+
+// ListPoliciesParams defines parameters for ListPolicies.
+type ListPoliciesParams struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListPoliciesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Prefix ref prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+}
+
+// ListPoliciesParamsOrder defines parameters for ListPolicies.
+type ListPoliciesParamsOrder string
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListUsersParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Prefix user_id prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// AttrKey only users whose attrs have this key
+	AttrKey *string `form:"attr_key,omitempty" json:"attr_key,omitempty"`
+
+	// AttrValue with attr_key: only users whose attr_key equals this value
+	AttrValue *string `form:"attr_value,omitempty" json:"attr_value,omitempty"`
+}
+
+// ListUsersParamsOrder defines parameters for ListUsers.
+type ListUsersParamsOrder string
+
+// ListAccessRequestsV1Params defines parameters for ListAccessRequestsV1.
+type ListAccessRequestsV1Params struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListAccessRequestsV1ParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// UserId exact user id
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TableName exact table name
+	TableName *string `form:"table_name,omitempty" json:"table_name,omitempty"`
+
+	// Status pending, approved or rejected
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListAccessRequestsV1ParamsOrder defines parameters for ListAccessRequestsV1.
+type ListAccessRequestsV1ParamsOrder string
+
+// ListDevicesV1Params defines parameters for ListDevicesV1.
+type ListDevicesV1Params struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListDevicesV1ParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Prefix dev_id prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// DevType exact device type
+	DevType *string `form:"dev_type,omitempty" json:"dev_type,omitempty"`
+}
+
+// ListDevicesV1ParamsOrder defines parameters for ListDevicesV1.
+type ListDevicesV1ParamsOrder string
+
+// ListGrantsV1Params defines parameters for ListGrantsV1.
+type ListGrantsV1Params struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListGrantsV1ParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// UserId exact user id
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TableName exact table name
+	TableName *string `form:"table_name,omitempty" json:"table_name,omitempty"`
+
+	// ExpiresBefore db_access_date before this date
+	ExpiresBefore *string `form:"expires_before,omitempty" json:"expires_before,omitempty"`
+
+	// ExpiresAfter db_access_date after this date
+	ExpiresAfter *string `form:"expires_after,omitempty" json:"expires_after,omitempty"`
+
+	// DeniedBefore db_deny_date before this date
+	DeniedBefore *string `form:"denied_before,omitempty" json:"denied_before,omitempty"`
+
+	// DeniedAfter db_deny_date after this date
+	DeniedAfter *string `form:"denied_after,omitempty" json:"denied_after,omitempty"`
+}
+
+// ListGrantsV1ParamsOrder defines parameters for ListGrantsV1.
+type ListGrantsV1ParamsOrder string
+
+// ListGrantHistoryV1Params defines parameters for ListGrantHistoryV1.
+type ListGrantHistoryV1Params struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListGrantHistoryV1ParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// UserId exact user id
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TableName exact table name
+	TableName *string `form:"table_name,omitempty" json:"table_name,omitempty"`
+}
+
+// ListGrantHistoryV1ParamsOrder defines parameters for ListGrantHistoryV1.
+type ListGrantHistoryV1ParamsOrder string
+
+// ListHierarchiesV1Params defines parameters for ListHierarchiesV1.
+type ListHierarchiesV1Params struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListHierarchiesV1ParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// ObjId exact object id
+	ObjId *string `form:"obj_id,omitempty" json:"obj_id,omitempty"`
+
+	// Action exact action
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+}
+
+// ListHierarchiesV1ParamsOrder defines parameters for ListHierarchiesV1.
+type ListHierarchiesV1ParamsOrder string
+
+// ListObjectsV1Params defines parameters for ListObjectsV1.
+type ListObjectsV1Params struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListObjectsV1ParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Prefix obj_id prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// ParentId exact parent
+	ParentId *string `form:"parent_id,omitempty" json:"parent_id,omitempty"`
+
+	// Kind exact kind
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// Root true lists objects without a parent
+	Root *string `form:"root,omitempty" json:"root,omitempty"`
+}
+
+// ListObjectsV1ParamsOrder defines parameters for ListObjectsV1.
+type ListObjectsV1ParamsOrder string
+
+// ListPoliciesV1Params defines parameters for ListPoliciesV1.
+type ListPoliciesV1Params struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListPoliciesV1ParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Prefix ref prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+}
+
+// ListPoliciesV1ParamsOrder defines parameters for ListPoliciesV1.
+type ListPoliciesV1ParamsOrder string
+
+// ListRolesV1Params defines parameters for ListRolesV1.
+type ListRolesV1Params struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListRolesV1ParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Prefix role name prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+}
+
+// ListRolesV1ParamsOrder defines parameters for ListRolesV1.
+type ListRolesV1ParamsOrder string
+
+// UnassignRoleV1ParamsKind defines parameters for UnassignRoleV1.
+type UnassignRoleV1ParamsKind string
+
+// AssignRoleV1ParamsKind defines parameters for AssignRoleV1.
+type AssignRoleV1ParamsKind string
+
+// SimulateV1Params defines parameters for SimulateV1.
+type SimulateV1Params struct {
+	// Flipped true lists only flipped and failed decisions
+	Flipped *string `form:"flipped,omitempty" json:"flipped,omitempty"`
+}
+
+// ListUsersV1Params defines parameters for ListUsersV1.
+type ListUsersV1Params struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListUsersV1ParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Prefix user_id prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// AttrKey only users whose attrs have this key
+	AttrKey *string `form:"attr_key,omitempty" json:"attr_key,omitempty"`
+
+	// AttrValue with attr_key: only users whose attr_key equals this value
+	AttrValue *string `form:"attr_value,omitempty" json:"attr_value,omitempty"`
+}
+
+// ListUsersV1ParamsOrder defines parameters for ListUsersV1.
+type ListUsersV1ParamsOrder string
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Limit page size, at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort column to sort by; defaults to the first key column
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort direction
+	Order *ListWebhookDeliveriesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Webhook exact webhook
+	Webhook *string `form:"webhook,omitempty" json:"webhook,omitempty"`
+
+	// Status exact status
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// EventType exact event_type
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty"`
+
+	// EventId exact event_id
+	EventId *string `form:"event_id,omitempty" json:"event_id,omitempty"`
+}
+
+// ListWebhookDeliveriesParamsOrder defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParamsOrder string
+
+// InsertPermInfoJSONRequestBody defines body for InsertPermInfo for application/json ContentType.
+type InsertPermInfoJSONRequestBody = InsertPermInfoQueryRequest
+
+// InsertUserAttrsJSONRequestBody defines body for InsertUserAttrs for application/json ContentType.
+type InsertUserAttrsJSONRequestBody = InsertPolicyRequest
+
+// SendJWTJSONRequestBody defines body for SendJWT for application/json ContentType.
+type SendJWTJSONRequestBody = JWTRequest
+
+// UpdateDBAllowJSONRequestBody defines body for UpdateDBAllow for application/json ContentType.
+type UpdateDBAllowJSONRequestBody = UpdateSecureDBAllowRequest
+
+// UpdateDBDenyJSONRequestBody defines body for UpdateDBDeny for application/json ContentType.
+type UpdateDBDenyJSONRequestBody = UpdateSecureDBDenyRequest
+
+// SubmitAccessRequestV1JSONRequestBody defines body for SubmitAccessRequestV1 for application/json ContentType.
+type SubmitAccessRequestV1JSONRequestBody = NewAccessRequest
+
+// ApproveAccessRequestV1JSONRequestBody defines body for ApproveAccessRequestV1 for application/json ContentType.
+type ApproveAccessRequestV1JSONRequestBody = AccessRequestDecision
+
+// RejectAccessRequestV1JSONRequestBody defines body for RejectAccessRequestV1 for application/json ContentType.
+type RejectAccessRequestV1JSONRequestBody = AccessRequestDecision
+
+// CreateDeviceV1JSONRequestBody defines body for CreateDeviceV1 for application/json ContentType.
+type CreateDeviceV1JSONRequestBody = InsertDevInfoFullRequest
+
+// CreateGrantV1JSONRequestBody defines body for CreateGrantV1 for application/json ContentType.
+type CreateGrantV1JSONRequestBody = DBAccess
+
+// SubmitGrantV1JSONRequestBody defines body for SubmitGrantV1 for application/json ContentType.
+type SubmitGrantV1JSONRequestBody = JWTRequest
+
+// IssueGrantV1JSONRequestBody defines body for IssueGrantV1 for application/json ContentType.
+type IssueGrantV1JSONRequestBody = IssueGrantRequest
+
+// UpdateGrantV1JSONRequestBody defines body for UpdateGrantV1 for application/json ContentType.
+type UpdateGrantV1JSONRequestBody = GrantUpdate
+
+// CreateHierarchyV1JSONRequestBody defines body for CreateHierarchyV1 for application/json ContentType.
+type CreateHierarchyV1JSONRequestBody = Hierarchy
+
+// UpdateHierarchyV1JSONRequestBody defines body for UpdateHierarchyV1 for application/json ContentType.
+type UpdateHierarchyV1JSONRequestBody = HierarchyUpdate
+
+// CreateObjectV1JSONRequestBody defines body for CreateObjectV1 for application/json ContentType.
+type CreateObjectV1JSONRequestBody = ObjectNode
+
+// UpdateObjectV1JSONRequestBody defines body for UpdateObjectV1 for application/json ContentType.
+type UpdateObjectV1JSONRequestBody = ObjectNode
+
+// SetObjectPoliciesV1JSONRequestBody defines body for SetObjectPoliciesV1 for application/json ContentType.
+type SetObjectPoliciesV1JSONRequestBody = PolicyRefs
+
+// CreatePolicyV1JSONRequestBody defines body for CreatePolicyV1 for application/json ContentType.
+type CreatePolicyV1JSONRequestBody = Policy
+
+// UpdatePolicyV1JSONRequestBody defines body for UpdatePolicyV1 for application/json ContentType.
+type UpdatePolicyV1JSONRequestBody = PolicyUpdate
+
+// RunPolicyTestsV1JSONRequestBody defines body for RunPolicyTestsV1 for application/json ContentType.
+type RunPolicyTestsV1JSONRequestBody = PolicyUpdate
+
+// PutPolicyTestV1JSONRequestBody defines body for PutPolicyTestV1 for application/json ContentType.
+type PutPolicyTestV1JSONRequestBody = PolicyTest
+
+// CreateRoleV1JSONRequestBody defines body for CreateRoleV1 for application/json ContentType.
+type CreateRoleV1JSONRequestBody = Role
+
+// UpdateRoleV1JSONRequestBody defines body for UpdateRoleV1 for application/json ContentType.
+type UpdateRoleV1JSONRequestBody = Role
+
+// SimulateV1JSONRequestBody defines body for SimulateV1 for application/json ContentType.
+type SimulateV1JSONRequestBody = SimulateRequest
+
+// CreateUserV1JSONRequestBody defines body for CreateUserV1 for application/json ContentType.
+type CreateUserV1JSONRequestBody = InsertUserAttrsRequest
+
+// UpdateUserV1JSONRequestBody defines body for UpdateUserV1 for application/json ContentType.
+type UpdateUserV1JSONRequestBody = UserAttrsUpdate
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListAuditLog request
+	ListAuditLog(ctx context.Context, params *ListAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamChanges request
+	StreamChanges(ctx context.Context, params *StreamChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamChangesWS request
+	StreamChangesWS(ctx context.Context, params *StreamChangesWSParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DebugStatus request
+	DebugStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportData request
+	ExportData(ctx context.Context, entity ExportDataParamsEntity, params *ExportDataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindDBAccess request
+	FindDBAccess(ctx context.Context, userId string, tableName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindDevActions request
+	FindDevActions(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindDevAttrs request
+	FindDevAttrs(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindDevCheckInfo request
+	FindDevCheckInfo(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindHierarchy request
+	FindHierarchy(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindPolicy request
+	FindPolicy(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindUserAttrs request
+	FindUserAttrs(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindUserCheckInfo request
+	FindUserCheckInfo(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Healthz request
+	Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportDataWithBody request with any body
+	ImportDataWithBody(ctx context.Context, entity ImportDataParamsEntity, params *ImportDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InsertPermInfoWithBody request with any body
+	InsertPermInfoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InsertPermInfo(ctx context.Context, body InsertPermInfoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InsertUserAttrsWithBody request with any body
+	InsertUserAttrsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InsertUserAttrs(ctx context.Context, body InsertUserAttrsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SendJWTWithBody request with any body
+	SendJWTWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SendJWT(ctx context.Context, body SendJWTJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDBAccess request
+	ListDBAccess(ctx context.Context, params *ListDBAccessParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDevices request
+	ListDevices(ctx context.Context, params *ListDevicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListHierarchies request
+	ListHierarchies(ctx context.Context, params *ListHierarchiesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPolicies request
+	ListPolicies(ctx context.Context, params *ListPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUsers request
+	ListUsers(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Metrics request
+	Metrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OpenAPI request
+	OpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Readyz request
+	Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDBAllowWithBody request with any body
+	UpdateDBAllowWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDBAllow(ctx context.Context, body UpdateDBAllowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDBDenyWithBody request with any body
+	UpdateDBDenyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDBDeny(ctx context.Context, body UpdateDBDenyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAccessRequestsV1 request
+	ListAccessRequestsV1(ctx context.Context, params *ListAccessRequestsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitAccessRequestV1WithBody request with any body
+	SubmitAccessRequestV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitAccessRequestV1(ctx context.Context, body SubmitAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccessRequestV1 request
+	GetAccessRequestV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveAccessRequestV1WithBody request with any body
+	ApproveAccessRequestV1WithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApproveAccessRequestV1(ctx context.Context, id int64, body ApproveAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RejectAccessRequestV1WithBody request with any body
+	RejectAccessRequestV1WithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RejectAccessRequestV1(ctx context.Context, id int64, body RejectAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDevicesV1 request
+	ListDevicesV1(ctx context.Context, params *ListDevicesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDeviceV1WithBody request with any body
+	CreateDeviceV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDeviceV1(ctx context.Context, body CreateDeviceV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeviceV1 request
+	GetDeviceV1(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeviceActionsV1 request
+	GetDeviceActionsV1(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeviceCheckInfoV1 request
+	GetDeviceCheckInfoV1(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListGrantsV1 request
+	ListGrantsV1(ctx context.Context, params *ListGrantsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateGrantV1WithBody request with any body
+	CreateGrantV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateGrantV1(ctx context.Context, body CreateGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListGrantHistoryV1 request
+	ListGrantHistoryV1(ctx context.Context, params *ListGrantHistoryV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitGrantV1WithBody request with any body
+	SubmitGrantV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitGrantV1(ctx context.Context, body SubmitGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// IssueGrantV1WithBody request with any body
+	IssueGrantV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	IssueGrantV1(ctx context.Context, body IssueGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetGrantV1 request
+	GetGrantV1(ctx context.Context, userId string, tableName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateGrantV1WithBody request with any body
+	UpdateGrantV1WithBody(ctx context.Context, userId string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateGrantV1(ctx context.Context, userId string, tableName string, body UpdateGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetGroupV1 request
+	GetGroupV1(ctx context.Context, group string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveGroupMemberV1 request
+	RemoveGroupMemberV1(ctx context.Context, group string, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddGroupMemberV1 request
+	AddGroupMemberV1(ctx context.Context, group string, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListHierarchiesV1 request
+	ListHierarchiesV1(ctx context.Context, params *ListHierarchiesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateHierarchyV1WithBody request with any body
+	CreateHierarchyV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateHierarchyV1(ctx context.Context, body CreateHierarchyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHierarchyV1 request
+	GetHierarchyV1(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateHierarchyV1WithBody request with any body
+	UpdateHierarchyV1WithBody(ctx context.Context, objId string, action string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateHierarchyV1(ctx context.Context, objId string, action string, body UpdateHierarchyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListObjectsV1 request
+	ListObjectsV1(ctx context.Context, params *ListObjectsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateObjectV1WithBody request with any body
+	CreateObjectV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateObjectV1(ctx context.Context, body CreateObjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteObjectV1 request
+	DeleteObjectV1(ctx context.Context, objId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetObjectV1 request
+	GetObjectV1(ctx context.Context, objId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateObjectV1WithBody request with any body
+	UpdateObjectV1WithBody(ctx context.Context, objId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateObjectV1(ctx context.Context, objId string, body UpdateObjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPolicyChainV1 request
+	GetPolicyChainV1(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetObjectPoliciesV1WithBody request with any body
+	SetObjectPoliciesV1WithBody(ctx context.Context, objId string, action string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetObjectPoliciesV1(ctx context.Context, objId string, action string, body SetObjectPoliciesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPoliciesV1 request
+	ListPoliciesV1(ctx context.Context, params *ListPoliciesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePolicyV1WithBody request with any body
+	CreatePolicyV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePolicyV1(ctx context.Context, body CreatePolicyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPolicyV1 request
+	GetPolicyV1(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePolicyV1WithBody request with any body
+	UpdatePolicyV1WithBody(ctx context.Context, ref string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePolicyV1(ctx context.Context, ref string, body UpdatePolicyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPolicyTestsV1 request
+	ListPolicyTestsV1(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RunPolicyTestsV1WithBody request with any body
+	RunPolicyTestsV1WithBody(ctx context.Context, ref string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RunPolicyTestsV1(ctx context.Context, ref string, body RunPolicyTestsV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePolicyTestV1 request
+	DeletePolicyTestV1(ctx context.Context, ref string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutPolicyTestV1WithBody request with any body
+	PutPolicyTestV1WithBody(ctx context.Context, ref string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutPolicyTestV1(ctx context.Context, ref string, name string, body PutPolicyTestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRolesV1 request
+	ListRolesV1(ctx context.Context, params *ListRolesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRoleV1WithBody request with any body
+	CreateRoleV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRoleV1(ctx context.Context, body CreateRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRoleV1 request
+	DeleteRoleV1(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRoleV1 request
+	GetRoleV1(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRoleV1WithBody request with any body
+	UpdateRoleV1WithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRoleV1(ctx context.Context, name string, body UpdateRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnassignRoleV1 request
+	UnassignRoleV1(ctx context.Context, name string, kind UnassignRoleV1ParamsKind, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AssignRoleV1 request
+	AssignRoleV1(ctx context.Context, name string, kind AssignRoleV1ParamsKind, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimulateV1WithBody request with any body
+	SimulateV1WithBody(ctx context.Context, params *SimulateV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimulateV1(ctx context.Context, params *SimulateV1Params, body SimulateV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUsersV1 request
+	ListUsersV1(ctx context.Context, params *ListUsersV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserV1WithBody request with any body
+	CreateUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUserV1(ctx context.Context, body CreateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserV1 request
+	GetUserV1(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserV1WithBody request with any body
+	UpdateUserV1WithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUserV1(ctx context.Context, userId string, body UpdateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserCheckInfoV1 request
+	GetUserCheckInfoV1(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserRolesV1 request
+	GetUserRolesV1(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhooks request
+	ListWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhookDeliveries request
+	ListWebhookDeliveries(ctx context.Context, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindWebhookDelivery request
+	FindWebhookDelivery(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RetryWebhookDelivery request
+	RetryWebhookDelivery(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListAuditLog(ctx context.Context, params *ListAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditLogRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamChanges(ctx context.Context, params *StreamChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamChangesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamChangesWS(ctx context.Context, params *StreamChangesWSParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamChangesWSRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DebugStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDebugStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportData(ctx context.Context, entity ExportDataParamsEntity, params *ExportDataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportDataRequest(c.Server, entity, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindDBAccess(ctx context.Context, userId string, tableName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindDBAccessRequest(c.Server, userId, tableName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindDevActions(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindDevActionsRequest(c.Server, devId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindDevAttrs(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindDevAttrsRequest(c.Server, devId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindDevCheckInfo(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindDevCheckInfoRequest(c.Server, devId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindHierarchy(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindHierarchyRequest(c.Server, objId, action)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindPolicy(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindPolicyRequest(c.Server, ref)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindUserAttrs(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindUserAttrsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindUserCheckInfo(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindUserCheckInfoRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportDataWithBody(ctx context.Context, entity ImportDataParamsEntity, params *ImportDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDataRequestWithBody(c.Server, entity, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InsertPermInfoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInsertPermInfoRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InsertPermInfo(ctx context.Context, body InsertPermInfoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInsertPermInfoRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InsertUserAttrsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInsertUserAttrsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InsertUserAttrs(ctx context.Context, body InsertUserAttrsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInsertUserAttrsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SendJWTWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendJWTRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SendJWT(ctx context.Context, body SendJWTJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendJWTRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListDBAccess(ctx context.Context, params *ListDBAccessParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDBAccessRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListDevices(ctx context.Context, params *ListDevicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDevicesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListHierarchies(ctx context.Context, params *ListHierarchiesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListHierarchiesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPolicies(ctx context.Context, params *ListPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPoliciesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListUsers(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Metrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetricsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOpenAPIRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadyzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDBAllowWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDBAllowRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDBAllow(ctx context.Context, body UpdateDBAllowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDBAllowRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDBDenyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDBDenyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDBDeny(ctx context.Context, body UpdateDBDenyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDBDenyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAccessRequestsV1(ctx context.Context, params *ListAccessRequestsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAccessRequestsV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitAccessRequestV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitAccessRequestV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitAccessRequestV1(ctx context.Context, body SubmitAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitAccessRequestV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAccessRequestV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccessRequestV1Request(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveAccessRequestV1WithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveAccessRequestV1RequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveAccessRequestV1(ctx context.Context, id int64, body ApproveAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveAccessRequestV1Request(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RejectAccessRequestV1WithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectAccessRequestV1RequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RejectAccessRequestV1(ctx context.Context, id int64, body RejectAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectAccessRequestV1Request(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListDevicesV1(ctx context.Context, params *ListDevicesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDevicesV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDeviceV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDeviceV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDeviceV1(ctx context.Context, body CreateDeviceV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDeviceV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDeviceV1(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeviceV1Request(c.Server, devId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDeviceActionsV1(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeviceActionsV1Request(c.Server, devId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDeviceCheckInfoV1(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeviceCheckInfoV1Request(c.Server, devId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListGrantsV1(ctx context.Context, params *ListGrantsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListGrantsV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateGrantV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateGrantV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateGrantV1(ctx context.Context, body CreateGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateGrantV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListGrantHistoryV1(ctx context.Context, params *ListGrantHistoryV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListGrantHistoryV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitGrantV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitGrantV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitGrantV1(ctx context.Context, body SubmitGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitGrantV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IssueGrantV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueGrantV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IssueGrantV1(ctx context.Context, body IssueGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueGrantV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetGrantV1(ctx context.Context, userId string, tableName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGrantV1Request(c.Server, userId, tableName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateGrantV1WithBody(ctx context.Context, userId string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateGrantV1RequestWithBody(c.Server, userId, tableName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateGrantV1(ctx context.Context, userId string, tableName string, body UpdateGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateGrantV1Request(c.Server, userId, tableName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetGroupV1(ctx context.Context, group string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGroupV1Request(c.Server, group)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveGroupMemberV1(ctx context.Context, group string, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveGroupMemberV1Request(c.Server, group, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddGroupMemberV1(ctx context.Context, group string, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddGroupMemberV1Request(c.Server, group, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListHierarchiesV1(ctx context.Context, params *ListHierarchiesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListHierarchiesV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateHierarchyV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateHierarchyV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateHierarchyV1(ctx context.Context, body CreateHierarchyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateHierarchyV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHierarchyV1(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHierarchyV1Request(c.Server, objId, action)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateHierarchyV1WithBody(ctx context.Context, objId string, action string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateHierarchyV1RequestWithBody(c.Server, objId, action, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateHierarchyV1(ctx context.Context, objId string, action string, body UpdateHierarchyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateHierarchyV1Request(c.Server, objId, action, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListObjectsV1(ctx context.Context, params *ListObjectsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListObjectsV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateObjectV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateObjectV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateObjectV1(ctx context.Context, body CreateObjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateObjectV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteObjectV1(ctx context.Context, objId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteObjectV1Request(c.Server, objId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetObjectV1(ctx context.Context, objId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetObjectV1Request(c.Server, objId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateObjectV1WithBody(ctx context.Context, objId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateObjectV1RequestWithBody(c.Server, objId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateObjectV1(ctx context.Context, objId string, body UpdateObjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateObjectV1Request(c.Server, objId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPolicyChainV1(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPolicyChainV1Request(c.Server, objId, action)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetObjectPoliciesV1WithBody(ctx context.Context, objId string, action string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetObjectPoliciesV1RequestWithBody(c.Server, objId, action, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetObjectPoliciesV1(ctx context.Context, objId string, action string, body SetObjectPoliciesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetObjectPoliciesV1Request(c.Server, objId, action, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPoliciesV1(ctx context.Context, params *ListPoliciesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPoliciesV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePolicyV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePolicyV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePolicyV1(ctx context.Context, body CreatePolicyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePolicyV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPolicyV1(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPolicyV1Request(c.Server, ref)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePolicyV1WithBody(ctx context.Context, ref string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePolicyV1RequestWithBody(c.Server, ref, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePolicyV1(ctx context.Context, ref string, body UpdatePolicyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePolicyV1Request(c.Server, ref, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPolicyTestsV1(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPolicyTestsV1Request(c.Server, ref)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RunPolicyTestsV1WithBody(ctx context.Context, ref string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunPolicyTestsV1RequestWithBody(c.Server, ref, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RunPolicyTestsV1(ctx context.Context, ref string, body RunPolicyTestsV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunPolicyTestsV1Request(c.Server, ref, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePolicyTestV1(ctx context.Context, ref string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePolicyTestV1Request(c.Server, ref, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutPolicyTestV1WithBody(ctx context.Context, ref string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPolicyTestV1RequestWithBody(c.Server, ref, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutPolicyTestV1(ctx context.Context, ref string, name string, body PutPolicyTestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPolicyTestV1Request(c.Server, ref, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRolesV1(ctx context.Context, params *ListRolesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRolesV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRoleV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoleV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRoleV1(ctx context.Context, body CreateRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoleV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRoleV1(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRoleV1Request(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRoleV1(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoleV1Request(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRoleV1WithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRoleV1RequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRoleV1(ctx context.Context, name string, body UpdateRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRoleV1Request(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnassignRoleV1(ctx context.Context, name string, kind UnassignRoleV1ParamsKind, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnassignRoleV1Request(c.Server, name, kind, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AssignRoleV1(ctx context.Context, name string, kind AssignRoleV1ParamsKind, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignRoleV1Request(c.Server, name, kind, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimulateV1WithBody(ctx context.Context, params *SimulateV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimulateV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimulateV1(ctx context.Context, params *SimulateV1Params, body SimulateV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimulateV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListUsersV1(ctx context.Context, params *ListUsersV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUsersV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserV1(ctx context.Context, body CreateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserV1(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserV1Request(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserV1WithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserV1RequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserV1(ctx context.Context, userId string, body UpdateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserV1Request(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserCheckInfoV1(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserCheckInfoV1Request(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserRolesV1(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRolesV1Request(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhooksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListWebhookDeliveries(ctx context.Context, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookDeliveriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FindWebhookDelivery(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindWebhookDeliveryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RetryWebhookDelivery(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryWebhookDeliveryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListAuditLogRequest generates requests for ListAuditLog
+func NewListAuditLogRequest(server string, params *ListAuditLogParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_type", runtime.ParamLocationQuery, *params.TargetType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_id", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Decision != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "decision", runtime.ParamLocationQuery, *params.Decision); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RequestId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "request_id", runtime.ParamLocationQuery, *params.RequestId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamChangesRequest generates requests for StreamChanges
+func NewStreamChangesRequest(server string, params *StreamChangesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/changes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Entity != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity", runtime.ParamLocationQuery, *params.Entity); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewStreamChangesWSRequest generates requests for StreamChangesWS
+func NewStreamChangesWSRequest(server string, params *StreamChangesWSParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/changes/ws")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Entity != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity", runtime.ParamLocationQuery, *params.Entity); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDebugStatusRequest generates requests for DebugStatus
+func NewDebugStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/debug/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportDataRequest generates requests for ExportData
+func NewExportDataRequest(server string, entity ExportDataParamsEntity, params *ExportDataParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "entity", runtime.ParamLocationPath, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/export/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindDBAccessRequest generates requests for FindDBAccess
+func NewFindDBAccessRequest(server string, userId string, tableName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "table_name", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/find_db_access/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindDevActionsRequest generates requests for FindDevActions
+func NewFindDevActionsRequest(server string, devId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dev_id", runtime.ParamLocationPath, devId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/find_dev_actions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindDevAttrsRequest generates requests for FindDevAttrs
+func NewFindDevAttrsRequest(server string, devId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dev_id", runtime.ParamLocationPath, devId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/find_dev_attrs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindDevCheckInfoRequest generates requests for FindDevCheckInfo
+func NewFindDevCheckInfoRequest(server string, devId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dev_id", runtime.ParamLocationPath, devId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/find_dev_check_info/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindHierarchyRequest generates requests for FindHierarchy
+func NewFindHierarchyRequest(server string, objId string, action string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "obj_id", runtime.ParamLocationPath, objId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "action", runtime.ParamLocationPath, action)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/find_hierarchy/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindPolicyRequest generates requests for FindPolicy
+func NewFindPolicyRequest(server string, ref string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ref", runtime.ParamLocationPath, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/find_policy/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindUserAttrsRequest generates requests for FindUserAttrs
+func NewFindUserAttrsRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/find_uesr_attrs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindUserCheckInfoRequest generates requests for FindUserCheckInfo
+func NewFindUserCheckInfoRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/find_user_check_info/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHealthzRequest generates requests for Healthz
+func NewHealthzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportDataRequestWithBody generates requests for ImportData with any type of body
+func NewImportDataRequestWithBody(server string, entity ImportDataParamsEntity, params *ImportDataParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "entity", runtime.ParamLocationPath, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/import/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInsertPermInfoRequest calls the generic InsertPermInfo builder with application/json body
+func NewInsertPermInfoRequest(server string, body InsertPermInfoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInsertPermInfoRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewInsertPermInfoRequestWithBody generates requests for InsertPermInfo with any type of body
+func NewInsertPermInfoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/insert_perm_info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInsertUserAttrsRequest calls the generic InsertUserAttrs builder with application/json body
+func NewInsertUserAttrsRequest(server string, body InsertUserAttrsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInsertUserAttrsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewInsertUserAttrsRequestWithBody generates requests for InsertUserAttrs with any type of body
+func NewInsertUserAttrsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/insert_user_attrs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSendJWTRequest calls the generic SendJWT builder with application/json body
+func NewSendJWTRequest(server string, body SendJWTJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSendJWTRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSendJWTRequestWithBody generates requests for SendJWT with any type of body
+func NewSendJWTRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jwt")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListDBAccessRequest generates requests for ListDBAccess
+func NewListDBAccessRequest(server string, params *ListDBAccessParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/list_db_access")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TableName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "table_name", runtime.ParamLocationQuery, *params.TableName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiresBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires_before", runtime.ParamLocationQuery, *params.ExpiresBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiresAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires_after", runtime.ParamLocationQuery, *params.ExpiresAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DeniedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "denied_before", runtime.ParamLocationQuery, *params.DeniedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DeniedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "denied_after", runtime.ParamLocationQuery, *params.DeniedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDevicesRequest generates requests for ListDevices
+func NewListDevicesRequest(server string, params *ListDevicesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/list_devices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DevType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dev_type", runtime.ParamLocationQuery, *params.DevType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListHierarchiesRequest generates requests for ListHierarchies
+func NewListHierarchiesRequest(server string, params *ListHierarchiesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/list_hierarchies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ObjId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "obj_id", runtime.ParamLocationQuery, *params.ObjId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPoliciesRequest generates requests for ListPolicies
+func NewListPoliciesRequest(server string, params *ListPoliciesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/list_policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListUsersRequest generates requests for ListUsers
+func NewListUsersRequest(server string, params *ListUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/list_users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AttrKey != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "attr_key", runtime.ParamLocationQuery, *params.AttrKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AttrValue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "attr_value", runtime.ParamLocationQuery, *params.AttrValue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetricsRequest generates requests for Metrics
+func NewMetricsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOpenAPIRequest generates requests for OpenAPI
+func NewOpenAPIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/openapi.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadyzRequest generates requests for Readyz
+func NewReadyzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/readyz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDBAllowRequest calls the generic UpdateDBAllow builder with application/json body
+func NewUpdateDBAllowRequest(server string, body UpdateDBAllowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDBAllowRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateDBAllowRequestWithBody generates requests for UpdateDBAllow with any type of body
+func NewUpdateDBAllowRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/update_db_allow")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateDBDenyRequest calls the generic UpdateDBDeny builder with application/json body
+func NewUpdateDBDenyRequest(server string, body UpdateDBDenyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDBDenyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateDBDenyRequestWithBody generates requests for UpdateDBDeny with any type of body
+func NewUpdateDBDenyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/update_db_deny")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAccessRequestsV1Request generates requests for ListAccessRequestsV1
+func NewListAccessRequestsV1Request(server string, params *ListAccessRequestsV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/access_requests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TableName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "table_name", runtime.ParamLocationQuery, *params.TableName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitAccessRequestV1Request calls the generic SubmitAccessRequestV1 builder with application/json body
+func NewSubmitAccessRequestV1Request(server string, body SubmitAccessRequestV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitAccessRequestV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitAccessRequestV1RequestWithBody generates requests for SubmitAccessRequestV1 with any type of body
+func NewSubmitAccessRequestV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/access_requests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAccessRequestV1Request generates requests for GetAccessRequestV1
+func NewGetAccessRequestV1Request(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/access_requests/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApproveAccessRequestV1Request calls the generic ApproveAccessRequestV1 builder with application/json body
+func NewApproveAccessRequestV1Request(server string, id int64, body ApproveAccessRequestV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApproveAccessRequestV1RequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewApproveAccessRequestV1RequestWithBody generates requests for ApproveAccessRequestV1 with any type of body
+func NewApproveAccessRequestV1RequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/access_requests/%s/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRejectAccessRequestV1Request calls the generic RejectAccessRequestV1 builder with application/json body
+func NewRejectAccessRequestV1Request(server string, id int64, body RejectAccessRequestV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRejectAccessRequestV1RequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRejectAccessRequestV1RequestWithBody generates requests for RejectAccessRequestV1 with any type of body
+func NewRejectAccessRequestV1RequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/access_requests/%s/reject", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListDevicesV1Request generates requests for ListDevicesV1
+func NewListDevicesV1Request(server string, params *ListDevicesV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/devices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DevType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dev_type", runtime.ParamLocationQuery, *params.DevType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDeviceV1Request calls the generic CreateDeviceV1 builder with application/json body
+func NewCreateDeviceV1Request(server string, body CreateDeviceV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDeviceV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateDeviceV1RequestWithBody generates requests for CreateDeviceV1 with any type of body
+func NewCreateDeviceV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/devices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDeviceV1Request generates requests for GetDeviceV1
+func NewGetDeviceV1Request(server string, devId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dev_id", runtime.ParamLocationPath, devId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/devices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDeviceActionsV1Request generates requests for GetDeviceActionsV1
+func NewGetDeviceActionsV1Request(server string, devId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dev_id", runtime.ParamLocationPath, devId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/devices/%s/actions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDeviceCheckInfoV1Request generates requests for GetDeviceCheckInfoV1
+func NewGetDeviceCheckInfoV1Request(server string, devId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dev_id", runtime.ParamLocationPath, devId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/devices/%s/check_info", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListGrantsV1Request generates requests for ListGrantsV1
+func NewListGrantsV1Request(server string, params *ListGrantsV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/grants")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TableName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "table_name", runtime.ParamLocationQuery, *params.TableName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiresBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires_before", runtime.ParamLocationQuery, *params.ExpiresBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiresAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires_after", runtime.ParamLocationQuery, *params.ExpiresAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DeniedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "denied_before", runtime.ParamLocationQuery, *params.DeniedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DeniedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "denied_after", runtime.ParamLocationQuery, *params.DeniedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateGrantV1Request calls the generic CreateGrantV1 builder with application/json body
+func NewCreateGrantV1Request(server string, body CreateGrantV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateGrantV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateGrantV1RequestWithBody generates requests for CreateGrantV1 with any type of body
+func NewCreateGrantV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/grants")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListGrantHistoryV1Request generates requests for ListGrantHistoryV1
+func NewListGrantHistoryV1Request(server string, params *ListGrantHistoryV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/grants/history")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TableName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "table_name", runtime.ParamLocationQuery, *params.TableName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitGrantV1Request calls the generic SubmitGrantV1 builder with application/json body
+func NewSubmitGrantV1Request(server string, body SubmitGrantV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitGrantV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitGrantV1RequestWithBody generates requests for SubmitGrantV1 with any type of body
+func NewSubmitGrantV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/grants/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewIssueGrantV1Request calls the generic IssueGrantV1 builder with application/json body
+func NewIssueGrantV1Request(server string, body IssueGrantV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewIssueGrantV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewIssueGrantV1RequestWithBody generates requests for IssueGrantV1 with any type of body
+func NewIssueGrantV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/grants/tokens/issue")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetGrantV1Request generates requests for GetGrantV1
+func NewGetGrantV1Request(server string, userId string, tableName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "table_name", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/grants/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateGrantV1Request calls the generic UpdateGrantV1 builder with application/json body
+func NewUpdateGrantV1Request(server string, userId string, tableName string, body UpdateGrantV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateGrantV1RequestWithBody(server, userId, tableName, "application/json", bodyReader)
+}
+
+// NewUpdateGrantV1RequestWithBody generates requests for UpdateGrantV1 with any type of body
+func NewUpdateGrantV1RequestWithBody(server string, userId string, tableName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "table_name", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/grants/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetGroupV1Request generates requests for GetGroupV1
+func NewGetGroupV1Request(server string, group string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group", runtime.ParamLocationPath, group)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/groups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveGroupMemberV1Request generates requests for RemoveGroupMemberV1
+func NewRemoveGroupMemberV1Request(server string, group string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group", runtime.ParamLocationPath, group)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/groups/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddGroupMemberV1Request generates requests for AddGroupMemberV1
+func NewAddGroupMemberV1Request(server string, group string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group", runtime.ParamLocationPath, group)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/groups/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListHierarchiesV1Request generates requests for ListHierarchiesV1
+func NewListHierarchiesV1Request(server string, params *ListHierarchiesV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/hierarchies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ObjId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "obj_id", runtime.ParamLocationQuery, *params.ObjId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateHierarchyV1Request calls the generic CreateHierarchyV1 builder with application/json body
+func NewCreateHierarchyV1Request(server string, body CreateHierarchyV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateHierarchyV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateHierarchyV1RequestWithBody generates requests for CreateHierarchyV1 with any type of body
+func NewCreateHierarchyV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/hierarchies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetHierarchyV1Request generates requests for GetHierarchyV1
+func NewGetHierarchyV1Request(server string, objId string, action string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "obj_id", runtime.ParamLocationPath, objId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "action", runtime.ParamLocationPath, action)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/hierarchies/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateHierarchyV1Request calls the generic UpdateHierarchyV1 builder with application/json body
+func NewUpdateHierarchyV1Request(server string, objId string, action string, body UpdateHierarchyV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateHierarchyV1RequestWithBody(server, objId, action, "application/json", bodyReader)
+}
+
+// NewUpdateHierarchyV1RequestWithBody generates requests for UpdateHierarchyV1 with any type of body
+func NewUpdateHierarchyV1RequestWithBody(server string, objId string, action string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "obj_id", runtime.ParamLocationPath, objId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "action", runtime.ParamLocationPath, action)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/hierarchies/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListObjectsV1Request generates requests for ListObjectsV1
+func NewListObjectsV1Request(server string, params *ListObjectsV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/objects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parent_id", runtime.ParamLocationQuery, *params.ParentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Kind != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kind", runtime.ParamLocationQuery, *params.Kind); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Root != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "root", runtime.ParamLocationQuery, *params.Root); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateObjectV1Request calls the generic CreateObjectV1 builder with application/json body
+func NewCreateObjectV1Request(server string, body CreateObjectV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateObjectV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateObjectV1RequestWithBody generates requests for CreateObjectV1 with any type of body
+func NewCreateObjectV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/objects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteObjectV1Request generates requests for DeleteObjectV1
+func NewDeleteObjectV1Request(server string, objId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "obj_id", runtime.ParamLocationPath, objId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/objects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetObjectV1Request generates requests for GetObjectV1
+func NewGetObjectV1Request(server string, objId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "obj_id", runtime.ParamLocationPath, objId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/objects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateObjectV1Request calls the generic UpdateObjectV1 builder with application/json body
+func NewUpdateObjectV1Request(server string, objId string, body UpdateObjectV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateObjectV1RequestWithBody(server, objId, "application/json", bodyReader)
+}
+
+// NewUpdateObjectV1RequestWithBody generates requests for UpdateObjectV1 with any type of body
+func NewUpdateObjectV1RequestWithBody(server string, objId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "obj_id", runtime.ParamLocationPath, objId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/objects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPolicyChainV1Request generates requests for GetPolicyChainV1
+func NewGetPolicyChainV1Request(server string, objId string, action string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "obj_id", runtime.ParamLocationPath, objId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "action", runtime.ParamLocationPath, action)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/objects/%s/effective/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetObjectPoliciesV1Request calls the generic SetObjectPoliciesV1 builder with application/json body
+func NewSetObjectPoliciesV1Request(server string, objId string, action string, body SetObjectPoliciesV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetObjectPoliciesV1RequestWithBody(server, objId, action, "application/json", bodyReader)
+}
+
+// NewSetObjectPoliciesV1RequestWithBody generates requests for SetObjectPoliciesV1 with any type of body
+func NewSetObjectPoliciesV1RequestWithBody(server string, objId string, action string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "obj_id", runtime.ParamLocationPath, objId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "action", runtime.ParamLocationPath, action)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/objects/%s/policies/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPoliciesV1Request generates requests for ListPoliciesV1
+func NewListPoliciesV1Request(server string, params *ListPoliciesV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePolicyV1Request calls the generic CreatePolicyV1 builder with application/json body
+func NewCreatePolicyV1Request(server string, body CreatePolicyV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePolicyV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePolicyV1RequestWithBody generates requests for CreatePolicyV1 with any type of body
+func NewCreatePolicyV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPolicyV1Request generates requests for GetPolicyV1
+func NewGetPolicyV1Request(server string, ref string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ref", runtime.ParamLocationPath, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePolicyV1Request calls the generic UpdatePolicyV1 builder with application/json body
+func NewUpdatePolicyV1Request(server string, ref string, body UpdatePolicyV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePolicyV1RequestWithBody(server, ref, "application/json", bodyReader)
+}
+
+// NewUpdatePolicyV1RequestWithBody generates requests for UpdatePolicyV1 with any type of body
+func NewUpdatePolicyV1RequestWithBody(server string, ref string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ref", runtime.ParamLocationPath, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPolicyTestsV1Request generates requests for ListPolicyTestsV1
+func NewListPolicyTestsV1Request(server string, ref string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ref", runtime.ParamLocationPath, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies/%s/tests", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRunPolicyTestsV1Request calls the generic RunPolicyTestsV1 builder with application/json body
+func NewRunPolicyTestsV1Request(server string, ref string, body RunPolicyTestsV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRunPolicyTestsV1RequestWithBody(server, ref, "application/json", bodyReader)
+}
+
+// NewRunPolicyTestsV1RequestWithBody generates requests for RunPolicyTestsV1 with any type of body
+func NewRunPolicyTestsV1RequestWithBody(server string, ref string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ref", runtime.ParamLocationPath, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies/%s/tests/run", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePolicyTestV1Request generates requests for DeletePolicyTestV1
+func NewDeletePolicyTestV1Request(server string, ref string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ref", runtime.ParamLocationPath, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies/%s/tests/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutPolicyTestV1Request calls the generic PutPolicyTestV1 builder with application/json body
+func NewPutPolicyTestV1Request(server string, ref string, name string, body PutPolicyTestV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutPolicyTestV1RequestWithBody(server, ref, name, "application/json", bodyReader)
+}
+
+// NewPutPolicyTestV1RequestWithBody generates requests for PutPolicyTestV1 with any type of body
+func NewPutPolicyTestV1RequestWithBody(server string, ref string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ref", runtime.ParamLocationPath, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies/%s/tests/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListRolesV1Request generates requests for ListRolesV1
+func NewListRolesV1Request(server string, params *ListRolesV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRoleV1Request calls the generic CreateRoleV1 builder with application/json body
+func NewCreateRoleV1Request(server string, body CreateRoleV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRoleV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRoleV1RequestWithBody generates requests for CreateRoleV1 with any type of body
+func NewCreateRoleV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRoleV1Request generates requests for DeleteRoleV1
+func NewDeleteRoleV1Request(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRoleV1Request generates requests for GetRoleV1
+func NewGetRoleV1Request(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRoleV1Request calls the generic UpdateRoleV1 builder with application/json body
+func NewUpdateRoleV1Request(server string, name string, body UpdateRoleV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRoleV1RequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewUpdateRoleV1RequestWithBody generates requests for UpdateRoleV1 with any type of body
+func NewUpdateRoleV1RequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnassignRoleV1Request generates requests for UnassignRoleV1
+func NewUnassignRoleV1Request(server string, name string, kind UnassignRoleV1ParamsKind, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "kind", runtime.ParamLocationPath, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s/members/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAssignRoleV1Request generates requests for AssignRoleV1
+func NewAssignRoleV1Request(server string, name string, kind AssignRoleV1ParamsKind, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "kind", runtime.ParamLocationPath, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s/members/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimulateV1Request calls the generic SimulateV1 builder with application/json body
+func NewSimulateV1Request(server string, params *SimulateV1Params, body SimulateV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimulateV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewSimulateV1RequestWithBody generates requests for SimulateV1 with any type of body
+func NewSimulateV1RequestWithBody(server string, params *SimulateV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/simulate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Flipped != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "flipped", runtime.ParamLocationQuery, *params.Flipped); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListUsersV1Request generates requests for ListUsersV1
+func NewListUsersV1Request(server string, params *ListUsersV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Prefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prefix", runtime.ParamLocationQuery, *params.Prefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AttrKey != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "attr_key", runtime.ParamLocationQuery, *params.AttrKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AttrValue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "attr_value", runtime.ParamLocationQuery, *params.AttrValue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUserV1Request calls the generic CreateUserV1 builder with application/json body
+func NewCreateUserV1Request(server string, body CreateUserV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUserV1RequestWithBody generates requests for CreateUserV1 with any type of body
+func NewCreateUserV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserV1Request generates requests for GetUserV1
+func NewGetUserV1Request(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserV1Request calls the generic UpdateUserV1 builder with application/json body
+func NewUpdateUserV1Request(server string, userId string, body UpdateUserV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserV1RequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUpdateUserV1RequestWithBody generates requests for UpdateUserV1 with any type of body
+func NewUpdateUserV1RequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserCheckInfoV1Request generates requests for GetUserCheckInfoV1
+func NewGetUserCheckInfoV1Request(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/check_info", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserRolesV1Request generates requests for GetUserRolesV1
+func NewGetUserRolesV1Request(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/roles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListWebhooksRequest generates requests for ListWebhooks
+func NewListWebhooksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListWebhookDeliveriesRequest generates requests for ListWebhookDeliveries
+func NewListWebhookDeliveriesRequest(server string, params *ListWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/deliveries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Webhook != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "webhook", runtime.ParamLocationQuery, *params.Webhook); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "event_type", runtime.ParamLocationQuery, *params.EventType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "event_id", runtime.ParamLocationQuery, *params.EventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindWebhookDeliveryRequest generates requests for FindWebhookDelivery
+func NewFindWebhookDeliveryRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/deliveries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRetryWebhookDeliveryRequest generates requests for RetryWebhookDelivery
+func NewRetryWebhookDeliveryRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/deliveries/%s/retry", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAuditLogWithResponse request
+	ListAuditLogWithResponse(ctx context.Context, params *ListAuditLogParams, reqEditors ...RequestEditorFn) (*ListAuditLogResponse, error)
+
+	// StreamChangesWithResponse request
+	StreamChangesWithResponse(ctx context.Context, params *StreamChangesParams, reqEditors ...RequestEditorFn) (*StreamChangesResponse, error)
+
+	// StreamChangesWSWithResponse request
+	StreamChangesWSWithResponse(ctx context.Context, params *StreamChangesWSParams, reqEditors ...RequestEditorFn) (*StreamChangesWSResponse, error)
+
+	// DebugStatusWithResponse request
+	DebugStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DebugStatusResponse, error)
+
+	// ExportDataWithResponse request
+	ExportDataWithResponse(ctx context.Context, entity ExportDataParamsEntity, params *ExportDataParams, reqEditors ...RequestEditorFn) (*ExportDataResponse, error)
+
+	// FindDBAccessWithResponse request
+	FindDBAccessWithResponse(ctx context.Context, userId string, tableName string, reqEditors ...RequestEditorFn) (*FindDBAccessResponse, error)
+
+	// FindDevActionsWithResponse request
+	FindDevActionsWithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*FindDevActionsResponse, error)
+
+	// FindDevAttrsWithResponse request
+	FindDevAttrsWithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*FindDevAttrsResponse, error)
+
+	// FindDevCheckInfoWithResponse request
+	FindDevCheckInfoWithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*FindDevCheckInfoResponse, error)
+
+	// FindHierarchyWithResponse request
+	FindHierarchyWithResponse(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*FindHierarchyResponse, error)
+
+	// FindPolicyWithResponse request
+	FindPolicyWithResponse(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*FindPolicyResponse, error)
+
+	// FindUserAttrsWithResponse request
+	FindUserAttrsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*FindUserAttrsResponse, error)
+
+	// FindUserCheckInfoWithResponse request
+	FindUserCheckInfoWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*FindUserCheckInfoResponse, error)
+
+	// HealthzWithResponse request
+	HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error)
+
+	// ImportDataWithBodyWithResponse request with any body
+	ImportDataWithBodyWithResponse(ctx context.Context, entity ImportDataParamsEntity, params *ImportDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDataResponse, error)
+
+	// InsertPermInfoWithBodyWithResponse request with any body
+	InsertPermInfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InsertPermInfoResponse, error)
+
+	InsertPermInfoWithResponse(ctx context.Context, body InsertPermInfoJSONRequestBody, reqEditors ...RequestEditorFn) (*InsertPermInfoResponse, error)
+
+	// InsertUserAttrsWithBodyWithResponse request with any body
+	InsertUserAttrsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InsertUserAttrsResponse, error)
+
+	InsertUserAttrsWithResponse(ctx context.Context, body InsertUserAttrsJSONRequestBody, reqEditors ...RequestEditorFn) (*InsertUserAttrsResponse, error)
+
+	// SendJWTWithBodyWithResponse request with any body
+	SendJWTWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendJWTResponse, error)
+
+	SendJWTWithResponse(ctx context.Context, body SendJWTJSONRequestBody, reqEditors ...RequestEditorFn) (*SendJWTResponse, error)
+
+	// ListDBAccessWithResponse request
+	ListDBAccessWithResponse(ctx context.Context, params *ListDBAccessParams, reqEditors ...RequestEditorFn) (*ListDBAccessResponse, error)
+
+	// ListDevicesWithResponse request
+	ListDevicesWithResponse(ctx context.Context, params *ListDevicesParams, reqEditors ...RequestEditorFn) (*ListDevicesResponse, error)
+
+	// ListHierarchiesWithResponse request
+	ListHierarchiesWithResponse(ctx context.Context, params *ListHierarchiesParams, reqEditors ...RequestEditorFn) (*ListHierarchiesResponse, error)
+
+	// ListPoliciesWithResponse request
+	ListPoliciesWithResponse(ctx context.Context, params *ListPoliciesParams, reqEditors ...RequestEditorFn) (*ListPoliciesResponse, error)
+
+	// ListUsersWithResponse request
+	ListUsersWithResponse(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*ListUsersResponse, error)
+
+	// MetricsWithResponse request
+	MetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetricsResponse, error)
+
+	// OpenAPIWithResponse request
+	OpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*OpenAPIResponse, error)
+
+	// ReadyzWithResponse request
+	ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error)
+
+	// UpdateDBAllowWithBodyWithResponse request with any body
+	UpdateDBAllowWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDBAllowResponse, error)
+
+	UpdateDBAllowWithResponse(ctx context.Context, body UpdateDBAllowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDBAllowResponse, error)
+
+	// UpdateDBDenyWithBodyWithResponse request with any body
+	UpdateDBDenyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDBDenyResponse, error)
+
+	UpdateDBDenyWithResponse(ctx context.Context, body UpdateDBDenyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDBDenyResponse, error)
+
+	// ListAccessRequestsV1WithResponse request
+	ListAccessRequestsV1WithResponse(ctx context.Context, params *ListAccessRequestsV1Params, reqEditors ...RequestEditorFn) (*ListAccessRequestsV1Response, error)
+
+	// SubmitAccessRequestV1WithBodyWithResponse request with any body
+	SubmitAccessRequestV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitAccessRequestV1Response, error)
+
+	SubmitAccessRequestV1WithResponse(ctx context.Context, body SubmitAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitAccessRequestV1Response, error)
+
+	// GetAccessRequestV1WithResponse request
+	GetAccessRequestV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetAccessRequestV1Response, error)
+
+	// ApproveAccessRequestV1WithBodyWithResponse request with any body
+	ApproveAccessRequestV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveAccessRequestV1Response, error)
+
+	ApproveAccessRequestV1WithResponse(ctx context.Context, id int64, body ApproveAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveAccessRequestV1Response, error)
+
+	// RejectAccessRequestV1WithBodyWithResponse request with any body
+	RejectAccessRequestV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RejectAccessRequestV1Response, error)
+
+	RejectAccessRequestV1WithResponse(ctx context.Context, id int64, body RejectAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RejectAccessRequestV1Response, error)
+
+	// ListDevicesV1WithResponse request
+	ListDevicesV1WithResponse(ctx context.Context, params *ListDevicesV1Params, reqEditors ...RequestEditorFn) (*ListDevicesV1Response, error)
+
+	// CreateDeviceV1WithBodyWithResponse request with any body
+	CreateDeviceV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeviceV1Response, error)
+
+	CreateDeviceV1WithResponse(ctx context.Context, body CreateDeviceV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeviceV1Response, error)
+
+	// GetDeviceV1WithResponse request
+	GetDeviceV1WithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*GetDeviceV1Response, error)
+
+	// GetDeviceActionsV1WithResponse request
+	GetDeviceActionsV1WithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*GetDeviceActionsV1Response, error)
+
+	// GetDeviceCheckInfoV1WithResponse request
+	GetDeviceCheckInfoV1WithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*GetDeviceCheckInfoV1Response, error)
+
+	// ListGrantsV1WithResponse request
+	ListGrantsV1WithResponse(ctx context.Context, params *ListGrantsV1Params, reqEditors ...RequestEditorFn) (*ListGrantsV1Response, error)
+
+	// CreateGrantV1WithBodyWithResponse request with any body
+	CreateGrantV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGrantV1Response, error)
+
+	CreateGrantV1WithResponse(ctx context.Context, body CreateGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGrantV1Response, error)
+
+	// ListGrantHistoryV1WithResponse request
+	ListGrantHistoryV1WithResponse(ctx context.Context, params *ListGrantHistoryV1Params, reqEditors ...RequestEditorFn) (*ListGrantHistoryV1Response, error)
+
+	// SubmitGrantV1WithBodyWithResponse request with any body
+	SubmitGrantV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitGrantV1Response, error)
+
+	SubmitGrantV1WithResponse(ctx context.Context, body SubmitGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitGrantV1Response, error)
+
+	// IssueGrantV1WithBodyWithResponse request with any body
+	IssueGrantV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IssueGrantV1Response, error)
+
+	IssueGrantV1WithResponse(ctx context.Context, body IssueGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*IssueGrantV1Response, error)
+
+	// GetGrantV1WithResponse request
+	GetGrantV1WithResponse(ctx context.Context, userId string, tableName string, reqEditors ...RequestEditorFn) (*GetGrantV1Response, error)
+
+	// UpdateGrantV1WithBodyWithResponse request with any body
+	UpdateGrantV1WithBodyWithResponse(ctx context.Context, userId string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGrantV1Response, error)
+
+	UpdateGrantV1WithResponse(ctx context.Context, userId string, tableName string, body UpdateGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGrantV1Response, error)
+
+	// GetGroupV1WithResponse request
+	GetGroupV1WithResponse(ctx context.Context, group string, reqEditors ...RequestEditorFn) (*GetGroupV1Response, error)
+
+	// RemoveGroupMemberV1WithResponse request
+	RemoveGroupMemberV1WithResponse(ctx context.Context, group string, userId string, reqEditors ...RequestEditorFn) (*RemoveGroupMemberV1Response, error)
+
+	// AddGroupMemberV1WithResponse request
+	AddGroupMemberV1WithResponse(ctx context.Context, group string, userId string, reqEditors ...RequestEditorFn) (*AddGroupMemberV1Response, error)
+
+	// ListHierarchiesV1WithResponse request
+	ListHierarchiesV1WithResponse(ctx context.Context, params *ListHierarchiesV1Params, reqEditors ...RequestEditorFn) (*ListHierarchiesV1Response, error)
+
+	// CreateHierarchyV1WithBodyWithResponse request with any body
+	CreateHierarchyV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHierarchyV1Response, error)
+
+	CreateHierarchyV1WithResponse(ctx context.Context, body CreateHierarchyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHierarchyV1Response, error)
+
+	// GetHierarchyV1WithResponse request
+	GetHierarchyV1WithResponse(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*GetHierarchyV1Response, error)
+
+	// UpdateHierarchyV1WithBodyWithResponse request with any body
+	UpdateHierarchyV1WithBodyWithResponse(ctx context.Context, objId string, action string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHierarchyV1Response, error)
+
+	UpdateHierarchyV1WithResponse(ctx context.Context, objId string, action string, body UpdateHierarchyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHierarchyV1Response, error)
+
+	// ListObjectsV1WithResponse request
+	ListObjectsV1WithResponse(ctx context.Context, params *ListObjectsV1Params, reqEditors ...RequestEditorFn) (*ListObjectsV1Response, error)
+
+	// CreateObjectV1WithBodyWithResponse request with any body
+	CreateObjectV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateObjectV1Response, error)
+
+	CreateObjectV1WithResponse(ctx context.Context, body CreateObjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateObjectV1Response, error)
+
+	// DeleteObjectV1WithResponse request
+	DeleteObjectV1WithResponse(ctx context.Context, objId string, reqEditors ...RequestEditorFn) (*DeleteObjectV1Response, error)
+
+	// GetObjectV1WithResponse request
+	GetObjectV1WithResponse(ctx context.Context, objId string, reqEditors ...RequestEditorFn) (*GetObjectV1Response, error)
+
+	// UpdateObjectV1WithBodyWithResponse request with any body
+	UpdateObjectV1WithBodyWithResponse(ctx context.Context, objId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateObjectV1Response, error)
+
+	UpdateObjectV1WithResponse(ctx context.Context, objId string, body UpdateObjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateObjectV1Response, error)
+
+	// GetPolicyChainV1WithResponse request
+	GetPolicyChainV1WithResponse(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*GetPolicyChainV1Response, error)
+
+	// SetObjectPoliciesV1WithBodyWithResponse request with any body
+	SetObjectPoliciesV1WithBodyWithResponse(ctx context.Context, objId string, action string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetObjectPoliciesV1Response, error)
+
+	SetObjectPoliciesV1WithResponse(ctx context.Context, objId string, action string, body SetObjectPoliciesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SetObjectPoliciesV1Response, error)
+
+	// ListPoliciesV1WithResponse request
+	ListPoliciesV1WithResponse(ctx context.Context, params *ListPoliciesV1Params, reqEditors ...RequestEditorFn) (*ListPoliciesV1Response, error)
+
+	// CreatePolicyV1WithBodyWithResponse request with any body
+	CreatePolicyV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePolicyV1Response, error)
+
+	CreatePolicyV1WithResponse(ctx context.Context, body CreatePolicyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePolicyV1Response, error)
+
+	// GetPolicyV1WithResponse request
+	GetPolicyV1WithResponse(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*GetPolicyV1Response, error)
+
+	// UpdatePolicyV1WithBodyWithResponse request with any body
+	UpdatePolicyV1WithBodyWithResponse(ctx context.Context, ref string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePolicyV1Response, error)
+
+	UpdatePolicyV1WithResponse(ctx context.Context, ref string, body UpdatePolicyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePolicyV1Response, error)
+
+	// ListPolicyTestsV1WithResponse request
+	ListPolicyTestsV1WithResponse(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*ListPolicyTestsV1Response, error)
+
+	// RunPolicyTestsV1WithBodyWithResponse request with any body
+	RunPolicyTestsV1WithBodyWithResponse(ctx context.Context, ref string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunPolicyTestsV1Response, error)
+
+	RunPolicyTestsV1WithResponse(ctx context.Context, ref string, body RunPolicyTestsV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RunPolicyTestsV1Response, error)
+
+	// DeletePolicyTestV1WithResponse request
+	DeletePolicyTestV1WithResponse(ctx context.Context, ref string, name string, reqEditors ...RequestEditorFn) (*DeletePolicyTestV1Response, error)
+
+	// PutPolicyTestV1WithBodyWithResponse request with any body
+	PutPolicyTestV1WithBodyWithResponse(ctx context.Context, ref string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPolicyTestV1Response, error)
+
+	PutPolicyTestV1WithResponse(ctx context.Context, ref string, name string, body PutPolicyTestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*PutPolicyTestV1Response, error)
+
+	// ListRolesV1WithResponse request
+	ListRolesV1WithResponse(ctx context.Context, params *ListRolesV1Params, reqEditors ...RequestEditorFn) (*ListRolesV1Response, error)
+
+	// CreateRoleV1WithBodyWithResponse request with any body
+	CreateRoleV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleV1Response, error)
+
+	CreateRoleV1WithResponse(ctx context.Context, body CreateRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleV1Response, error)
+
+	// DeleteRoleV1WithResponse request
+	DeleteRoleV1WithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteRoleV1Response, error)
+
+	// GetRoleV1WithResponse request
+	GetRoleV1WithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetRoleV1Response, error)
+
+	// UpdateRoleV1WithBodyWithResponse request with any body
+	UpdateRoleV1WithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleV1Response, error)
+
+	UpdateRoleV1WithResponse(ctx context.Context, name string, body UpdateRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleV1Response, error)
+
+	// UnassignRoleV1WithResponse request
+	UnassignRoleV1WithResponse(ctx context.Context, name string, kind UnassignRoleV1ParamsKind, id string, reqEditors ...RequestEditorFn) (*UnassignRoleV1Response, error)
+
+	// AssignRoleV1WithResponse request
+	AssignRoleV1WithResponse(ctx context.Context, name string, kind AssignRoleV1ParamsKind, id string, reqEditors ...RequestEditorFn) (*AssignRoleV1Response, error)
+
+	// SimulateV1WithBodyWithResponse request with any body
+	SimulateV1WithBodyWithResponse(ctx context.Context, params *SimulateV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimulateV1Response, error)
+
+	SimulateV1WithResponse(ctx context.Context, params *SimulateV1Params, body SimulateV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SimulateV1Response, error)
+
+	// ListUsersV1WithResponse request
+	ListUsersV1WithResponse(ctx context.Context, params *ListUsersV1Params, reqEditors ...RequestEditorFn) (*ListUsersV1Response, error)
+
+	// CreateUserV1WithBodyWithResponse request with any body
+	CreateUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserV1Response, error)
+
+	CreateUserV1WithResponse(ctx context.Context, body CreateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserV1Response, error)
+
+	// GetUserV1WithResponse request
+	GetUserV1WithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*GetUserV1Response, error)
+
+	// UpdateUserV1WithBodyWithResponse request with any body
+	UpdateUserV1WithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserV1Response, error)
+
+	UpdateUserV1WithResponse(ctx context.Context, userId string, body UpdateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserV1Response, error)
+
+	// GetUserCheckInfoV1WithResponse request
+	GetUserCheckInfoV1WithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*GetUserCheckInfoV1Response, error)
+
+	// GetUserRolesV1WithResponse request
+	GetUserRolesV1WithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*GetUserRolesV1Response, error)
+
+	// ListWebhooksWithResponse request
+	ListWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWebhooksResponse, error)
+
+	// ListWebhookDeliveriesWithResponse request
+	ListWebhookDeliveriesWithResponse(ctx context.Context, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error)
+
+	// FindWebhookDeliveryWithResponse request
+	FindWebhookDeliveryWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*FindWebhookDeliveryResponse, error)
+
+	// RetryWebhookDeliveryWithResponse request
+	RetryWebhookDeliveryWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*RetryWebhookDeliveryResponse, error)
+}
+
+type ListAuditLogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditList
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamChangesWSResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamChangesWSResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamChangesWSResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DebugStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusReport
+}
+
+// Status returns HTTPResponse.Status
+func (r DebugStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DebugStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindDBAccessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DBAccess
+}
+
+// Status returns HTTPResponse.Status
+func (r FindDBAccessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindDBAccessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindDevActionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DevActions
+}
+
+// Status returns HTTPResponse.Status
+func (r FindDevActionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindDevActionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindDevAttrsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DevAttrs
+}
+
+// Status returns HTTPResponse.Status
+func (r FindDevAttrsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindDevAttrsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindDevCheckInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DevCheckInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r FindDevCheckInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindDevCheckInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindHierarchyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Hierarchy
+}
+
+// Status returns HTTPResponse.Status
+func (r FindHierarchyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindHierarchyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Policy
+}
+
+// Status returns HTTPResponse.Status
+func (r FindPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindUserAttrsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserAttrs
+}
+
+// Status returns HTTPResponse.Status
+func (r FindUserAttrsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindUserAttrsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindUserCheckInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserCheckInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r FindUserCheckInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindUserCheckInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status string `json:"status"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportReport
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InsertPermInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InsertPermInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InsertPermInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InsertUserAttrsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r InsertUserAttrsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InsertUserAttrsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SendJWTResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServerMessage
+	JSON400      *ServerMessage
+	JSON409      *ServerMessage
+	JSON500      *ServerMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r SendJWTResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SendJWTResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDBAccessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DBAccessList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDBAccessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDBAccessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDevicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeviceList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDevicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDevicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListHierarchiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HierarchyList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListHierarchiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListHierarchiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPoliciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PolicyList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r MetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OpenAPIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r OpenAPIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OpenAPIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadyzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReadyReport
+	JSON503      *ReadyReport
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadyzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadyzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDBAllowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDBAllowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDBAllowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDBDenyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDBDenyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDBDenyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAccessRequestsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccessRequestList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAccessRequestsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAccessRequestsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitAccessRequestV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AccessRequest
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitAccessRequestV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitAccessRequestV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccessRequestV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccessRequest
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccessRequestV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccessRequestV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveAccessRequestV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccessRequest
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveAccessRequestV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveAccessRequestV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RejectAccessRequestV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccessRequest
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RejectAccessRequestV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RejectAccessRequestV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDevicesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeviceList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDevicesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDevicesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDeviceV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WriteResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDeviceV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDeviceV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeviceV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DevAttrs
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeviceV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeviceV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeviceActionsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DevActions
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeviceActionsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeviceActionsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeviceCheckInfoV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DevCheckInfo
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeviceCheckInfoV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeviceCheckInfoV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListGrantsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DBAccessList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListGrantsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListGrantsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateGrantV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WriteResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateGrantV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateGrantV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListGrantHistoryV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ArchivedGrantList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListGrantHistoryV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListGrantHistoryV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitGrantV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServerMessage
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitGrantV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitGrantV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssueGrantV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IssuedGrant
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r IssueGrantV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssueGrantV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGrantV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DBAccess
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGrantV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGrantV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateGrantV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WriteResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateGrantV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateGrantV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGroupV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GroupMembers
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGroupV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGroupV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveGroupMemberV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WriteResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveGroupMemberV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveGroupMemberV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddGroupMemberV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WriteResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddGroupMemberV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddGroupMemberV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListHierarchiesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HierarchyList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListHierarchiesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListHierarchiesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateHierarchyV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WriteResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateHierarchyV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateHierarchyV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHierarchyV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Hierarchy
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHierarchyV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHierarchyV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateHierarchyV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WriteResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateHierarchyV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateHierarchyV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListObjectsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ObjectList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListObjectsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListObjectsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateObjectV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ObjectNode
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateObjectV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateObjectV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteObjectV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteObjectV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteObjectV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetObjectV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ObjectNode
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetObjectV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetObjectV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateObjectV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ObjectNode
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateObjectV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateObjectV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPolicyChainV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PolicyChain
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPolicyChainV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPolicyChainV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetObjectPoliciesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ObjectNode
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetObjectPoliciesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetObjectPoliciesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPoliciesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PolicyList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPoliciesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPoliciesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePolicyV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WriteResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePolicyV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePolicyV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPolicyV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Policy
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPolicyV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPolicyV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePolicyV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WriteResult
+	JSON400      *Error
+	JSON422      *PolicyTestFailure
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePolicyV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePolicyV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPolicyTestsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PolicyTest
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPolicyTestsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPolicyTestsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunPolicyTestsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PolicyTestReport
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RunPolicyTestsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunPolicyTestsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePolicyTestV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePolicyTestV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePolicyTestV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutPolicyTestV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PolicyTest
+	JSON201      *PolicyTest
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutPolicyTestV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutPolicyTestV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRolesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoleList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRolesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRolesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRoleV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Role
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRoleV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRoleV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRoleV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRoleV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRoleV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRoleV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Role
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRoleV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRoleV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRoleV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Role
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRoleV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRoleV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnassignRoleV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WriteResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UnassignRoleV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnassignRoleV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AssignRoleV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WriteResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AssignRoleV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssignRoleV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimulateV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SimulateReport
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimulateV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimulateV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUsersV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUsersV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUsersV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WriteResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserAttrs
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WriteResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserCheckInfoV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserCheckInfo
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserCheckInfoV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserCheckInfoV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserRolesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]UserRole
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserRolesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserRolesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookEndpoint
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveryList
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindWebhookDeliveryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDelivery
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FindWebhookDeliveryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindWebhookDeliveryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RetryWebhookDeliveryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServerMessage
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RetryWebhookDeliveryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetryWebhookDeliveryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListAuditLogWithResponse request returning *ListAuditLogResponse
+func (c *ClientWithResponses) ListAuditLogWithResponse(ctx context.Context, params *ListAuditLogParams, reqEditors ...RequestEditorFn) (*ListAuditLogResponse, error) {
+	rsp, err := c.ListAuditLog(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditLogResponse(rsp)
+}
+
+// StreamChangesWithResponse request returning *StreamChangesResponse
+func (c *ClientWithResponses) StreamChangesWithResponse(ctx context.Context, params *StreamChangesParams, reqEditors ...RequestEditorFn) (*StreamChangesResponse, error) {
+	rsp, err := c.StreamChanges(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamChangesResponse(rsp)
+}
+
+// StreamChangesWSWithResponse request returning *StreamChangesWSResponse
+func (c *ClientWithResponses) StreamChangesWSWithResponse(ctx context.Context, params *StreamChangesWSParams, reqEditors ...RequestEditorFn) (*StreamChangesWSResponse, error) {
+	rsp, err := c.StreamChangesWS(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamChangesWSResponse(rsp)
+}
+
+// DebugStatusWithResponse request returning *DebugStatusResponse
+func (c *ClientWithResponses) DebugStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DebugStatusResponse, error) {
+	rsp, err := c.DebugStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDebugStatusResponse(rsp)
+}
+
+// ExportDataWithResponse request returning *ExportDataResponse
+func (c *ClientWithResponses) ExportDataWithResponse(ctx context.Context, entity ExportDataParamsEntity, params *ExportDataParams, reqEditors ...RequestEditorFn) (*ExportDataResponse, error) {
+	rsp, err := c.ExportData(ctx, entity, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportDataResponse(rsp)
+}
+
+// FindDBAccessWithResponse request returning *FindDBAccessResponse
+func (c *ClientWithResponses) FindDBAccessWithResponse(ctx context.Context, userId string, tableName string, reqEditors ...RequestEditorFn) (*FindDBAccessResponse, error) {
+	rsp, err := c.FindDBAccess(ctx, userId, tableName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindDBAccessResponse(rsp)
+}
+
+// FindDevActionsWithResponse request returning *FindDevActionsResponse
+func (c *ClientWithResponses) FindDevActionsWithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*FindDevActionsResponse, error) {
+	rsp, err := c.FindDevActions(ctx, devId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindDevActionsResponse(rsp)
+}
+
+// FindDevAttrsWithResponse request returning *FindDevAttrsResponse
+func (c *ClientWithResponses) FindDevAttrsWithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*FindDevAttrsResponse, error) {
+	rsp, err := c.FindDevAttrs(ctx, devId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindDevAttrsResponse(rsp)
+}
+
+// FindDevCheckInfoWithResponse request returning *FindDevCheckInfoResponse
+func (c *ClientWithResponses) FindDevCheckInfoWithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*FindDevCheckInfoResponse, error) {
+	rsp, err := c.FindDevCheckInfo(ctx, devId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindDevCheckInfoResponse(rsp)
+}
+
+// FindHierarchyWithResponse request returning *FindHierarchyResponse
+func (c *ClientWithResponses) FindHierarchyWithResponse(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*FindHierarchyResponse, error) {
+	rsp, err := c.FindHierarchy(ctx, objId, action, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindHierarchyResponse(rsp)
+}
+
+// FindPolicyWithResponse request returning *FindPolicyResponse
+func (c *ClientWithResponses) FindPolicyWithResponse(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*FindPolicyResponse, error) {
+	rsp, err := c.FindPolicy(ctx, ref, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindPolicyResponse(rsp)
+}
+
+// FindUserAttrsWithResponse request returning *FindUserAttrsResponse
+func (c *ClientWithResponses) FindUserAttrsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*FindUserAttrsResponse, error) {
+	rsp, err := c.FindUserAttrs(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindUserAttrsResponse(rsp)
+}
+
+// FindUserCheckInfoWithResponse request returning *FindUserCheckInfoResponse
+func (c *ClientWithResponses) FindUserCheckInfoWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*FindUserCheckInfoResponse, error) {
+	rsp, err := c.FindUserCheckInfo(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindUserCheckInfoResponse(rsp)
+}
+
+// HealthzWithResponse request returning *HealthzResponse
+func (c *ClientWithResponses) HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error) {
+	rsp, err := c.Healthz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthzResponse(rsp)
+}
+
+// ImportDataWithBodyWithResponse request with arbitrary body returning *ImportDataResponse
+func (c *ClientWithResponses) ImportDataWithBodyWithResponse(ctx context.Context, entity ImportDataParamsEntity, params *ImportDataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDataResponse, error) {
+	rsp, err := c.ImportDataWithBody(ctx, entity, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDataResponse(rsp)
+}
+
+// InsertPermInfoWithBodyWithResponse request with arbitrary body returning *InsertPermInfoResponse
+func (c *ClientWithResponses) InsertPermInfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InsertPermInfoResponse, error) {
+	rsp, err := c.InsertPermInfoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInsertPermInfoResponse(rsp)
+}
+
+func (c *ClientWithResponses) InsertPermInfoWithResponse(ctx context.Context, body InsertPermInfoJSONRequestBody, reqEditors ...RequestEditorFn) (*InsertPermInfoResponse, error) {
+	rsp, err := c.InsertPermInfo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInsertPermInfoResponse(rsp)
+}
+
+// InsertUserAttrsWithBodyWithResponse request with arbitrary body returning *InsertUserAttrsResponse
+func (c *ClientWithResponses) InsertUserAttrsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InsertUserAttrsResponse, error) {
+	rsp, err := c.InsertUserAttrsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInsertUserAttrsResponse(rsp)
+}
+
+func (c *ClientWithResponses) InsertUserAttrsWithResponse(ctx context.Context, body InsertUserAttrsJSONRequestBody, reqEditors ...RequestEditorFn) (*InsertUserAttrsResponse, error) {
+	rsp, err := c.InsertUserAttrs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInsertUserAttrsResponse(rsp)
+}
+
+// SendJWTWithBodyWithResponse request with arbitrary body returning *SendJWTResponse
+func (c *ClientWithResponses) SendJWTWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendJWTResponse, error) {
+	rsp, err := c.SendJWTWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSendJWTResponse(rsp)
+}
+
+func (c *ClientWithResponses) SendJWTWithResponse(ctx context.Context, body SendJWTJSONRequestBody, reqEditors ...RequestEditorFn) (*SendJWTResponse, error) {
+	rsp, err := c.SendJWT(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSendJWTResponse(rsp)
+}
+
+// ListDBAccessWithResponse request returning *ListDBAccessResponse
+func (c *ClientWithResponses) ListDBAccessWithResponse(ctx context.Context, params *ListDBAccessParams, reqEditors ...RequestEditorFn) (*ListDBAccessResponse, error) {
+	rsp, err := c.ListDBAccess(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDBAccessResponse(rsp)
+}
+
+// ListDevicesWithResponse request returning *ListDevicesResponse
+func (c *ClientWithResponses) ListDevicesWithResponse(ctx context.Context, params *ListDevicesParams, reqEditors ...RequestEditorFn) (*ListDevicesResponse, error) {
+	rsp, err := c.ListDevices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDevicesResponse(rsp)
+}
+
+// ListHierarchiesWithResponse request returning *ListHierarchiesResponse
+func (c *ClientWithResponses) ListHierarchiesWithResponse(ctx context.Context, params *ListHierarchiesParams, reqEditors ...RequestEditorFn) (*ListHierarchiesResponse, error) {
+	rsp, err := c.ListHierarchies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListHierarchiesResponse(rsp)
+}
+
+// ListPoliciesWithResponse request returning *ListPoliciesResponse
+func (c *ClientWithResponses) ListPoliciesWithResponse(ctx context.Context, params *ListPoliciesParams, reqEditors ...RequestEditorFn) (*ListPoliciesResponse, error) {
+	rsp, err := c.ListPolicies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPoliciesResponse(rsp)
+}
+
+// ListUsersWithResponse request returning *ListUsersResponse
+func (c *ClientWithResponses) ListUsersWithResponse(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*ListUsersResponse, error) {
+	rsp, err := c.ListUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUsersResponse(rsp)
+}
+
+// MetricsWithResponse request returning *MetricsResponse
+func (c *ClientWithResponses) MetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetricsResponse, error) {
+	rsp, err := c.Metrics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetricsResponse(rsp)
+}
+
+// OpenAPIWithResponse request returning *OpenAPIResponse
+func (c *ClientWithResponses) OpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*OpenAPIResponse, error) {
+	rsp, err := c.OpenAPI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOpenAPIResponse(rsp)
+}
+
+// ReadyzWithResponse request returning *ReadyzResponse
+func (c *ClientWithResponses) ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error) {
+	rsp, err := c.Readyz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadyzResponse(rsp)
+}
+
+// UpdateDBAllowWithBodyWithResponse request with arbitrary body returning *UpdateDBAllowResponse
+func (c *ClientWithResponses) UpdateDBAllowWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDBAllowResponse, error) {
+	rsp, err := c.UpdateDBAllowWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDBAllowResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDBAllowWithResponse(ctx context.Context, body UpdateDBAllowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDBAllowResponse, error) {
+	rsp, err := c.UpdateDBAllow(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDBAllowResponse(rsp)
+}
+
+// UpdateDBDenyWithBodyWithResponse request with arbitrary body returning *UpdateDBDenyResponse
+func (c *ClientWithResponses) UpdateDBDenyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDBDenyResponse, error) {
+	rsp, err := c.UpdateDBDenyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDBDenyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDBDenyWithResponse(ctx context.Context, body UpdateDBDenyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDBDenyResponse, error) {
+	rsp, err := c.UpdateDBDeny(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDBDenyResponse(rsp)
+}
+
+// ListAccessRequestsV1WithResponse request returning *ListAccessRequestsV1Response
+func (c *ClientWithResponses) ListAccessRequestsV1WithResponse(ctx context.Context, params *ListAccessRequestsV1Params, reqEditors ...RequestEditorFn) (*ListAccessRequestsV1Response, error) {
+	rsp, err := c.ListAccessRequestsV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAccessRequestsV1Response(rsp)
+}
+
+// SubmitAccessRequestV1WithBodyWithResponse request with arbitrary body returning *SubmitAccessRequestV1Response
+func (c *ClientWithResponses) SubmitAccessRequestV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitAccessRequestV1Response, error) {
+	rsp, err := c.SubmitAccessRequestV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitAccessRequestV1Response(rsp)
+}
+
+func (c *ClientWithResponses) SubmitAccessRequestV1WithResponse(ctx context.Context, body SubmitAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitAccessRequestV1Response, error) {
+	rsp, err := c.SubmitAccessRequestV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitAccessRequestV1Response(rsp)
+}
+
+// GetAccessRequestV1WithResponse request returning *GetAccessRequestV1Response
+func (c *ClientWithResponses) GetAccessRequestV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetAccessRequestV1Response, error) {
+	rsp, err := c.GetAccessRequestV1(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccessRequestV1Response(rsp)
+}
+
+// ApproveAccessRequestV1WithBodyWithResponse request with arbitrary body returning *ApproveAccessRequestV1Response
+func (c *ClientWithResponses) ApproveAccessRequestV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveAccessRequestV1Response, error) {
+	rsp, err := c.ApproveAccessRequestV1WithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveAccessRequestV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ApproveAccessRequestV1WithResponse(ctx context.Context, id int64, body ApproveAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveAccessRequestV1Response, error) {
+	rsp, err := c.ApproveAccessRequestV1(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveAccessRequestV1Response(rsp)
+}
+
+// RejectAccessRequestV1WithBodyWithResponse request with arbitrary body returning *RejectAccessRequestV1Response
+func (c *ClientWithResponses) RejectAccessRequestV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RejectAccessRequestV1Response, error) {
+	rsp, err := c.RejectAccessRequestV1WithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectAccessRequestV1Response(rsp)
+}
+
+func (c *ClientWithResponses) RejectAccessRequestV1WithResponse(ctx context.Context, id int64, body RejectAccessRequestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RejectAccessRequestV1Response, error) {
+	rsp, err := c.RejectAccessRequestV1(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectAccessRequestV1Response(rsp)
+}
+
+// ListDevicesV1WithResponse request returning *ListDevicesV1Response
+func (c *ClientWithResponses) ListDevicesV1WithResponse(ctx context.Context, params *ListDevicesV1Params, reqEditors ...RequestEditorFn) (*ListDevicesV1Response, error) {
+	rsp, err := c.ListDevicesV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDevicesV1Response(rsp)
+}
+
+// CreateDeviceV1WithBodyWithResponse request with arbitrary body returning *CreateDeviceV1Response
+func (c *ClientWithResponses) CreateDeviceV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeviceV1Response, error) {
+	rsp, err := c.CreateDeviceV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDeviceV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateDeviceV1WithResponse(ctx context.Context, body CreateDeviceV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeviceV1Response, error) {
+	rsp, err := c.CreateDeviceV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDeviceV1Response(rsp)
+}
+
+// GetDeviceV1WithResponse request returning *GetDeviceV1Response
+func (c *ClientWithResponses) GetDeviceV1WithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*GetDeviceV1Response, error) {
+	rsp, err := c.GetDeviceV1(ctx, devId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeviceV1Response(rsp)
+}
+
+// GetDeviceActionsV1WithResponse request returning *GetDeviceActionsV1Response
+func (c *ClientWithResponses) GetDeviceActionsV1WithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*GetDeviceActionsV1Response, error) {
+	rsp, err := c.GetDeviceActionsV1(ctx, devId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeviceActionsV1Response(rsp)
+}
+
+// GetDeviceCheckInfoV1WithResponse request returning *GetDeviceCheckInfoV1Response
+func (c *ClientWithResponses) GetDeviceCheckInfoV1WithResponse(ctx context.Context, devId string, reqEditors ...RequestEditorFn) (*GetDeviceCheckInfoV1Response, error) {
+	rsp, err := c.GetDeviceCheckInfoV1(ctx, devId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeviceCheckInfoV1Response(rsp)
+}
+
+// ListGrantsV1WithResponse request returning *ListGrantsV1Response
+func (c *ClientWithResponses) ListGrantsV1WithResponse(ctx context.Context, params *ListGrantsV1Params, reqEditors ...RequestEditorFn) (*ListGrantsV1Response, error) {
+	rsp, err := c.ListGrantsV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListGrantsV1Response(rsp)
+}
+
+// CreateGrantV1WithBodyWithResponse request with arbitrary body returning *CreateGrantV1Response
+func (c *ClientWithResponses) CreateGrantV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGrantV1Response, error) {
+	rsp, err := c.CreateGrantV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGrantV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateGrantV1WithResponse(ctx context.Context, body CreateGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGrantV1Response, error) {
+	rsp, err := c.CreateGrantV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGrantV1Response(rsp)
+}
+
+// ListGrantHistoryV1WithResponse request returning *ListGrantHistoryV1Response
+func (c *ClientWithResponses) ListGrantHistoryV1WithResponse(ctx context.Context, params *ListGrantHistoryV1Params, reqEditors ...RequestEditorFn) (*ListGrantHistoryV1Response, error) {
+	rsp, err := c.ListGrantHistoryV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListGrantHistoryV1Response(rsp)
+}
+
+// SubmitGrantV1WithBodyWithResponse request with arbitrary body returning *SubmitGrantV1Response
+func (c *ClientWithResponses) SubmitGrantV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitGrantV1Response, error) {
+	rsp, err := c.SubmitGrantV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitGrantV1Response(rsp)
+}
+
+func (c *ClientWithResponses) SubmitGrantV1WithResponse(ctx context.Context, body SubmitGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitGrantV1Response, error) {
+	rsp, err := c.SubmitGrantV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitGrantV1Response(rsp)
+}
+
+// IssueGrantV1WithBodyWithResponse request with arbitrary body returning *IssueGrantV1Response
+func (c *ClientWithResponses) IssueGrantV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IssueGrantV1Response, error) {
+	rsp, err := c.IssueGrantV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueGrantV1Response(rsp)
+}
+
+func (c *ClientWithResponses) IssueGrantV1WithResponse(ctx context.Context, body IssueGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*IssueGrantV1Response, error) {
+	rsp, err := c.IssueGrantV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueGrantV1Response(rsp)
+}
+
+// GetGrantV1WithResponse request returning *GetGrantV1Response
+func (c *ClientWithResponses) GetGrantV1WithResponse(ctx context.Context, userId string, tableName string, reqEditors ...RequestEditorFn) (*GetGrantV1Response, error) {
+	rsp, err := c.GetGrantV1(ctx, userId, tableName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGrantV1Response(rsp)
+}
+
+// UpdateGrantV1WithBodyWithResponse request with arbitrary body returning *UpdateGrantV1Response
+func (c *ClientWithResponses) UpdateGrantV1WithBodyWithResponse(ctx context.Context, userId string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGrantV1Response, error) {
+	rsp, err := c.UpdateGrantV1WithBody(ctx, userId, tableName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGrantV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateGrantV1WithResponse(ctx context.Context, userId string, tableName string, body UpdateGrantV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGrantV1Response, error) {
+	rsp, err := c.UpdateGrantV1(ctx, userId, tableName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGrantV1Response(rsp)
+}
+
+// GetGroupV1WithResponse request returning *GetGroupV1Response
+func (c *ClientWithResponses) GetGroupV1WithResponse(ctx context.Context, group string, reqEditors ...RequestEditorFn) (*GetGroupV1Response, error) {
+	rsp, err := c.GetGroupV1(ctx, group, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGroupV1Response(rsp)
+}
+
+// RemoveGroupMemberV1WithResponse request returning *RemoveGroupMemberV1Response
+func (c *ClientWithResponses) RemoveGroupMemberV1WithResponse(ctx context.Context, group string, userId string, reqEditors ...RequestEditorFn) (*RemoveGroupMemberV1Response, error) {
+	rsp, err := c.RemoveGroupMemberV1(ctx, group, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveGroupMemberV1Response(rsp)
+}
+
+// AddGroupMemberV1WithResponse request returning *AddGroupMemberV1Response
+func (c *ClientWithResponses) AddGroupMemberV1WithResponse(ctx context.Context, group string, userId string, reqEditors ...RequestEditorFn) (*AddGroupMemberV1Response, error) {
+	rsp, err := c.AddGroupMemberV1(ctx, group, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddGroupMemberV1Response(rsp)
+}
+
+// ListHierarchiesV1WithResponse request returning *ListHierarchiesV1Response
+func (c *ClientWithResponses) ListHierarchiesV1WithResponse(ctx context.Context, params *ListHierarchiesV1Params, reqEditors ...RequestEditorFn) (*ListHierarchiesV1Response, error) {
+	rsp, err := c.ListHierarchiesV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListHierarchiesV1Response(rsp)
+}
+
+// CreateHierarchyV1WithBodyWithResponse request with arbitrary body returning *CreateHierarchyV1Response
+func (c *ClientWithResponses) CreateHierarchyV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHierarchyV1Response, error) {
+	rsp, err := c.CreateHierarchyV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateHierarchyV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateHierarchyV1WithResponse(ctx context.Context, body CreateHierarchyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHierarchyV1Response, error) {
+	rsp, err := c.CreateHierarchyV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateHierarchyV1Response(rsp)
+}
+
+// GetHierarchyV1WithResponse request returning *GetHierarchyV1Response
+func (c *ClientWithResponses) GetHierarchyV1WithResponse(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*GetHierarchyV1Response, error) {
+	rsp, err := c.GetHierarchyV1(ctx, objId, action, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHierarchyV1Response(rsp)
+}
+
+// UpdateHierarchyV1WithBodyWithResponse request with arbitrary body returning *UpdateHierarchyV1Response
+func (c *ClientWithResponses) UpdateHierarchyV1WithBodyWithResponse(ctx context.Context, objId string, action string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHierarchyV1Response, error) {
+	rsp, err := c.UpdateHierarchyV1WithBody(ctx, objId, action, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateHierarchyV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateHierarchyV1WithResponse(ctx context.Context, objId string, action string, body UpdateHierarchyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHierarchyV1Response, error) {
+	rsp, err := c.UpdateHierarchyV1(ctx, objId, action, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateHierarchyV1Response(rsp)
+}
+
+// ListObjectsV1WithResponse request returning *ListObjectsV1Response
+func (c *ClientWithResponses) ListObjectsV1WithResponse(ctx context.Context, params *ListObjectsV1Params, reqEditors ...RequestEditorFn) (*ListObjectsV1Response, error) {
+	rsp, err := c.ListObjectsV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListObjectsV1Response(rsp)
+}
+
+// CreateObjectV1WithBodyWithResponse request with arbitrary body returning *CreateObjectV1Response
+func (c *ClientWithResponses) CreateObjectV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateObjectV1Response, error) {
+	rsp, err := c.CreateObjectV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateObjectV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateObjectV1WithResponse(ctx context.Context, body CreateObjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateObjectV1Response, error) {
+	rsp, err := c.CreateObjectV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateObjectV1Response(rsp)
+}
+
+// DeleteObjectV1WithResponse request returning *DeleteObjectV1Response
+func (c *ClientWithResponses) DeleteObjectV1WithResponse(ctx context.Context, objId string, reqEditors ...RequestEditorFn) (*DeleteObjectV1Response, error) {
+	rsp, err := c.DeleteObjectV1(ctx, objId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteObjectV1Response(rsp)
+}
+
+// GetObjectV1WithResponse request returning *GetObjectV1Response
+func (c *ClientWithResponses) GetObjectV1WithResponse(ctx context.Context, objId string, reqEditors ...RequestEditorFn) (*GetObjectV1Response, error) {
+	rsp, err := c.GetObjectV1(ctx, objId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetObjectV1Response(rsp)
+}
+
+// UpdateObjectV1WithBodyWithResponse request with arbitrary body returning *UpdateObjectV1Response
+func (c *ClientWithResponses) UpdateObjectV1WithBodyWithResponse(ctx context.Context, objId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateObjectV1Response, error) {
+	rsp, err := c.UpdateObjectV1WithBody(ctx, objId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateObjectV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateObjectV1WithResponse(ctx context.Context, objId string, body UpdateObjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateObjectV1Response, error) {
+	rsp, err := c.UpdateObjectV1(ctx, objId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateObjectV1Response(rsp)
+}
+
+// GetPolicyChainV1WithResponse request returning *GetPolicyChainV1Response
+func (c *ClientWithResponses) GetPolicyChainV1WithResponse(ctx context.Context, objId string, action string, reqEditors ...RequestEditorFn) (*GetPolicyChainV1Response, error) {
+	rsp, err := c.GetPolicyChainV1(ctx, objId, action, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPolicyChainV1Response(rsp)
+}
+
+// SetObjectPoliciesV1WithBodyWithResponse request with arbitrary body returning *SetObjectPoliciesV1Response
+func (c *ClientWithResponses) SetObjectPoliciesV1WithBodyWithResponse(ctx context.Context, objId string, action string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetObjectPoliciesV1Response, error) {
+	rsp, err := c.SetObjectPoliciesV1WithBody(ctx, objId, action, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetObjectPoliciesV1Response(rsp)
+}
+
+func (c *ClientWithResponses) SetObjectPoliciesV1WithResponse(ctx context.Context, objId string, action string, body SetObjectPoliciesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SetObjectPoliciesV1Response, error) {
+	rsp, err := c.SetObjectPoliciesV1(ctx, objId, action, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetObjectPoliciesV1Response(rsp)
+}
+
+// ListPoliciesV1WithResponse request returning *ListPoliciesV1Response
+func (c *ClientWithResponses) ListPoliciesV1WithResponse(ctx context.Context, params *ListPoliciesV1Params, reqEditors ...RequestEditorFn) (*ListPoliciesV1Response, error) {
+	rsp, err := c.ListPoliciesV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPoliciesV1Response(rsp)
+}
+
+// CreatePolicyV1WithBodyWithResponse request with arbitrary body returning *CreatePolicyV1Response
+func (c *ClientWithResponses) CreatePolicyV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePolicyV1Response, error) {
+	rsp, err := c.CreatePolicyV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePolicyV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreatePolicyV1WithResponse(ctx context.Context, body CreatePolicyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePolicyV1Response, error) {
+	rsp, err := c.CreatePolicyV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePolicyV1Response(rsp)
+}
+
+// GetPolicyV1WithResponse request returning *GetPolicyV1Response
+func (c *ClientWithResponses) GetPolicyV1WithResponse(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*GetPolicyV1Response, error) {
+	rsp, err := c.GetPolicyV1(ctx, ref, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPolicyV1Response(rsp)
+}
+
+// UpdatePolicyV1WithBodyWithResponse request with arbitrary body returning *UpdatePolicyV1Response
+func (c *ClientWithResponses) UpdatePolicyV1WithBodyWithResponse(ctx context.Context, ref string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePolicyV1Response, error) {
+	rsp, err := c.UpdatePolicyV1WithBody(ctx, ref, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePolicyV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePolicyV1WithResponse(ctx context.Context, ref string, body UpdatePolicyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePolicyV1Response, error) {
+	rsp, err := c.UpdatePolicyV1(ctx, ref, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePolicyV1Response(rsp)
+}
+
+// ListPolicyTestsV1WithResponse request returning *ListPolicyTestsV1Response
+func (c *ClientWithResponses) ListPolicyTestsV1WithResponse(ctx context.Context, ref string, reqEditors ...RequestEditorFn) (*ListPolicyTestsV1Response, error) {
+	rsp, err := c.ListPolicyTestsV1(ctx, ref, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPolicyTestsV1Response(rsp)
+}
+
+// RunPolicyTestsV1WithBodyWithResponse request with arbitrary body returning *RunPolicyTestsV1Response
+func (c *ClientWithResponses) RunPolicyTestsV1WithBodyWithResponse(ctx context.Context, ref string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunPolicyTestsV1Response, error) {
+	rsp, err := c.RunPolicyTestsV1WithBody(ctx, ref, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunPolicyTestsV1Response(rsp)
+}
+
+func (c *ClientWithResponses) RunPolicyTestsV1WithResponse(ctx context.Context, ref string, body RunPolicyTestsV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RunPolicyTestsV1Response, error) {
+	rsp, err := c.RunPolicyTestsV1(ctx, ref, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunPolicyTestsV1Response(rsp)
+}
+
+// DeletePolicyTestV1WithResponse request returning *DeletePolicyTestV1Response
+func (c *ClientWithResponses) DeletePolicyTestV1WithResponse(ctx context.Context, ref string, name string, reqEditors ...RequestEditorFn) (*DeletePolicyTestV1Response, error) {
+	rsp, err := c.DeletePolicyTestV1(ctx, ref, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePolicyTestV1Response(rsp)
+}
+
+// PutPolicyTestV1WithBodyWithResponse request with arbitrary body returning *PutPolicyTestV1Response
+func (c *ClientWithResponses) PutPolicyTestV1WithBodyWithResponse(ctx context.Context, ref string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPolicyTestV1Response, error) {
+	rsp, err := c.PutPolicyTestV1WithBody(ctx, ref, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPolicyTestV1Response(rsp)
+}
+
+func (c *ClientWithResponses) PutPolicyTestV1WithResponse(ctx context.Context, ref string, name string, body PutPolicyTestV1JSONRequestBody, reqEditors ...RequestEditorFn) (*PutPolicyTestV1Response, error) {
+	rsp, err := c.PutPolicyTestV1(ctx, ref, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPolicyTestV1Response(rsp)
+}
+
+// ListRolesV1WithResponse request returning *ListRolesV1Response
+func (c *ClientWithResponses) ListRolesV1WithResponse(ctx context.Context, params *ListRolesV1Params, reqEditors ...RequestEditorFn) (*ListRolesV1Response, error) {
+	rsp, err := c.ListRolesV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRolesV1Response(rsp)
+}
+
+// CreateRoleV1WithBodyWithResponse request with arbitrary body returning *CreateRoleV1Response
+func (c *ClientWithResponses) CreateRoleV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleV1Response, error) {
+	rsp, err := c.CreateRoleV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoleV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateRoleV1WithResponse(ctx context.Context, body CreateRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleV1Response, error) {
+	rsp, err := c.CreateRoleV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoleV1Response(rsp)
+}
+
+// DeleteRoleV1WithResponse request returning *DeleteRoleV1Response
+func (c *ClientWithResponses) DeleteRoleV1WithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteRoleV1Response, error) {
+	rsp, err := c.DeleteRoleV1(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRoleV1Response(rsp)
+}
+
+// GetRoleV1WithResponse request returning *GetRoleV1Response
+func (c *ClientWithResponses) GetRoleV1WithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetRoleV1Response, error) {
+	rsp, err := c.GetRoleV1(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoleV1Response(rsp)
+}
+
+// UpdateRoleV1WithBodyWithResponse request with arbitrary body returning *UpdateRoleV1Response
+func (c *ClientWithResponses) UpdateRoleV1WithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleV1Response, error) {
+	rsp, err := c.UpdateRoleV1WithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRoleV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRoleV1WithResponse(ctx context.Context, name string, body UpdateRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleV1Response, error) {
+	rsp, err := c.UpdateRoleV1(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRoleV1Response(rsp)
+}
+
+// UnassignRoleV1WithResponse request returning *UnassignRoleV1Response
+func (c *ClientWithResponses) UnassignRoleV1WithResponse(ctx context.Context, name string, kind UnassignRoleV1ParamsKind, id string, reqEditors ...RequestEditorFn) (*UnassignRoleV1Response, error) {
+	rsp, err := c.UnassignRoleV1(ctx, name, kind, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnassignRoleV1Response(rsp)
+}
+
+// AssignRoleV1WithResponse request returning *AssignRoleV1Response
+func (c *ClientWithResponses) AssignRoleV1WithResponse(ctx context.Context, name string, kind AssignRoleV1ParamsKind, id string, reqEditors ...RequestEditorFn) (*AssignRoleV1Response, error) {
+	rsp, err := c.AssignRoleV1(ctx, name, kind, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignRoleV1Response(rsp)
+}
+
+// SimulateV1WithBodyWithResponse request with arbitrary body returning *SimulateV1Response
+func (c *ClientWithResponses) SimulateV1WithBodyWithResponse(ctx context.Context, params *SimulateV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimulateV1Response, error) {
+	rsp, err := c.SimulateV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimulateV1Response(rsp)
+}
+
+func (c *ClientWithResponses) SimulateV1WithResponse(ctx context.Context, params *SimulateV1Params, body SimulateV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SimulateV1Response, error) {
+	rsp, err := c.SimulateV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimulateV1Response(rsp)
+}
+
+// ListUsersV1WithResponse request returning *ListUsersV1Response
+func (c *ClientWithResponses) ListUsersV1WithResponse(ctx context.Context, params *ListUsersV1Params, reqEditors ...RequestEditorFn) (*ListUsersV1Response, error) {
+	rsp, err := c.ListUsersV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUsersV1Response(rsp)
+}
+
+// CreateUserV1WithBodyWithResponse request with arbitrary body returning *CreateUserV1Response
+func (c *ClientWithResponses) CreateUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserV1Response, error) {
+	rsp, err := c.CreateUserV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserV1WithResponse(ctx context.Context, body CreateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserV1Response, error) {
+	rsp, err := c.CreateUserV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserV1Response(rsp)
+}
+
+// GetUserV1WithResponse request returning *GetUserV1Response
+func (c *ClientWithResponses) GetUserV1WithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*GetUserV1Response, error) {
+	rsp, err := c.GetUserV1(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserV1Response(rsp)
+}
+
+// UpdateUserV1WithBodyWithResponse request with arbitrary body returning *UpdateUserV1Response
+func (c *ClientWithResponses) UpdateUserV1WithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserV1Response, error) {
+	rsp, err := c.UpdateUserV1WithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserV1WithResponse(ctx context.Context, userId string, body UpdateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserV1Response, error) {
+	rsp, err := c.UpdateUserV1(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserV1Response(rsp)
+}
+
+// GetUserCheckInfoV1WithResponse request returning *GetUserCheckInfoV1Response
+func (c *ClientWithResponses) GetUserCheckInfoV1WithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*GetUserCheckInfoV1Response, error) {
+	rsp, err := c.GetUserCheckInfoV1(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserCheckInfoV1Response(rsp)
+}
+
+// GetUserRolesV1WithResponse request returning *GetUserRolesV1Response
+func (c *ClientWithResponses) GetUserRolesV1WithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*GetUserRolesV1Response, error) {
+	rsp, err := c.GetUserRolesV1(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserRolesV1Response(rsp)
+}
+
+// ListWebhooksWithResponse request returning *ListWebhooksResponse
+func (c *ClientWithResponses) ListWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWebhooksResponse, error) {
+	rsp, err := c.ListWebhooks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhooksResponse(rsp)
+}
+
+// ListWebhookDeliveriesWithResponse request returning *ListWebhookDeliveriesResponse
+func (c *ClientWithResponses) ListWebhookDeliveriesWithResponse(ctx context.Context, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error) {
+	rsp, err := c.ListWebhookDeliveries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookDeliveriesResponse(rsp)
+}
+
+// FindWebhookDeliveryWithResponse request returning *FindWebhookDeliveryResponse
+func (c *ClientWithResponses) FindWebhookDeliveryWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*FindWebhookDeliveryResponse, error) {
+	rsp, err := c.FindWebhookDelivery(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindWebhookDeliveryResponse(rsp)
+}
+
+// RetryWebhookDeliveryWithResponse request returning *RetryWebhookDeliveryResponse
+func (c *ClientWithResponses) RetryWebhookDeliveryWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*RetryWebhookDeliveryResponse, error) {
+	rsp, err := c.RetryWebhookDelivery(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryWebhookDeliveryResponse(rsp)
+}
+
+// ParseListAuditLogResponse parses an HTTP response from a ListAuditLogWithResponse call
+func ParseListAuditLogResponse(rsp *http.Response) (*ListAuditLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAuditLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamChangesResponse parses an HTTP response from a StreamChangesWithResponse call
+func ParseStreamChangesResponse(rsp *http.Response) (*StreamChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseStreamChangesWSResponse parses an HTTP response from a StreamChangesWSWithResponse call
+func ParseStreamChangesWSResponse(rsp *http.Response) (*StreamChangesWSResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamChangesWSResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDebugStatusResponse parses an HTTP response from a DebugStatusWithResponse call
+func ParseDebugStatusResponse(rsp *http.Response) (*DebugStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DebugStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportDataResponse parses an HTTP response from a ExportDataWithResponse call
+func ParseExportDataResponse(rsp *http.Response) (*ExportDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindDBAccessResponse parses an HTTP response from a FindDBAccessWithResponse call
+func ParseFindDBAccessResponse(rsp *http.Response) (*FindDBAccessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindDBAccessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DBAccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindDevActionsResponse parses an HTTP response from a FindDevActionsWithResponse call
+func ParseFindDevActionsResponse(rsp *http.Response) (*FindDevActionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindDevActionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DevActions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindDevAttrsResponse parses an HTTP response from a FindDevAttrsWithResponse call
+func ParseFindDevAttrsResponse(rsp *http.Response) (*FindDevAttrsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindDevAttrsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DevAttrs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindDevCheckInfoResponse parses an HTTP response from a FindDevCheckInfoWithResponse call
+func ParseFindDevCheckInfoResponse(rsp *http.Response) (*FindDevCheckInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindDevCheckInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DevCheckInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindHierarchyResponse parses an HTTP response from a FindHierarchyWithResponse call
+func ParseFindHierarchyResponse(rsp *http.Response) (*FindHierarchyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindHierarchyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Hierarchy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindPolicyResponse parses an HTTP response from a FindPolicyWithResponse call
+func ParseFindPolicyResponse(rsp *http.Response) (*FindPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindUserAttrsResponse parses an HTTP response from a FindUserAttrsWithResponse call
+func ParseFindUserAttrsResponse(rsp *http.Response) (*FindUserAttrsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindUserAttrsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserAttrs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindUserCheckInfoResponse parses an HTTP response from a FindUserCheckInfoWithResponse call
+func ParseFindUserCheckInfoResponse(rsp *http.Response) (*FindUserCheckInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindUserCheckInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserCheckInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHealthzResponse parses an HTTP response from a HealthzWithResponse call
+func ParseHealthzResponse(rsp *http.Response) (*HealthzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status string `json:"status"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportDataResponse parses an HTTP response from a ImportDataWithResponse call
+func ParseImportDataResponse(rsp *http.Response) (*ImportDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInsertPermInfoResponse parses an HTTP response from a InsertPermInfoWithResponse call
+func ParseInsertPermInfoResponse(rsp *http.Response) (*InsertPermInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InsertPermInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInsertUserAttrsResponse parses an HTTP response from a InsertUserAttrsWithResponse call
+func ParseInsertUserAttrsResponse(rsp *http.Response) (*InsertUserAttrsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InsertUserAttrsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSendJWTResponse parses an HTTP response from a SendJWTWithResponse call
+func ParseSendJWTResponse(rsp *http.Response) (*SendJWTResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SendJWTResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServerMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ServerMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ServerMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ServerMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDBAccessResponse parses an HTTP response from a ListDBAccessWithResponse call
+func ParseListDBAccessResponse(rsp *http.Response) (*ListDBAccessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDBAccessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DBAccessList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDevicesResponse parses an HTTP response from a ListDevicesWithResponse call
+func ParseListDevicesResponse(rsp *http.Response) (*ListDevicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDevicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeviceList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListHierarchiesResponse parses an HTTP response from a ListHierarchiesWithResponse call
+func ParseListHierarchiesResponse(rsp *http.Response) (*ListHierarchiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListHierarchiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HierarchyList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPoliciesResponse parses an HTTP response from a ListPoliciesWithResponse call
+func ParseListPoliciesResponse(rsp *http.Response) (*ListPoliciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPoliciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListUsersResponse parses an HTTP response from a ListUsersWithResponse call
+func ParseListUsersResponse(rsp *http.Response) (*ListUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMetricsResponse parses an HTTP response from a MetricsWithResponse call
+func ParseMetricsResponse(rsp *http.Response) (*MetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseOpenAPIResponse parses an HTTP response from a OpenAPIWithResponse call
+func ParseOpenAPIResponse(rsp *http.Response) (*OpenAPIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OpenAPIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadyzResponse parses an HTTP response from a ReadyzWithResponse call
+func ParseReadyzResponse(rsp *http.Response) (*ReadyzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadyzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReadyReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ReadyReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDBAllowResponse parses an HTTP response from a UpdateDBAllowWithResponse call
+func ParseUpdateDBAllowResponse(rsp *http.Response) (*UpdateDBAllowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDBAllowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDBDenyResponse parses an HTTP response from a UpdateDBDenyWithResponse call
+func ParseUpdateDBDenyResponse(rsp *http.Response) (*UpdateDBDenyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDBDenyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAccessRequestsV1Response parses an HTTP response from a ListAccessRequestsV1WithResponse call
+func ParseListAccessRequestsV1Response(rsp *http.Response) (*ListAccessRequestsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAccessRequestsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRequestList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitAccessRequestV1Response parses an HTTP response from a SubmitAccessRequestV1WithResponse call
+func ParseSubmitAccessRequestV1Response(rsp *http.Response) (*SubmitAccessRequestV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitAccessRequestV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AccessRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccessRequestV1Response parses an HTTP response from a GetAccessRequestV1WithResponse call
+func ParseGetAccessRequestV1Response(rsp *http.Response) (*GetAccessRequestV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccessRequestV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApproveAccessRequestV1Response parses an HTTP response from a ApproveAccessRequestV1WithResponse call
+func ParseApproveAccessRequestV1Response(rsp *http.Response) (*ApproveAccessRequestV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApproveAccessRequestV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRejectAccessRequestV1Response parses an HTTP response from a RejectAccessRequestV1WithResponse call
+func ParseRejectAccessRequestV1Response(rsp *http.Response) (*RejectAccessRequestV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RejectAccessRequestV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccessRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDevicesV1Response parses an HTTP response from a ListDevicesV1WithResponse call
+func ParseListDevicesV1Response(rsp *http.Response) (*ListDevicesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDevicesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeviceList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDeviceV1Response parses an HTTP response from a CreateDeviceV1WithResponse call
+func ParseCreateDeviceV1Response(rsp *http.Response) (*CreateDeviceV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDeviceV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeviceV1Response parses an HTTP response from a GetDeviceV1WithResponse call
+func ParseGetDeviceV1Response(rsp *http.Response) (*GetDeviceV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeviceV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DevAttrs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeviceActionsV1Response parses an HTTP response from a GetDeviceActionsV1WithResponse call
+func ParseGetDeviceActionsV1Response(rsp *http.Response) (*GetDeviceActionsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeviceActionsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DevActions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeviceCheckInfoV1Response parses an HTTP response from a GetDeviceCheckInfoV1WithResponse call
+func ParseGetDeviceCheckInfoV1Response(rsp *http.Response) (*GetDeviceCheckInfoV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeviceCheckInfoV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DevCheckInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListGrantsV1Response parses an HTTP response from a ListGrantsV1WithResponse call
+func ParseListGrantsV1Response(rsp *http.Response) (*ListGrantsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListGrantsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DBAccessList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateGrantV1Response parses an HTTP response from a CreateGrantV1WithResponse call
+func ParseCreateGrantV1Response(rsp *http.Response) (*CreateGrantV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateGrantV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListGrantHistoryV1Response parses an HTTP response from a ListGrantHistoryV1WithResponse call
+func ParseListGrantHistoryV1Response(rsp *http.Response) (*ListGrantHistoryV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListGrantHistoryV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArchivedGrantList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitGrantV1Response parses an HTTP response from a SubmitGrantV1WithResponse call
+func ParseSubmitGrantV1Response(rsp *http.Response) (*SubmitGrantV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitGrantV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServerMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseIssueGrantV1Response parses an HTTP response from a IssueGrantV1WithResponse call
+func ParseIssueGrantV1Response(rsp *http.Response) (*IssueGrantV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &IssueGrantV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IssuedGrant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGrantV1Response parses an HTTP response from a GetGrantV1WithResponse call
+func ParseGetGrantV1Response(rsp *http.Response) (*GetGrantV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGrantV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DBAccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateGrantV1Response parses an HTTP response from a UpdateGrantV1WithResponse call
+func ParseUpdateGrantV1Response(rsp *http.Response) (*UpdateGrantV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateGrantV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGroupV1Response parses an HTTP response from a GetGroupV1WithResponse call
+func ParseGetGroupV1Response(rsp *http.Response) (*GetGroupV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGroupV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupMembers
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveGroupMemberV1Response parses an HTTP response from a RemoveGroupMemberV1WithResponse call
+func ParseRemoveGroupMemberV1Response(rsp *http.Response) (*RemoveGroupMemberV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveGroupMemberV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddGroupMemberV1Response parses an HTTP response from a AddGroupMemberV1WithResponse call
+func ParseAddGroupMemberV1Response(rsp *http.Response) (*AddGroupMemberV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddGroupMemberV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListHierarchiesV1Response parses an HTTP response from a ListHierarchiesV1WithResponse call
+func ParseListHierarchiesV1Response(rsp *http.Response) (*ListHierarchiesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListHierarchiesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HierarchyList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateHierarchyV1Response parses an HTTP response from a CreateHierarchyV1WithResponse call
+func ParseCreateHierarchyV1Response(rsp *http.Response) (*CreateHierarchyV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateHierarchyV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHierarchyV1Response parses an HTTP response from a GetHierarchyV1WithResponse call
+func ParseGetHierarchyV1Response(rsp *http.Response) (*GetHierarchyV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHierarchyV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Hierarchy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateHierarchyV1Response parses an HTTP response from a UpdateHierarchyV1WithResponse call
+func ParseUpdateHierarchyV1Response(rsp *http.Response) (*UpdateHierarchyV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateHierarchyV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListObjectsV1Response parses an HTTP response from a ListObjectsV1WithResponse call
+func ParseListObjectsV1Response(rsp *http.Response) (*ListObjectsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListObjectsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ObjectList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateObjectV1Response parses an HTTP response from a CreateObjectV1WithResponse call
+func ParseCreateObjectV1Response(rsp *http.Response) (*CreateObjectV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateObjectV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ObjectNode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteObjectV1Response parses an HTTP response from a DeleteObjectV1WithResponse call
+func ParseDeleteObjectV1Response(rsp *http.Response) (*DeleteObjectV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteObjectV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetObjectV1Response parses an HTTP response from a GetObjectV1WithResponse call
+func ParseGetObjectV1Response(rsp *http.Response) (*GetObjectV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetObjectV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ObjectNode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateObjectV1Response parses an HTTP response from a UpdateObjectV1WithResponse call
+func ParseUpdateObjectV1Response(rsp *http.Response) (*UpdateObjectV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateObjectV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ObjectNode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPolicyChainV1Response parses an HTTP response from a GetPolicyChainV1WithResponse call
+func ParseGetPolicyChainV1Response(rsp *http.Response) (*GetPolicyChainV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPolicyChainV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyChain
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetObjectPoliciesV1Response parses an HTTP response from a SetObjectPoliciesV1WithResponse call
+func ParseSetObjectPoliciesV1Response(rsp *http.Response) (*SetObjectPoliciesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetObjectPoliciesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ObjectNode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPoliciesV1Response parses an HTTP response from a ListPoliciesV1WithResponse call
+func ParseListPoliciesV1Response(rsp *http.Response) (*ListPoliciesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPoliciesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePolicyV1Response parses an HTTP response from a CreatePolicyV1WithResponse call
+func ParseCreatePolicyV1Response(rsp *http.Response) (*CreatePolicyV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePolicyV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPolicyV1Response parses an HTTP response from a GetPolicyV1WithResponse call
+func ParseGetPolicyV1Response(rsp *http.Response) (*GetPolicyV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPolicyV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePolicyV1Response parses an HTTP response from a UpdatePolicyV1WithResponse call
+func ParseUpdatePolicyV1Response(rsp *http.Response) (*UpdatePolicyV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePolicyV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest PolicyTestFailure
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPolicyTestsV1Response parses an HTTP response from a ListPolicyTestsV1WithResponse call
+func ParseListPolicyTestsV1Response(rsp *http.Response) (*ListPolicyTestsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPolicyTestsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PolicyTest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRunPolicyTestsV1Response parses an HTTP response from a RunPolicyTestsV1WithResponse call
+func ParseRunPolicyTestsV1Response(rsp *http.Response) (*RunPolicyTestsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RunPolicyTestsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyTestReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePolicyTestV1Response parses an HTTP response from a DeletePolicyTestV1WithResponse call
+func ParseDeletePolicyTestV1Response(rsp *http.Response) (*DeletePolicyTestV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePolicyTestV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutPolicyTestV1Response parses an HTTP response from a PutPolicyTestV1WithResponse call
+func ParsePutPolicyTestV1Response(rsp *http.Response) (*PutPolicyTestV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutPolicyTestV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyTest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PolicyTest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRolesV1Response parses an HTTP response from a ListRolesV1WithResponse call
+func ParseListRolesV1Response(rsp *http.Response) (*ListRolesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRolesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RoleList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRoleV1Response parses an HTTP response from a CreateRoleV1WithResponse call
+func ParseCreateRoleV1Response(rsp *http.Response) (*CreateRoleV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRoleV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Role
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRoleV1Response parses an HTTP response from a DeleteRoleV1WithResponse call
+func ParseDeleteRoleV1Response(rsp *http.Response) (*DeleteRoleV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRoleV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRoleV1Response parses an HTTP response from a GetRoleV1WithResponse call
+func ParseGetRoleV1Response(rsp *http.Response) (*GetRoleV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRoleV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Role
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRoleV1Response parses an HTTP response from a UpdateRoleV1WithResponse call
+func ParseUpdateRoleV1Response(rsp *http.Response) (*UpdateRoleV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRoleV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Role
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnassignRoleV1Response parses an HTTP response from a UnassignRoleV1WithResponse call
+func ParseUnassignRoleV1Response(rsp *http.Response) (*UnassignRoleV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnassignRoleV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAssignRoleV1Response parses an HTTP response from a AssignRoleV1WithResponse call
+func ParseAssignRoleV1Response(rsp *http.Response) (*AssignRoleV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AssignRoleV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimulateV1Response parses an HTTP response from a SimulateV1WithResponse call
+func ParseSimulateV1Response(rsp *http.Response) (*SimulateV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimulateV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SimulateReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListUsersV1Response parses an HTTP response from a ListUsersV1WithResponse call
+func ParseListUsersV1Response(rsp *http.Response) (*ListUsersV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUsersV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserV1Response parses an HTTP response from a CreateUserV1WithResponse call
+func ParseCreateUserV1Response(rsp *http.Response) (*CreateUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserV1Response parses an HTTP response from a GetUserV1WithResponse call
+func ParseGetUserV1Response(rsp *http.Response) (*GetUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserAttrs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateUserV1Response parses an HTTP response from a UpdateUserV1WithResponse call
+func ParseUpdateUserV1Response(rsp *http.Response) (*UpdateUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WriteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserCheckInfoV1Response parses an HTTP response from a GetUserCheckInfoV1WithResponse call
+func ParseGetUserCheckInfoV1Response(rsp *http.Response) (*GetUserCheckInfoV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserCheckInfoV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserCheckInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserRolesV1Response parses an HTTP response from a GetUserRolesV1WithResponse call
+func ParseGetUserRolesV1Response(rsp *http.Response) (*GetUserRolesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserRolesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []UserRole
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhooksResponse parses an HTTP response from a ListWebhooksWithResponse call
+func ParseListWebhooksResponse(rsp *http.Response) (*ListWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhookDeliveriesResponse parses an HTTP response from a ListWebhookDeliveriesWithResponse call
+func ParseListWebhookDeliveriesResponse(rsp *http.Response) (*ListWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFindWebhookDeliveryResponse parses an HTTP response from a FindWebhookDeliveryWithResponse call
+func ParseFindWebhookDeliveryResponse(rsp *http.Response) (*FindWebhookDeliveryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindWebhookDeliveryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRetryWebhookDeliveryResponse parses an HTTP response from a RetryWebhookDeliveryWithResponse call
+func ParseRetryWebhookDeliveryResponse(rsp *http.Response) (*RetryWebhookDeliveryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RetryWebhookDeliveryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServerMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
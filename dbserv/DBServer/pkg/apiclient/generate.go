@@ -0,0 +1,10 @@
+// Package apiclient is a typed HTTP client for DBServer, generated from the
+// OpenAPI document served at /openapi.json (pkg/app/openapi.json). Regenerate
+// with go generate after editing the document.
+//
+// ClientWithResponses decodes JSON bodies into the types of this package;
+// Client returns the raw *http.Response. Write endpoints answer with plain
+// text such as "1 rows inserted ", which is left in the Body field.
+package apiclient
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config oapi-codegen.yaml ../app/openapi.json
@@ -0,0 +1,395 @@
+package app
+
+import (
+	sqlctx "context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Access requests put a person between asking for table access and getting
+// it. A user submits a request for N more days on a table, signing in with
+// HTTP Basic credentials checked against user_attrs, and may only ask for
+// themselves; one of the grant issuers (see grantissue.go) whose limits
+// cover that grant approves or rejects it with their bearer token. Approval
+// moves the access date in the same transaction that stores the decision,
+// so a request is never approved without its grant, and the audit log,
+// metrics and webhooks then see the same grant.update an "allow N days"
+// grant token causes. Every transition is kept in access_request_events and
+// returned as the request's history.
+
+const (
+	RequestPending  = "pending"
+	RequestApproved = "approved"
+	RequestRejected = "rejected"
+
+	RequestSubmitted = "submitted"
+)
+
+var (
+	errRequestDecided  = errors.New("access request has already been decided")
+	errNoGrantRow      = errors.New("user has no grant row for this table")
+	errBadCredentials  = errors.New("a user name and password are required")
+	errNotRequestOwner = errors.New("user_id must be the signed in user")
+)
+
+var accessRequestColumns = []string{"id", "user_id", "table_name", "days", "reason", "status", "decided_by", "decided_at", "created_at"}
+
+func accessRequestFromRow(row map[string]string) AccessRequest {
+	var r AccessRequest
+	fmt.Sscan(row["id"], &r.Id)
+	fmt.Sscan(row["days"], &r.Days)
+	r.User_id, r.Table_name, r.Reason, r.Status = row["user_id"], row["table_name"], row["reason"], row["status"]
+	r.Decided_by, r.Decided_at, r.Created_at = row["decided_by"], row["decided_at"], row["created_at"]
+	return r
+}
+
+// accessRequestList lists access requests, newest first. Filters: user_id,
+// table_name and status.
+var accessRequestList = listSpec{
+	entity:   "access_request",
+	query:    ListAccessRequestsQuery,
+	columns:  accessRequestColumns,
+	keys:     []string{"id"},
+	sortable: []string{"id"},
+	order:    "desc",
+	build:    func(row map[string]string) interface{} { return accessRequestFromRow(row) },
+	filter: func(q listQuery, f *listFilter) error {
+		for _, col := range []string{"user_id", "table_name", "status"} {
+			if v := q.get(col); v != "" {
+				f.add(col+" = ?", v)
+			}
+		}
+		return nil
+	},
+}
+
+// findAccessRequest reads one request and, with history, its transitions.
+func (s *Server) findAccessRequest(ctx sqlctx.Context, id int64, history bool) (AccessRequest, error) {
+	vals := make([]sql.NullString, len(accessRequestColumns))
+	ptrs := make([]interface{}, len(vals))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	done := timeQuery(ctx, FindAccessRequestQuery)
+	err := s.conn.QueryRowContext(ctx, FindAccessRequestQuery, id).Scan(ptrs...)
+	done(err)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+	row := make(map[string]string, len(accessRequestColumns))
+	for i, c := range accessRequestColumns {
+		row[c] = vals[i].String
+	}
+	r := accessRequestFromRow(row)
+	if !history {
+		return r, nil
+	}
+
+	done = timeQuery(ctx, ListAccessRequestEventsQuery)
+	res, err := s.conn.QueryContext(ctx, ListAccessRequestEventsQuery, id)
+	done(err)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+	defer res.Close()
+	for res.Next() {
+		var ev AccessRequestEvent
+		var comment sql.NullString
+		if err := res.Scan(&ev.Ts, &ev.Actor, &ev.Action, &comment); err != nil {
+			return AccessRequest{}, err
+		}
+		ev.Comment = comment.String
+		r.History = append(r.History, ev)
+	}
+	return r, res.Err()
+}
+
+// submitAccessRequest stores a pending request. Only users who already have
+// a grant row for the table can ask, since that row is what approval extends.
+func (s *Server) submitAccessRequest(ctx sqlctx.Context, req NewAccessRequest) (int64, error) {
+	if req.User_id == "" {
+		return 0, fmt.Errorf("%w: user_id is required", errInvalidGrant)
+	}
+	if req.Days <= 0 {
+		return 0, fmt.Errorf("%w: days must be positive", errInvalidGrant)
+	}
+	if _, err := (GrantSpec{Table_name: req.Table_name, Decision: DecisionAllow, Days: req.Days}).rule(); err != nil {
+		return 0, err
+	}
+
+	var current DBAccess
+	done := timeQuery(ctx, FindAccessDateQuery)
+	err := s.conn.QueryRowContext(ctx, FindAccessDateQuery, req.User_id, req.Table_name).
+		Scan(&current.User_id, &current.Table_name, &current.Db_access_date, &current.Db_deny_date)
+	done(err)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, errNoGrantRow
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().UTC().Format(auditTimeLayout)
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	done = timeQuery(ctx, InsertAccessRequestQuery)
+	res, err := tx.ExecContext(ctx, InsertAccessRequestQuery, req.User_id, req.Table_name, req.Days, req.Reason, RequestPending, now)
+	done(err)
+	if err != nil {
+		return 0, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	if err := insertAccessRequestEvent(ctx, tx, id, now, metaFrom(ctx).actor, RequestSubmitted, req.Reason); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	s.audit.Record(ctx, AuditRecord{Action: AuditAccessRequest, Target_type: "access_request", Target_id: strconv.FormatInt(id, 10),
+		After: toAuditJSON(req)})
+	accessRequests.WithLabelValues(RequestSubmitted).Inc()
+	return id, nil
+}
+
+// decideAccessRequest approves or rejects a pending request on behalf of
+// approver, who must be allowed to grant it. An approval extends the grant
+// in the transaction that stores it; if the grant cannot be extended the
+// request stays pending.
+func (s *Server) decideAccessRequest(ctx sqlctx.Context, id int64, approver GrantIssuer, approve bool, comment string) error {
+	r, err := s.findAccessRequest(ctx, id, false)
+	if err != nil {
+		return err
+	}
+	if r.Status != RequestPending {
+		return errRequestDecided
+	}
+	decision := DecisionDeny
+	spec := GrantSpec{Table_name: r.Table_name, Decision: DecisionDeny}
+	if approve {
+		decision = DecisionAllow
+		spec = GrantSpec{Table_name: r.Table_name, Decision: DecisionAllow, Days: r.Days}
+	}
+	// rejecting needs the same coverage of user and table as approving,
+	// but not the day limit
+	if _, _, err := approver.authorize(IssueGrantRequest{User_id: r.User_id, Grants: []GrantSpec{spec}}); err != nil {
+		return err
+	}
+
+	status := RequestRejected
+	if approve {
+		status = RequestApproved
+	}
+	actor := metaFrom(ctx).actor
+	now := time.Now().UTC().Format(auditTimeLayout)
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	done := timeQuery(ctx, DecideAccessRequestQuery)
+	res, err := tx.ExecContext(ctx, DecideAccessRequestQuery, status, actor, now, id, RequestPending)
+	done(err)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		// another approver got there first
+		return errRequestDecided
+	}
+	if err := insertAccessRequestEvent(ctx, tx, id, now, actor, status, comment); err != nil {
+		return err
+	}
+	var granted grantChange
+	if approve {
+		if granted, err = s.applyGrant(ctx, tx, r.User_id, r.Table_name, true, r.Days); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	after := r
+	after.Status, after.Decided_by, after.Decided_at = status, actor, now
+	s.audit.Record(ctx, AuditRecord{Action: AuditAccessRequest, Target_type: "access_request", Target_id: strconv.FormatInt(id, 10),
+		Before: toAuditJSON(r), After: toAuditJSON(after), Decision: decision})
+	accessRequests.WithLabelValues(status).Inc()
+	if approve {
+		s.reportGrant(ctx, granted)
+		s.webhooks.notify()
+	}
+	return nil
+}
+
+func insertAccessRequestEvent(ctx sqlctx.Context, tx *sql.Tx, id int64, ts string, actor string, action string, comment string) error {
+	done := timeQuery(ctx, InsertAccessRequestEventQuery)
+	_, err := tx.ExecContext(ctx, InsertAccessRequestEventQuery, id, ts, actor, action, comment)
+	done(err)
+	return err
+}
+
+func accessRequestID(context *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(context.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		v1Error(context, http.StatusBadRequest, "id must be a positive integer")
+		return 0, false
+	}
+	return id, true
+}
+
+// writeAccessRequest answers with request id and its history.
+func (s *Server) writeAccessRequest(context *gin.Context, code int, id int64) {
+	r, err := s.findAccessRequest(context.Request.Context(), id, true)
+	if errors.Is(err, sql.ErrNoRows) {
+		v1Error(context, http.StatusNotFound, "not found")
+		return
+	}
+	if err != nil {
+		s.log.ErrorContext(context.Request.Context(), "unable to read access request", "id", id, "err", err)
+		v1Error(context, http.StatusInternalServerError, "database error")
+		return
+	}
+	context.JSON(code, r)
+}
+
+// requester returns the user whose HTTP Basic credentials the request
+// carries, checked against user_attrs as the auth server checks a login.
+func (s *Server) requester(context *gin.Context) (string, error) {
+	user, password, ok := context.Request.BasicAuth()
+	if !ok || user == "" {
+		return "", errBadCredentials
+	}
+	info, err := s.findUserCheckInfo(context.Request.Context(), user)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", errBadCredentials
+	}
+	if err != nil {
+		return "", err
+	}
+	if subtle.ConstantTimeCompare([]byte(password), []byte(info.Password)) != 1 {
+		return "", errBadCredentials
+	}
+	return user, nil
+}
+
+// SubmitAccessRequestV1 files a pending access request for the signed in
+// user.
+func (s *Server) SubmitAccessRequestV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		user, err := s.requester(context)
+		switch {
+		case errors.Is(err, errBadCredentials):
+			context.Header("WWW-Authenticate", `Basic realm="access requests"`)
+			v1Error(context, http.StatusUnauthorized, err.Error())
+			return
+		case err != nil:
+			s.log.ErrorContext(context.Request.Context(), "unable to check credentials", "err", err)
+			v1Error(context, http.StatusInternalServerError, "database error")
+			return
+		}
+		var req NewAccessRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		if req.User_id != "" && req.User_id != user {
+			v1Error(context, http.StatusForbidden, errNotRequestOwner.Error())
+			return
+		}
+		req.User_id = user
+		ctx := WithActor(context.Request.Context(), "user:"+user)
+		context.Request = context.Request.WithContext(ctx)
+		ctx, cancelfunc := sqlctx.WithTimeout(ctx, queryTimeout)
+		defer cancelfunc()
+		id, err := s.submitAccessRequest(ctx, req)
+		switch {
+		case errors.Is(err, errInvalidGrant), errors.Is(err, errNoGrantRow):
+			v1Error(context, http.StatusBadRequest, err.Error())
+			return
+		case err != nil:
+			s.log.ErrorContext(ctx, "unable to submit access request", "err", err)
+			v1Error(context, http.StatusInternalServerError, "database error")
+			return
+		}
+		s.writeAccessRequest(context, http.StatusCreated, id)
+	}
+}
+
+// GetAccessRequestV1 reads one request with its history.
+func (s *Server) GetAccessRequestV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		id, ok := accessRequestID(context)
+		if !ok {
+			return
+		}
+		s.audit.Record(context.Request.Context(), AuditRecord{Action: AuditRead, Target_type: "access_request", Target_id: strconv.FormatInt(id, 10)})
+		s.writeAccessRequest(context, http.StatusOK, id)
+	}
+}
+
+// ApproveAccessRequestV1 approves a pending request and extends access.
+func (s *Server) ApproveAccessRequestV1() gin.HandlerFunc {
+	return s.decideAccessRequestV1(true)
+}
+
+// RejectAccessRequestV1 rejects a pending request.
+func (s *Server) RejectAccessRequestV1() gin.HandlerFunc {
+	return s.decideAccessRequestV1(false)
+}
+
+func (s *Server) decideAccessRequestV1(approve bool) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		approver, ok := s.grantIssuer(context)
+		if !ok {
+			context.Header("WWW-Authenticate", "Bearer")
+			v1Error(context, http.StatusUnauthorized, "an approver token is required")
+			return
+		}
+		id, ok := accessRequestID(context)
+		if !ok {
+			return
+		}
+		var req AccessRequestDecision
+		if context.Request.ContentLength != 0 && !s.bindV1(context, &req) {
+			return
+		}
+		ctx := WithActor(context.Request.Context(), "approver:"+approver.Name)
+		context.Request = context.Request.WithContext(ctx)
+		ctx, cancelfunc := sqlctx.WithTimeout(ctx, queryTimeout)
+		defer cancelfunc()
+
+		err := s.decideAccessRequest(ctx, id, approver, approve, req.Comment)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			v1Error(context, http.StatusNotFound, "not found")
+			return
+		case errors.Is(err, errRequestDecided), errors.Is(err, errNoGrantRow):
+			v1Error(context, http.StatusConflict, err.Error())
+			return
+		case errors.Is(err, errGrantForbidden):
+			s.log.WarnContext(ctx, "access request decision refused", "id", id, "approver", approver.Name, "err", err)
+			v1Error(context, http.StatusForbidden, err.Error())
+			return
+		case err != nil:
+			s.log.ErrorContext(ctx, "unable to decide access request", "id", id, "err", err)
+			v1Error(context, http.StatusInternalServerError, "database error")
+			return
+		}
+		s.writeAccessRequest(context, http.StatusOK, id)
+	}
+}
@@ -0,0 +1,156 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// expectAccessRequest expects request id, for days on orders, to be read
+// back with status.
+func expectAccessRequest(mock sqlmock.Sqlmock, id int64, days int, status string) {
+	mock.ExpectQuery(regexp.QuoteMeta(FindAccessRequestQuery)).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(accessRequestColumns).
+			AddRow(id, "svc_etl", "orders", days, "month end", status, nil, nil, "2024-01-01 00:00:00.000000"))
+}
+
+func TestApproveExtendsGrantInTheSameTransaction(t *testing.T) {
+	s, mock := newTestServer(t)
+	s.webhooks = newWebhookDispatcher(nil)
+	ctx := WithActor(sqlctx.Background(), "approver:oncall")
+	want := time.Now().AddDate(0, 0, 5).Format("2006-01-02")
+
+	expectAccessRequest(mock, 3, 5, RequestPending)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(DecideAccessRequestQuery)).
+		WithArgs(RequestApproved, "approver:oncall", sqlmock.AnyArg(), 3, RequestPending).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(InsertAccessRequestEventQuery)).
+		WithArgs(3, sqlmock.AnyArg(), "approver:oncall", RequestApproved, "ok").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(LockAccessDateQuery)).WithArgs("svc_etl", "orders").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "tbl_name", "db_access_date", "db_deny_date"}).
+			AddRow("svc_etl", "orders", "2024-01-01", nil))
+	mock.ExpectExec(regexp.QuoteMeta(UpdateSecureDBAllowQuery)).WithArgs(want, "svc_etl", "orders").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectAudit(mock, "approver:oncall", AuditAccessRequest)
+	expectAudit(mock, "approver:oncall", AuditGrant)
+
+	if err := s.decideAccessRequest(ctx, 3, oncall, true, "ok"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Without a grant row to extend, the approval is rolled back and the
+// request stays pending.
+func TestApproveWithoutGrantRowRollsBack(t *testing.T) {
+	s, mock := newTestServer(t)
+	s.webhooks = newWebhookDispatcher(nil)
+	expectAccessRequest(mock, 3, 5, RequestPending)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(DecideAccessRequestQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(InsertAccessRequestEventQuery)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(LockAccessDateQuery)).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	if err := s.decideAccessRequest(sqlctx.Background(), 3, oncall, true, ""); !errors.Is(err, errNoGrantRow) {
+		t.Fatalf("err = %v, want errNoGrantRow", err)
+	}
+}
+
+func TestDecideRefusals(t *testing.T) {
+	ctx := WithActor(sqlctx.Background(), "approver:oncall")
+	s, mock := newTestServer(t)
+
+	expectAccessRequest(mock, 3, 5, RequestRejected)
+	if err := s.decideAccessRequest(ctx, 3, oncall, true, ""); !errors.Is(err, errRequestDecided) {
+		t.Errorf("decided request: %v, want errRequestDecided", err)
+	}
+
+	// another approver decides between the read and the update
+	expectAccessRequest(mock, 3, 5, RequestPending)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(DecideAccessRequestQuery)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	if err := s.decideAccessRequest(ctx, 3, oncall, false, ""); !errors.Is(err, errRequestDecided) {
+		t.Errorf("concurrent decision: %v, want errRequestDecided", err)
+	}
+
+	// 30 days is over the approver's limit, but a rejection does not grant
+	expectAccessRequest(mock, 4, 30, RequestPending)
+	if err := s.decideAccessRequest(ctx, 4, oncall, true, ""); !errors.Is(err, errGrantForbidden) {
+		t.Errorf("approval over the limit: %v, want errGrantForbidden", err)
+	}
+	expectAccessRequest(mock, 4, 30, RequestPending)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(DecideAccessRequestQuery)).
+		WithArgs(RequestRejected, "approver:oncall", sqlmock.AnyArg(), 4, RequestPending).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(InsertAccessRequestEventQuery)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAudit(mock, "approver:oncall", AuditAccessRequest)
+	if err := s.decideAccessRequest(ctx, 4, oncall, false, ""); err != nil {
+		t.Errorf("rejection over the limit: %v", err)
+	}
+}
+
+// Requests are filed by a user signed in with Basic credentials, for
+// themselves only.
+func TestSubmitAccessRequestOverHTTP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, mock := newTestServer(t)
+	r := gin.New()
+	r.POST("/v1/access_requests", s.SubmitAccessRequestV1())
+	submit := func(user string, password string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/access_requests", strings.NewReader(body))
+		if user != "" {
+			req.SetBasicAuth(user, password)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	expectLogin := func(user string) {
+		mock.ExpectQuery(regexp.QuoteMeta(FindUserCheckInfoQuery)).WithArgs(user).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "pwd"}).AddRow(user, "hunter2"))
+	}
+	body := `{"table_name": "orders", "days": 5, "reason": "month end"}`
+
+	if w := submit("", "", body); w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") == "" {
+		t.Fatalf("no credentials: status %d", w.Code)
+	}
+	expectLogin("svc_etl")
+	if w := submit("svc_etl", "wrong", body); w.Code != http.StatusUnauthorized {
+		t.Fatalf("wrong password: status %d", w.Code)
+	}
+	expectLogin("svc_etl")
+	if w := submit("svc_etl", "hunter2", `{"user_id": "alice", "table_name": "orders", "days": 5}`); w.Code != http.StatusForbidden {
+		t.Fatalf("request for another user: status %d", w.Code)
+	}
+
+	expectLogin("svc_etl")
+	mock.ExpectQuery(regexp.QuoteMeta(FindAccessDateQuery)).WithArgs("svc_etl", "orders").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "tbl_name", "db_access_date", "db_deny_date"}).
+			AddRow("svc_etl", "orders", "2024-01-01", "2024-01-01"))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(InsertAccessRequestQuery)).
+		WithArgs("svc_etl", "orders", 5, "month end", RequestPending, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectExec(regexp.QuoteMeta(InsertAccessRequestEventQuery)).
+		WithArgs(3, sqlmock.AnyArg(), "user:svc_etl", RequestSubmitted, "month end").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAudit(mock, "user:svc_etl", AuditAccessRequest)
+	expectAccessRequest(mock, 3, 5, RequestPending)
+	mock.ExpectQuery(regexp.QuoteMeta(ListAccessRequestEventsQuery)).WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"ts", "actor", "action", "comment"}).
+			AddRow("2024-01-01 00:00:00.000000", "user:svc_etl", RequestSubmitted, "month end"))
+	w := submit("svc_etl", "hunter2", body)
+	if w.Code != http.StatusCreated || !strings.Contains(w.Body.String(), `"status":"pending"`) || !strings.Contains(w.Body.String(), `"actor":"user:svc_etl"`) {
+		t.Fatalf("submit: status %d %s", w.Code, w.Body)
+	}
+}
@@ -0,0 +1,99 @@
+package app
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Routes that dump or rewrite whole datasets, or that run caller supplied
+// code, are only served to callers holding an admin token. The admin tokens
+// file is a JSON array, for example:
+//
+//	[{"name": "ops", "token_sha256": "<hex sha256 of the bearer token>"}]
+//
+// Admins authenticate with "Authorization: Bearer <token>" like grant
+// issuers do, and are recorded in the audit log as "admin:<name>" instead of
+// by their client address. With no admin tokens configured these routes
+// refuse every caller.
+
+var errNoAdminTokens = errors.New("no admin tokens are configured")
+
+func LoadAdminTokens(path string) ([]AdminToken, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var admins []AdminToken
+	if err := json.Unmarshal(raw, &admins); err != nil {
+		return nil, fmt.Errorf("admin tokens %s: %v", path, err)
+	}
+	seen := make(map[string]bool)
+	for i := range admins {
+		a := &admins[i]
+		if a.Name == "" {
+			return nil, fmt.Errorf("admin tokens %s: token %d: name is required", path, i)
+		}
+		a.Token_sha256 = strings.ToLower(a.Token_sha256)
+		if b, err := hex.DecodeString(a.Token_sha256); err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("admin tokens %s: token %d: token_sha256 must be 64 hex digits", path, i)
+		}
+		if seen[a.Name] {
+			return nil, fmt.Errorf("admin tokens %s: duplicate name %q", path, a.Name)
+		}
+		seen[a.Name] = true
+	}
+	return admins, nil
+}
+
+// bearerHash returns the hex SHA-256 of a bearer token, as the issuers and
+// admin tokens files store it.
+func bearerHash(header string) ([]byte, bool) {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || token == "" {
+		return nil, false
+	}
+	sum := sha256.Sum256([]byte(token))
+	return []byte(hex.EncodeToString(sum[:])), true
+}
+
+// admin finds the admin whose token an Authorization header carries.
+func (s *Server) admin(header string) (AdminToken, error) {
+	if len(s.admins) == 0 {
+		return AdminToken{}, errNoAdminTokens
+	}
+	hash, ok := bearerHash(header)
+	if ok {
+		for _, a := range s.admins {
+			if subtle.ConstantTimeCompare(hash, []byte(a.Token_sha256)) == 1 {
+				return a, nil
+			}
+		}
+	}
+	return AdminToken{}, errors.New("an admin token is required")
+}
+
+// AdminOnly refuses callers without an admin token and records the admin as
+// the actor of the rest of the request.
+func (s *Server) AdminOnly() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		a, err := s.admin(context.GetHeader(authorizationHeader))
+		if err != nil {
+			context.Header("WWW-Authenticate", "Bearer")
+			v1Error(context, http.StatusUnauthorized, err.Error())
+			context.Abort()
+			return
+		}
+		ctx := WithActor(context.Request.Context(), "admin:"+a.Name)
+		context.Request = context.Request.WithContext(ctx)
+		context.Next()
+	}
+}
@@ -0,0 +1,280 @@
+package app
+
+import (
+	sqlctx "context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	AuditRead          = "read"
+	AuditReadCheckInfo = "read_check_info"
+	AuditList          = "list"
+	AuditInsert        = "insert"
+	AuditUpdate        = "update"
+	AuditImport        = "import"
+	AuditExport        = "export"
+	AuditCheck         = "access_check"
+	AuditGrant         = "grant_update"
+	AuditJWT           = "jwt_verify"
+	AuditIssue         = "grant_issue"
+	AuditAccessRequest = "access_request"
+	AuditArchive       = "grant_archive"
+	AuditDelete        = "delete"
+	AuditAssign        = "assign"
+	AuditUnassign      = "unassign"
+	AuditSimulate      = "simulate"
+	AuditTestRun       = "test_run"
+	AuditRetry         = "retry"
+
+	DecisionAllow = "allow"
+	DecisionDeny  = "deny"
+
+	requestIDHeader = "X-Request-ID"
+
+	auditTimeLayout = "2006-01-02 15:04:05.000000"
+)
+
+type requestMetaKey struct{}
+
+// requestMeta identifies who is behind a request. It travels in the request
+// context so that code without the gin context can still attribute its work.
+type requestMeta struct {
+	requestID string
+	actor     string
+}
+
+func metaFrom(ctx sqlctx.Context) requestMeta {
+	if ctx == nil {
+		return requestMeta{}
+	}
+	m, _ := ctx.Value(requestMetaKey{}).(requestMeta)
+	return m
+}
+
+// WithActor returns ctx with the actor replaced, e.g. by the verified issuer
+// of a grant token or the operator running a CLI subcommand.
+func WithActor(ctx sqlctx.Context, actor string) sqlctx.Context {
+	m := metaFrom(ctx)
+	m.actor = actor
+	return sqlctx.WithValue(ctx, requestMetaKey{}, m)
+}
+
+func newRequestID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// RequestContext tags every request with a request id (taken from
+// X-Request-ID when the caller sent one) and the acting principal. Until a
+// route authenticates the caller the actor is anonymous@<client address>;
+// nothing the caller merely claims is recorded. Routes that authenticate
+// replace it with the verified identity: admin:<name> (AdminOnly),
+// issuer:<name> and approver:<name> (grant issuer tokens), user:<id>
+// (access requests) and jwt:<iss> (grant tokens).
+func (s *Server) RequestContext() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		id := context.GetHeader(requestIDHeader)
+		if id == "" || len(id) > 64 {
+			id = newRequestID()
+		}
+		actor := "anonymous@" + context.ClientIP()
+		context.Header(requestIDHeader, id)
+		ctx := sqlctx.WithValue(context.Request.Context(), requestMetaKey{}, requestMeta{requestID: id, actor: actor})
+		context.Request = context.Request.WithContext(ctx)
+		context.Next()
+	}
+}
+
+// Auditor appends audit records to the audit_log table and, optionally, to
+// a JSON Lines file. Records are hash chained, see auditchain.go.
+type Auditor struct {
+	conn *sql.DB
+	log  *slog.Logger
+	// mu keeps lines in the file whole; the chain is ordered by audit_head
+	mu   sync.Mutex
+	file *os.File
+
+	signer ed25519.PrivateKey
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func NewAuditor(conn *sql.DB, path string) (*Auditor, error) {
+	a := &Auditor{conn: conn, log: slog.Default()}
+	if path != "" {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("opening audit file: %w", err)
+		}
+		a.file = f
+	}
+	return a, nil
+}
+
+func (a *Auditor) Close() error {
+	if a.stop != nil {
+		close(a.stop)
+		<-a.done
+		a.stop = nil
+	}
+	if a.file == nil {
+		return nil
+	}
+	return a.file.Close()
+}
+
+// Record stores rec, filling in the timestamp, request id and actor from ctx
+// when they are not set. Failures are logged rather than returned so that an
+// audit outage does not take the data path down with it.
+func (a *Auditor) Record(ctx sqlctx.Context, rec AuditRecord) {
+	meta := metaFrom(ctx)
+	if rec.Request_id == "" {
+		rec.Request_id = meta.requestID
+	}
+	if rec.Actor == "" {
+		rec.Actor = meta.actor
+	}
+	if rec.Actor == "" {
+		rec.Actor = "system"
+	}
+	if rec.Ts == "" {
+		rec.Ts = time.Now().UTC().Format(auditTimeLayout)
+	}
+
+	if err := a.append(ctx, &rec); err != nil {
+		a.log.ErrorContext(ctx, "audit: unable to store record", "action", rec.Action,
+			"target_type", rec.Target_type, "target_id", rec.Target_id, "err", err)
+	}
+
+	if a.file != nil {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		line, err := json.Marshal(rec)
+		if err == nil {
+			_, err = a.file.Write(append(line, '\n'))
+		}
+		if err != nil {
+			a.log.ErrorContext(ctx, "audit: unable to write record to file", "err", err)
+		}
+	}
+}
+
+func nullString(v string) sql.NullString {
+	return sql.NullString{String: v, Valid: v != ""}
+}
+
+// snapshot returns the first row of query as a JSON object keyed by column
+// name, or "" if there is no such row. It is used for before/after values.
+func (s *Server) snapshot(ctx sqlctx.Context, query string, args ...interface{}) string {
+	done := timeQuery(ctx, query)
+	res, err := s.conn.QueryContext(ctx, query, args...)
+	done(err)
+	if err != nil {
+		s.log.ErrorContext(ctx, "audit: snapshot query failed", "query", query, "err", err)
+		return ""
+	}
+	defer res.Close()
+	if !res.Next() {
+		return ""
+	}
+	cols, err := res.Columns()
+	if err != nil {
+		return ""
+	}
+	vals := make([]sql.NullString, len(cols))
+	ptrs := make([]interface{}, len(vals))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	if err := res.Scan(ptrs...); err != nil {
+		return ""
+	}
+	row := make(map[string]string, len(cols))
+	for i, c := range cols {
+		row[c] = vals[i].String
+	}
+	return toAuditJSON(row)
+}
+
+// toAuditJSON marshals v for before/after columns with secrets blanked out.
+func toAuditJSON(v interface{}) string {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return string(raw)
+	}
+	raw, _ = json.Marshal(redactValue(generic))
+	return string(raw)
+}
+
+// ListAuditLog serves /audit. Filters: actor, action, target_type,
+// target_id, decision, request_id, since and until (RFC 3339 or YYYY-MM-DD,
+// compared in UTC). Records are returned newest first unless order=asc.
+func (s *Server) ListAuditLog() gin.HandlerFunc {
+	spec := listSpec{
+		entity:   "audit",
+		query:    ListAuditLogQuery,
+		columns:  []string{"id", "ts", "request_id", "actor", "action", "target_type", "target_id", "before_val", "after_val", "decision", "prev_hash", "hash"},
+		keys:     []string{"id"},
+		sortable: []string{"id"},
+		order:    "desc",
+		build: func(row map[string]string) interface{} {
+			var id int64
+			fmt.Sscan(row["id"], &id)
+			return AuditRecord{Id: id, Ts: row["ts"], Request_id: row["request_id"], Actor: row["actor"],
+				Action: row["action"], Target_type: row["target_type"], Target_id: row["target_id"],
+				Before: row["before_val"], After: row["after_val"], Decision: row["decision"],
+				Prev_hash: row["prev_hash"], Hash: row["hash"]}
+		},
+		filter: func(q listQuery, f *listFilter) error {
+			for _, col := range []string{"actor", "action", "target_type", "target_id", "decision", "request_id"} {
+				if v := q.get(col); v != "" {
+					f.add(col+" = ?", v)
+				}
+			}
+			for _, b := range []struct{ param, cond string }{{"since", "ts >= ?"}, {"until", "ts < ?"}} {
+				v := q.get(b.param)
+				if v == "" {
+					continue
+				}
+				t, err := parseAuditTime(v)
+				if err != nil {
+					return fmt.Errorf("%s: %v", b.param, err)
+				}
+				f.add(b.cond, t.UTC().Format(auditTimeLayout))
+			}
+			return nil
+		},
+	}
+	return func(context *gin.Context) {
+		s.list(context, spec)
+	}
+}
+
+func parseAuditTime(v string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		return t, fmt.Errorf("expected RFC 3339 time or YYYY-MM-DD date")
+	}
+	return t, nil
+}
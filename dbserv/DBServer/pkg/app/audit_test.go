@@ -0,0 +1,130 @@
+package app
+
+import (
+	sqlctx "context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, _ := newTestServer(t)
+	var got requestMeta
+	r := gin.New()
+	r.GET("/", s.RequestContext(), func(context *gin.Context) { got = metaFrom(context.Request.Context()) })
+
+	for _, tc := range []struct {
+		id, actor         string
+		wantID, wantActor string
+	}{
+		// a claimed actor is not taken at its word
+		{id: "req-1", actor: "admin:ops", wantID: "req-1", wantActor: "anonymous@192.0.2.1"},
+		{wantActor: "anonymous@192.0.2.1"},
+		{id: strings.Repeat("x", 65), actor: "ops"},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		if tc.id != "" {
+			req.Header.Set(requestIDHeader, tc.id)
+		}
+		if tc.actor != "" {
+			req.Header.Set("X-Actor", tc.actor)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		switch {
+		case tc.wantID != "" && got.requestID != tc.wantID:
+			t.Errorf("X-Request-ID %q: request id %q, want it kept", tc.id, got.requestID)
+		case tc.wantID == "" && (got.requestID == "" || got.requestID == tc.id):
+			t.Errorf("X-Request-ID %q: request id %q, want a new one", tc.id, got.requestID)
+		}
+		if w.Header().Get(requestIDHeader) != got.requestID {
+			t.Errorf("response X-Request-ID %q, want %q", w.Header().Get(requestIDHeader), got.requestID)
+		}
+		if tc.wantActor != "" && got.actor != tc.wantActor {
+			t.Errorf("actor %q, want %q", got.actor, tc.wantActor)
+		}
+	}
+}
+
+func TestRecordWritesDatabaseAndFile(t *testing.T) {
+	s, mock := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.audit.file = f
+	defer f.Close()
+
+	expectAudit(mock, "cli:bob", AuditUpdate)
+	s.audit.Record(WithActor(sqlctx.Background(), "cli:bob"), AuditRecord{Action: AuditUpdate, Target_type: "user", Target_id: "alice"})
+	// an audit database outage still leaves the file record
+	mock.ExpectBegin().WillReturnError(errors.New("database is down"))
+	s.audit.Record(sqlctx.Background(), AuditRecord{Action: AuditRead, Target_type: "user", Target_id: "alice"})
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("audit file has %d lines, want 2", len(lines))
+	}
+	var first, second AuditRecord
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &second); err != nil {
+		t.Fatal(err)
+	}
+	if first.Actor != "cli:bob" || first.Ts == "" || first.Hash == "" {
+		t.Errorf("first record = %+v", first)
+	}
+	if second.Actor != "system" || second.Action != AuditRead {
+		t.Errorf("second record = %+v, want a read by system", second)
+	}
+}
+
+func TestToAuditJSONRedactsSecrets(t *testing.T) {
+	got := toAuditJSON(map[string]interface{}{"user_id": "alice", "pwd": "hunter2", "nested": map[string]string{"Token": "t"}})
+	if strings.Contains(got, "hunter2") || strings.Contains(got, `"t"`) || !strings.Contains(got, `"user_id":"alice"`) {
+		t.Fatalf("toAuditJSON = %s", got)
+	}
+}
+
+// The audit log holds the before and after values of every change, so it
+// is served to admins only.
+func TestAuditLogNeedsAnAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, mock := newTestServer(t)
+	withAdmin(s, "ops", "s3cret")
+	s.router = gin.New()
+	r := s.Routes()
+
+	req := httptest.NewRequest(http.MethodGet, "/audit", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("without a token: status %d, want 401", w.Code)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(ListAuditLogQuery)).WillReturnRows(sqlmock.NewRows([]string{"id", "ts", "request_id", "actor", "action", "target_type", "target_id", "before_val", "after_val", "decision", "prev_hash", "hash"}))
+	req = httptest.NewRequest(http.MethodGet, "/audit", nil)
+	req.Header.Set("Authorization", "Bearer s3cret")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("as an admin: status %d %s", w.Code, w.Body)
+	}
+}
@@ -0,0 +1,307 @@
+package app
+
+import (
+	sqlctx "context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Every audit_log row carries prev_hash, the hash of the row before it, and
+// hash, a SHA-256 over prev_hash and the row's own fields. Editing, removing
+// or reordering rows therefore breaks the chain from that point on. On top of
+// that the server periodically signs the head of the chain with an ed25519
+// key and stores the signature in audit_checkpoint, so truncating the tail
+// or rewriting the whole chain is caught as well.
+
+const checkpointDomain = "abac-audit-checkpoint"
+
+// auditHash is computed over a JSON array so that field boundaries are
+// unambiguous. The row id is not covered because it is only known after the
+// insert; ordering is protected by prev_hash instead.
+func auditHash(rec AuditRecord) string {
+	fields := []string{rec.Prev_hash, rec.Ts, rec.Request_id, rec.Actor, rec.Action,
+		rec.Target_type, rec.Target_id, rec.Before, rec.After, rec.Decision}
+	raw, _ := json.Marshal(fields)
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// append links rec to the current head and inserts it. The head lives in
+// the single audit_head row, which is locked until the record is in, so
+// concurrent writers, including other processes such as the import
+// subcommand, queue on it and cannot fork the chain. The write is not bound
+// to the caller's deadline: a cancelled request still gets audited.
+func (a *Auditor) append(parent sqlctx.Context, rec *AuditRecord) error {
+	ctx := sqlctx.WithoutCancel(parent)
+	tx, err := a.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	done := timeQuery(ctx, LockAuditHeadQuery)
+	err = tx.QueryRowContext(ctx, LockAuditHeadQuery).Scan(&rec.Prev_hash)
+	done(err)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.New("audit_head is not seeded; run the migrations")
+	}
+	if err != nil {
+		return err
+	}
+	rec.Hash = auditHash(*rec)
+
+	done = timeQuery(ctx, InsertAuditLogQuery)
+	res, err := tx.ExecContext(ctx, InsertAuditLogQuery, rec.Ts, rec.Request_id, rec.Actor, rec.Action,
+		rec.Target_type, rec.Target_id, nullString(rec.Before), nullString(rec.After), rec.Decision,
+		rec.Prev_hash, rec.Hash)
+	done(err)
+	if err != nil {
+		return err
+	}
+	done = timeQuery(ctx, MoveAuditHeadQuery)
+	_, err = tx.ExecContext(ctx, MoveAuditHeadQuery, rec.Hash)
+	done(err)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	if id, err := res.LastInsertId(); err == nil {
+		rec.Id = id
+	}
+	return nil
+}
+
+func auditKeyID(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return hex.EncodeToString(sum[:8])
+}
+
+func checkpointMessage(recordID int64, hash string, createdAt string) []byte {
+	return []byte(fmt.Sprintf("%s\n%d\n%s\n%s", checkpointDomain, recordID, hash, createdAt))
+}
+
+// GenerateAuditKey returns a new hex encoded ed25519 seed suitable for
+// LoadAuditKey, together with the hex encoded public key.
+func GenerateAuditKey() (seed string, pub string, err error) {
+	p, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(priv.Seed()), hex.EncodeToString(p), nil
+}
+
+// LoadAuditKey reads a hex encoded ed25519 seed (or full private key) from
+// path.
+func LoadAuditKey(path string) (ed25519.PrivateKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("audit key %s: %v", path, err)
+	}
+	switch len(b) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(b), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(b), nil
+	}
+	return nil, fmt.Errorf("audit key %s: expected %d or %d bytes, got %d", path, ed25519.SeedSize, ed25519.PrivateKeySize, len(b))
+}
+
+// ParseAuditPublicKey decodes a hex encoded ed25519 public key.
+func ParseAuditPublicKey(s string) (ed25519.PublicKey, error) {
+	b, err := hex.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("expected %d byte public key, got %d", ed25519.PublicKeySize, len(b))
+	}
+	return ed25519.PublicKey(b), nil
+}
+
+// EnableCheckpoints signs the chain head with key every interval until the
+// Auditor is closed.
+func (a *Auditor) EnableCheckpoints(key ed25519.PrivateKey, interval time.Duration) {
+	a.signer = key
+	a.stop = make(chan struct{})
+	a.done = make(chan struct{})
+	go func() {
+		defer close(a.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-a.stop:
+				return
+			case <-ticker.C:
+				if _, err := a.Checkpoint(sqlctx.Background()); err != nil {
+					a.log.Error("audit: checkpoint failed", "err", err)
+				}
+			}
+		}
+	}()
+}
+
+// Checkpoint signs the current head of the chain. It returns nil when
+// nothing was appended since the last checkpoint.
+func (a *Auditor) Checkpoint(ctx sqlctx.Context) (*AuditCheckpoint, error) {
+	if a.signer == nil {
+		return nil, errors.New("no audit signing key configured")
+	}
+	var cp AuditCheckpoint
+	done := timeQuery(ctx, FindAuditHeadQuery)
+	err := a.conn.QueryRowContext(ctx, FindAuditHeadQuery).Scan(&cp.Record_id, &cp.Hash)
+	done(err)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && cp.Hash == "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var last int64
+	done = timeQuery(ctx, FindLastCheckpointQuery)
+	err = a.conn.QueryRowContext(ctx, FindLastCheckpointQuery).Scan(&last)
+	done(err)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if last == cp.Record_id {
+		return nil, nil
+	}
+
+	cp.Created_at = time.Now().UTC().Format(auditTimeLayout)
+	cp.Key_id = auditKeyID(a.signer.Public().(ed25519.PublicKey))
+	cp.Signature = hex.EncodeToString(ed25519.Sign(a.signer, checkpointMessage(cp.Record_id, cp.Hash, cp.Created_at)))
+	done = timeQuery(ctx, InsertAuditCheckpointQuery)
+	res, err := a.conn.ExecContext(ctx, InsertAuditCheckpointQuery, cp.Record_id, cp.Hash, cp.Key_id, cp.Signature, cp.Created_at)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	cp.Id, _ = res.LastInsertId()
+	return &cp, nil
+}
+
+// VerifyAuditChain walks audit_log in id order and stops at the first row
+// whose link or hash does not match, or whose checkpoint disagrees with it.
+// Checkpoint signatures are only checked when pub is not nil. Rows written before
+// the chain existed (empty hash, at the start of the table) are counted as
+// legacy and otherwise skipped.
+func VerifyAuditChain(ctx sqlctx.Context, db *sql.DB, pub ed25519.PublicKey) (AuditVerifyReport, error) {
+	var report AuditVerifyReport
+
+	// checkpoints are few, load them first and match them during the walk
+	checkpoints := make(map[int64][]AuditCheckpoint)
+	done := timeQuery(ctx, ScanAuditCheckpointQuery)
+	cps, err := db.QueryContext(ctx, ScanAuditCheckpointQuery)
+	done(err)
+	if err != nil {
+		return report, err
+	}
+	for cps.Next() {
+		var cp AuditCheckpoint
+		if err := cps.Scan(&cp.Id, &cp.Record_id, &cp.Hash, &cp.Key_id, &cp.Signature, &cp.Created_at); err != nil {
+			cps.Close()
+			return report, err
+		}
+		checkpoints[cp.Record_id] = append(checkpoints[cp.Record_id], cp)
+	}
+	cps.Close()
+	if err := cps.Err(); err != nil {
+		return report, err
+	}
+
+	done = timeQuery(ctx, ScanAuditLogQuery)
+	res, err := db.QueryContext(ctx, ScanAuditLogQuery)
+	done(err)
+	if err != nil {
+		return report, err
+	}
+	defer res.Close()
+
+	prev := ""
+	chained := false
+	for res.Next() {
+		var rec AuditRecord
+		var before, after sql.NullString
+		if err := res.Scan(&rec.Id, &rec.Ts, &rec.Request_id, &rec.Actor, &rec.Action, &rec.Target_type,
+			&rec.Target_id, &before, &after, &rec.Decision, &rec.Prev_hash, &rec.Hash); err != nil {
+			return report, err
+		}
+		rec.Before, rec.After = before.String, after.String
+		report.Records++
+
+		if rec.Hash == "" && !chained {
+			report.Legacy++
+			continue
+		}
+		chained = true
+		switch {
+		case rec.Hash == "":
+			return broken(report, rec.Id, "record has no hash"), nil
+		case rec.Prev_hash != prev:
+			return broken(report, rec.Id, "prev_hash does not match the preceding record"), nil
+		case auditHash(rec) != rec.Hash:
+			return broken(report, rec.Id, "record contents do not match its hash"), nil
+		}
+		prev = rec.Hash
+
+		for _, cp := range checkpoints[rec.Id] {
+			if reason := checkCheckpoint(cp, rec.Hash, pub); reason != "" {
+				return broken(report, rec.Id, reason), nil
+			}
+			report.Checkpoints++
+		}
+		delete(checkpoints, rec.Id)
+	}
+	if err := res.Err(); err != nil {
+		return report, err
+	}
+
+	// anything left points at a record that is no longer in the chain,
+	// typically because the tail of the log was removed
+	for id, list := range checkpoints {
+		return broken(report, id, fmt.Sprintf("checkpoint %d refers to a missing record", list[0].Id)), nil
+	}
+
+	report.Ok = true
+	return report, nil
+}
+
+func checkCheckpoint(cp AuditCheckpoint, hash string, pub ed25519.PublicKey) string {
+	if cp.Hash != hash {
+		return fmt.Sprintf("checkpoint %d does not match the chain", cp.Id)
+	}
+	if pub == nil {
+		return ""
+	}
+	if cp.Key_id != auditKeyID(pub) {
+		return fmt.Sprintf("checkpoint %d was signed by unknown key %s", cp.Id, cp.Key_id)
+	}
+	sig, err := hex.DecodeString(cp.Signature)
+	if err != nil || !ed25519.Verify(pub, checkpointMessage(cp.Record_id, cp.Hash, cp.Created_at), sig) {
+		return fmt.Sprintf("checkpoint %d has an invalid signature", cp.Id)
+	}
+	return ""
+}
+
+func broken(report AuditVerifyReport, id int64, reason string) AuditVerifyReport {
+	report.Ok = false
+	report.Broken_at = id
+	report.Reason = reason
+	return report
+}
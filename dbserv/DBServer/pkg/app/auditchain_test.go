@@ -0,0 +1,126 @@
+package app
+
+import (
+	sqlctx "context"
+	"crypto/ed25519"
+	"database/sql/driver"
+	"encoding/hex"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var auditColumns = []string{"id", "ts", "request_id", "actor", "action", "target_type", "target_id",
+	"before_val", "after_val", "decision", "prev_hash", "hash"}
+
+// testChain links n records the way append does, after one legacy record
+// written before the chain existed.
+func testChain(n int) []AuditRecord {
+	recs := []AuditRecord{{Id: 1, Ts: "2024-01-01 00:00:00.000000", Actor: "system", Action: AuditRead}}
+	prev := ""
+	for i := 0; i < n; i++ {
+		rec := AuditRecord{Id: int64(i + 2), Ts: "2024-01-02 00:00:00.000000", Request_id: "r", Actor: "cli:bob",
+			Action: AuditUpdate, Target_type: "user", Target_id: "alice", After: `{"attrs":"{}"}`, Prev_hash: prev}
+		rec.Hash = auditHash(rec)
+		prev = rec.Hash
+		recs = append(recs, rec)
+	}
+	return recs
+}
+
+func auditRows(recs []AuditRecord) *sqlmock.Rows {
+	rows := sqlmock.NewRows(auditColumns)
+	for _, r := range recs {
+		var after driver.Value
+		if r.After != "" {
+			after = r.After
+		}
+		rows.AddRow(r.Id, r.Ts, r.Request_id, r.Actor, r.Action, r.Target_type, r.Target_id, nil, after, r.Decision, r.Prev_hash, r.Hash)
+	}
+	return rows
+}
+
+func signedCheckpoint(key ed25519.PrivateKey, id int64, rec AuditRecord) AuditCheckpoint {
+	cp := AuditCheckpoint{Id: id, Record_id: rec.Id, Hash: rec.Hash, Created_at: "2024-01-03 00:00:00.000000",
+		Key_id: auditKeyID(key.Public().(ed25519.PublicKey))}
+	cp.Signature = hex.EncodeToString(ed25519.Sign(key, checkpointMessage(cp.Record_id, cp.Hash, cp.Created_at)))
+	return cp
+}
+
+func verify(t *testing.T, recs []AuditRecord, cps []AuditCheckpoint, pub ed25519.PublicKey) AuditVerifyReport {
+	t.Helper()
+	s, mock := newTestServer(t)
+	rows := sqlmock.NewRows([]string{"id", "record_id", "hash", "key_id", "signature", "created_at"})
+	for _, cp := range cps {
+		rows.AddRow(cp.Id, cp.Record_id, cp.Hash, cp.Key_id, cp.Signature, cp.Created_at)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(ScanAuditCheckpointQuery)).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(ScanAuditLogQuery)).WillReturnRows(auditRows(recs))
+	report, err := VerifyAuditChain(sqlctx.Background(), s.conn, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return report
+}
+
+func TestVerifyAuditChain(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPub, _, _ := ed25519.GenerateKey(nil)
+
+	recs := testChain(3)
+	cp := signedCheckpoint(key, 1, recs[2])
+	report := verify(t, recs, []AuditCheckpoint{cp}, pub)
+	if !report.Ok || report.Records != 4 || report.Legacy != 1 || report.Checkpoints != 1 {
+		t.Fatalf("intact chain: %+v", report)
+	}
+
+	tampered := testChain(3)
+	tampered[2].Actor = "someone else"
+	if r := verify(t, tampered, nil, nil); r.Ok || r.Broken_at != 3 {
+		t.Errorf("edited record: %+v, want broken at 3", r)
+	}
+
+	gap := testChain(3)
+	gap = append(gap[:2], gap[3])
+	if r := verify(t, gap, nil, nil); r.Ok || r.Broken_at != 4 {
+		t.Errorf("removed record: %+v, want broken at 4", r)
+	}
+
+	if r := verify(t, recs, []AuditCheckpoint{cp}, otherPub); r.Ok || r.Broken_at != 3 {
+		t.Errorf("checkpoint by another key: %+v, want broken at 3", r)
+	}
+
+	// cutting the tail leaves a checkpoint without its record
+	tail := signedCheckpoint(key, 2, recs[3])
+	if r := verify(t, recs[:3], []AuditCheckpoint{tail}, pub); r.Ok || r.Broken_at != 4 {
+		t.Errorf("truncated log: %+v, want broken at 4", r)
+	}
+}
+
+// append links the record to the hash held in audit_head and moves the head
+// on, in the one transaction.
+func TestAppendLinksToHead(t *testing.T) {
+	s, mock := newTestServer(t)
+	rec := AuditRecord{Ts: "2024-01-02 00:00:00.000000", Actor: "system", Action: AuditRead, Target_type: "user", Target_id: "alice"}
+	want := rec
+	want.Prev_hash = "abc"
+	want.Hash = auditHash(want)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(LockAuditHeadQuery)).WillReturnRows(sqlmock.NewRows([]string{"hash"}).AddRow("abc"))
+	mock.ExpectExec(regexp.QuoteMeta(InsertAuditLogQuery)).
+		WithArgs(rec.Ts, "", "system", AuditRead, "user", "alice", nil, nil, "", "abc", want.Hash).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectExec(regexp.QuoteMeta(MoveAuditHeadQuery)).WithArgs(want.Hash).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	if err := s.audit.append(sqlctx.Background(), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Id != 7 || rec.Prev_hash != "abc" || rec.Hash != want.Hash {
+		t.Fatalf("appended record = %+v", rec)
+	}
+}
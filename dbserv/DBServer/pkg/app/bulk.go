@@ -0,0 +1,509 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	sqlctx "context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	FormatJSONL = "jsonl"
+	FormatCSV   = "csv"
+
+	maxImportBody = 32 << 20
+	maxJSONLLine  = 4 << 20
+)
+
+// bulkEntity maps one dataset name onto its table. fields are the record
+// keys used in both JSON Lines and CSV files, in export column order. target
+// is the audit target type of the rows. secrets are the credential fields,
+// left out of exports over HTTP.
+type bulkEntity struct {
+	target      string
+	fields      []string
+	secrets     []string
+	keys        []string
+	required    []string
+	dates       []string
+	jsonFields  []string
+	exportQuery string
+	insertQuery string
+}
+
+var bulkEntities = map[string]bulkEntity{
+	"users": {
+		target:      "user",
+		fields:      []string{"user_id", "password", "attrs"},
+		secrets:     []string{"password"},
+		keys:        []string{"user_id"},
+		required:    []string{"user_id", "password"},
+		jsonFields:  []string{"attrs"},
+		exportQuery: ExportUserAttrsQuery,
+		insertQuery: InsertUserAttrsQuery,
+	},
+	"devices": {
+		target:      "device",
+		fields:      []string{"dev_id", "dev_type", "actions", "token", "attrs"},
+		secrets:     []string{"token"},
+		keys:        []string{"dev_id"},
+		required:    []string{"dev_id", "dev_type"},
+		jsonFields:  []string{"attrs"},
+		exportQuery: ExportDevInfoQuery,
+		insertQuery: InsertDevInfoFullQuery,
+	},
+	"policies": {
+		target:      "policy",
+		fields:      []string{"ref", "content"},
+		keys:        []string{"ref"},
+		required:    []string{"ref", "content"},
+		exportQuery: ExportPolicyQuery,
+		insertQuery: InsertPolicyQuery,
+	},
+	"hierarchies": {
+		target:      "hierarchy",
+		fields:      []string{"obj_id", "action", "hierarchy"},
+		keys:        []string{"obj_id", "action"},
+		required:    []string{"obj_id", "action", "hierarchy"},
+		exportQuery: ExportHierarchyQuery,
+		insertQuery: InsertObjectHierarchyQuery,
+	},
+	"grants": {
+		target:      "grant",
+		fields:      []string{"user_id", "tbl_name", "db_access_date", "db_deny_date"},
+		keys:        []string{"user_id", "tbl_name"},
+		required:    []string{"user_id", "tbl_name", "db_access_date", "db_deny_date"},
+		dates:       []string{"db_access_date", "db_deny_date"},
+		exportQuery: ExportAccessDateQuery,
+		insertQuery: InsertPermInfoQuery,
+	},
+}
+
+// BulkEntityNames returns the dataset names accepted by ImportDataset and
+// ExportDataset.
+func BulkEntityNames() []string {
+	names := make([]string, 0, len(bulkEntities))
+	for n := range bulkEntities {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func lookupBulkEntity(entity string, format string) (bulkEntity, error) {
+	e, ok := bulkEntities[entity]
+	if !ok {
+		return e, fmt.Errorf("unknown entity %q, expected one of %s", entity, strings.Join(BulkEntityNames(), ", "))
+	}
+	if format != FormatJSONL && format != FormatCSV {
+		return e, fmt.Errorf("unknown format %q, expected %s or %s", format, FormatJSONL, FormatCSV)
+	}
+	return e, nil
+}
+
+type bulkRecord struct {
+	row    int
+	values map[string]string
+	err    error
+}
+
+func (e bulkEntity) hasField(name string) bool {
+	for _, f := range e.fields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (e bulkEntity) isSecret(name string) bool {
+	for _, f := range e.secrets {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (e bulkEntity) key(values map[string]string) string {
+	parts := make([]string, len(e.keys))
+	for i, k := range e.keys {
+		parts[i] = values[k]
+	}
+	return strings.Join(parts, "/")
+}
+
+func (e bulkEntity) validate(values map[string]string) error {
+	for _, f := range e.required {
+		if values[f] == "" {
+			return fmt.Errorf("%s is required", f)
+		}
+	}
+	for _, f := range e.dates {
+		if _, err := time.Parse("2006-01-02", values[f]); err != nil {
+			return fmt.Errorf("%s must be a date in YYYY-MM-DD form", f)
+		}
+	}
+	for _, f := range e.jsonFields {
+		if v := values[f]; v != "" && !json.Valid([]byte(v)) {
+			return fmt.Errorf("%s must be valid JSON", f)
+		}
+	}
+	return nil
+}
+
+func (e bulkEntity) args(values map[string]string) []interface{} {
+	args := make([]interface{}, len(e.fields))
+	for i, f := range e.fields {
+		args[i] = values[f]
+	}
+	return args
+}
+
+// readJSONL decodes one object per line. String values are taken as is;
+// any other JSON value (typically an attrs object) is kept as compact JSON
+// text, which is how the attrs columns store it.
+func (e bulkEntity) readJSONL(r io.Reader) ([]bulkRecord, error) {
+	var records []bulkRecord
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxJSONLLine)
+	row := 0
+	for scanner.Scan() {
+		row++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		rec := bulkRecord{row: row, values: make(map[string]string)}
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(line, &raw); err != nil {
+			rec.err = fmt.Errorf("invalid JSON: %v", err)
+			records = append(records, rec)
+			continue
+		}
+		for k, v := range raw {
+			if !e.hasField(k) {
+				rec.err = fmt.Errorf("unknown field %q", k)
+				break
+			}
+			var str string
+			if err := json.Unmarshal(v, &str); err == nil {
+				rec.values[k] = str
+				continue
+			}
+			if string(v) == "null" {
+				continue
+			}
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, v); err != nil {
+				rec.err = fmt.Errorf("%s: %v", k, err)
+				break
+			}
+			rec.values[k] = buf.String()
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// readCSV expects a header row naming a subset of the entity fields.
+func (e bulkEntity) readCSV(r io.Reader) ([]bulkRecord, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %v", err)
+	}
+	for _, h := range header {
+		if !e.hasField(h) {
+			return nil, fmt.Errorf("unknown column %q in header", h)
+		}
+	}
+	var records []bulkRecord
+	for {
+		fields, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var perr *csv.ParseError
+			if errors.As(err, &perr) {
+				records = append(records, bulkRecord{row: perr.Line, values: map[string]string{}, err: perr.Err})
+				continue
+			}
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		rec := bulkRecord{row: line, values: make(map[string]string)}
+		if len(fields) != len(header) {
+			rec.err = fmt.Errorf("expected %d columns, got %d", len(header), len(fields))
+		} else {
+			for i, h := range header {
+				rec.values[h] = fields[i]
+			}
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// ImportDataset reads entity records in the given format, validates them and
+// inserts them in a single transaction. The transaction is committed only if
+// every row succeeded and dryRun is false; otherwise nothing is written and
+// the report lists the failing rows. Non dry-run attempts are recorded with
+// audit when it is not nil.
+func ImportDataset(ctx sqlctx.Context, db *sql.DB, audit *Auditor, entity string, format string, r io.Reader, dryRun bool) (ImportReport, error) {
+	report := ImportReport{Entity: entity, Format: format, Dry_run: dryRun, Errors: []ImportRowError{}}
+	e, err := lookupBulkEntity(entity, format)
+	if err != nil {
+		return report, err
+	}
+
+	var records []bulkRecord
+	if format == FormatCSV {
+		records, err = e.readCSV(r)
+	} else {
+		records, err = e.readJSONL(r)
+	}
+	if err != nil {
+		return report, err
+	}
+	report.Total = len(records)
+
+	fail := func(rec bulkRecord, err error) {
+		report.Failed++
+		report.Errors = append(report.Errors, ImportRowError{Row: rec.row, Key: e.key(rec.values), Error: err.Error()})
+	}
+
+	seen := make(map[string]int)
+	var valid []bulkRecord
+	for _, rec := range records {
+		if rec.err == nil {
+			rec.err = e.validate(rec.values)
+		}
+		if rec.err == nil {
+			k := e.key(rec.values)
+			if first, ok := seen[k]; ok {
+				rec.err = fmt.Errorf("duplicate of row %d", first)
+			} else {
+				seen[k] = rec.row
+			}
+		}
+		if rec.err != nil {
+			fail(rec, rec.err)
+			continue
+		}
+		valid = append(valid, rec)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return report, err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, e.insertQuery)
+	if err != nil {
+		return report, err
+	}
+	defer stmt.Close()
+
+	for _, rec := range valid {
+		done := timeQuery(ctx, e.insertQuery)
+		_, err := stmt.ExecContext(ctx, e.args(rec.values)...)
+		done(err)
+		if err != nil {
+			fail(rec, err)
+			continue
+		}
+		report.Valid++
+	}
+
+	sort.Slice(report.Errors, func(i, j int) bool { return report.Errors[i].Row < report.Errors[j].Row })
+
+	if dryRun {
+		return report, nil
+	}
+	if report.Failed == 0 {
+		if err := tx.Commit(); err != nil {
+			return report, err
+		}
+		report.Committed = true
+	}
+	if audit != nil {
+		decision := DecisionAllow
+		if !report.Committed {
+			decision = DecisionDeny
+		}
+		audit.Record(ctx, AuditRecord{Action: AuditImport, Target_type: e.target,
+			After: fmt.Sprintf(`{"total": %d, "failed": %d}`, report.Total, report.Failed), Decision: decision})
+	}
+	return report, nil
+}
+
+// ExportDataset writes every row of entity to w and returns the row count.
+// Passwords and device tokens are only written when withSecrets is true,
+// which makes the export one that ImportDataset can load back.
+func ExportDataset(ctx sqlctx.Context, db *sql.DB, entity string, format string, w io.Writer, withSecrets bool) (int, error) {
+	e, err := lookupBulkEntity(entity, format)
+	if err != nil {
+		return 0, err
+	}
+	var columns []int
+	var header []string
+	for i, f := range e.fields {
+		if withSecrets || !e.isSecret(f) {
+			columns = append(columns, i)
+			header = append(header, f)
+		}
+	}
+
+	done := timeQuery(ctx, e.exportQuery)
+	res, err := db.QueryContext(ctx, e.exportQuery)
+	done(err)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	var cw *csv.Writer
+	var enc *json.Encoder
+	if format == FormatCSV {
+		cw = csv.NewWriter(w)
+		if err := cw.Write(header); err != nil {
+			return 0, err
+		}
+	} else {
+		enc = json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+	}
+
+	count := 0
+	vals := make([]sql.NullString, len(e.fields))
+	ptrs := make([]interface{}, len(vals))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	for res.Next() {
+		if err := res.Scan(ptrs...); err != nil {
+			return count, err
+		}
+		if cw != nil {
+			fields := make([]string, len(columns))
+			for i, c := range columns {
+				fields[i] = vals[c].String
+			}
+			if err := cw.Write(fields); err != nil {
+				return count, err
+			}
+		} else {
+			rec := make(map[string]string, len(columns))
+			for _, c := range columns {
+				rec[e.fields[c]] = vals[c].String
+			}
+			if err := enc.Encode(rec); err != nil {
+				return count, err
+			}
+		}
+		count++
+	}
+	if err := res.Err(); err != nil {
+		return count, err
+	}
+	if cw != nil {
+		cw.Flush()
+		return count, cw.Error()
+	}
+	return count, nil
+}
+
+// ImportData accepts a JSON Lines or CSV body for /import/:entity.
+// Query parameters: format (jsonl or csv, default jsonl) and dry_run.
+func (s *Server) ImportData() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		context.Header("Content-Type", "application/json")
+
+		entity := context.Param("entity")
+		format := context.DefaultQuery("format", FormatJSONL)
+		dryRun, _ := strconv.ParseBool(context.DefaultQuery("dry_run", "false"))
+
+		body := http.MaxBytesReader(context.Writer, context.Request.Body, maxImportBody)
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), 60*time.Second)
+		defer cancelfunc()
+		report, err := ImportDataset(ctx, s.conn, s.audit, entity, format, body, dryRun)
+		if err != nil {
+			s.log.WarnContext(context.Request.Context(), "import failed", "entity", entity, "err", err)
+			context.String(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if report.Committed {
+			s.purgeCache(entity)
+		}
+		s.log.InfoContext(context.Request.Context(), "import finished", "entity", entity, "rows", report.Total,
+			"failed", report.Failed, "committed", report.Committed)
+
+		ret, err := json.Marshal(report)
+		if err != nil {
+			s.log.ErrorContext(context.Request.Context(), "marshal import report", "err", err)
+			return
+		}
+		status := http.StatusOK
+		if report.Failed > 0 {
+			status = http.StatusBadRequest
+		}
+		context.String(status, string(ret))
+	}
+}
+
+// ExportData streams an entity as JSON Lines or CSV for /export/:entity,
+// without passwords and device tokens; only `server export` dumps those.
+func (s *Server) ExportData() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		entity := context.Param("entity")
+		format := context.DefaultQuery("format", FormatJSONL)
+		if _, err := lookupBulkEntity(entity, format); err != nil {
+			context.String(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if format == FormatCSV {
+			context.Header("Content-Type", "text/csv")
+		} else {
+			context.Header("Content-Type", "application/x-ndjson")
+		}
+		context.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s", entity, format))
+
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), 60*time.Second)
+		defer cancelfunc()
+		count, err := ExportDataset(ctx, s.conn, entity, format, context.Writer, false)
+		if err != nil {
+			s.log.ErrorContext(context.Request.Context(), "export failed", "entity", entity, "rows", count, "err", err)
+			if count == 0 {
+				context.String(http.StatusInternalServerError, err.Error())
+			}
+			return
+		}
+		s.log.InfoContext(context.Request.Context(), "export finished", "entity", entity, "rows", count)
+		s.audit.Record(context.Request.Context(), AuditRecord{Action: AuditExport, Target_type: bulkEntities[entity].target,
+			After: fmt.Sprintf(`{"rows": %d}`, count)})
+	}
+}
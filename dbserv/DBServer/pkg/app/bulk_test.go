@@ -0,0 +1,106 @@
+package app
+
+import (
+	sqlctx "context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+func TestReadJSONL(t *testing.T) {
+	e := bulkEntities["users"]
+	in := `{"user_id": "alice", "password": "pw", "attrs": {"team": "a",  "level": 2}}
+
+{"user_id": "bob", "shoe_size": 9}
+not json
+`
+	records, err := e.readJSONL(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3", len(records))
+	}
+	if r := records[0]; r.err != nil || r.values["attrs"] != `{"team":"a","level":2}` || r.values["password"] != "pw" {
+		t.Errorf("record 1 = %+v", r)
+	}
+	if r := records[1]; r.row != 3 || r.err == nil || !strings.Contains(r.err.Error(), "shoe_size") {
+		t.Errorf("record 2 = %+v, want an unknown field error on row 3", r)
+	}
+	if r := records[2]; r.row != 4 || r.err == nil {
+		t.Errorf("record 3 = %+v, want an invalid JSON error on row 4", r)
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	e := bulkEntities["grants"]
+	if _, err := e.readCSV(strings.NewReader("user_id,colour\n")); err == nil {
+		t.Error("an unknown header column was accepted")
+	}
+	records, err := e.readCSV(strings.NewReader("user_id,tbl_name\nalice,orders\nbob\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].err != nil || records[0].values["tbl_name"] != "orders" || records[1].err == nil {
+		t.Fatalf("records = %+v", records)
+	}
+}
+
+// One bad row keeps the whole import from committing.
+func TestImportDatasetRollsBackOnAnyFailure(t *testing.T) {
+	s, mock := newTestServer(t)
+	in := `user_id,tbl_name,db_access_date,db_deny_date
+alice,orders,2030-01-01,2000-01-01
+alice,orders,2030-01-01,2000-01-01
+bob,orders,soon,2000-01-01
+`
+	mock.ExpectBegin()
+	mock.ExpectPrepare(regexp.QuoteMeta(InsertPermInfoQuery)).
+		ExpectExec().WithArgs("alice", "orders", "2030-01-01", "2000-01-01").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	report, err := ImportDataset(sqlctx.Background(), s.conn, nil, "grants", FormatCSV, strings.NewReader(in), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Committed || report.Total != 3 || report.Valid != 1 || report.Failed != 2 {
+		t.Fatalf("report = %+v", report)
+	}
+	if report.Errors[0].Row != 3 || !strings.Contains(report.Errors[0].Error, "duplicate of row 2") {
+		t.Errorf("first error = %+v, want row 3 as a duplicate of row 2", report.Errors[0])
+	}
+}
+
+// Exports over HTTP need an admin token and never carry passwords.
+func TestExportOverHTTP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, mock := newTestServer(t)
+	withAdmin(s, "ops", "s3cret")
+	r := gin.New()
+	r.GET("/export/:entity", s.AdminOnly(), s.ExportData())
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/export/users", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("export without a token: status %d, want 401", w.Code)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(ExportUserAttrsQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "pwd", "attrs"}).AddRow("alice", "hunter2", `{"team":"a"}`))
+	expectAudit(mock, "admin:ops", AuditExport)
+	req := httptest.NewRequest(http.MethodGet, "/export/users", nil)
+	req.Header.Set("Authorization", "Bearer s3cret")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("export with an admin token: status %d, want 200", w.Code)
+	}
+	if body := w.Body.String(); !strings.Contains(body, `"user_id":"alice"`) || strings.Contains(body, "hunter2") || strings.Contains(body, "password") {
+		t.Fatalf("export body = %q", body)
+	}
+}
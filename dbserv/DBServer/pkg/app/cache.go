@@ -0,0 +1,162 @@
+package app
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Read-through caches in front of the policy, hierarchy and user attribute
+// lookups. Entries expire after a TTL and the least recently used entry is
+// evicted once a cache is full. The insert/update handlers invalidate the
+// keys they write, so staleness is bounded by the TTL only for writes made
+// outside this process.
+
+const (
+	cacheBypassHeader = "X-Cache-Bypass"
+	cacheStatusHeader = "X-Cache"
+
+	cacheHit    = "HIT"
+	cacheMiss   = "MISS"
+	cacheBypass = "BYPASS"
+)
+
+type cacheEntry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+type ttlCache struct {
+	name string
+	ttl  time.Duration
+	size int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+// newTTLCache returns nil, a cache that never hits, when size is not
+// positive.
+func newTTLCache(name string, ttl time.Duration, size int) *ttlCache {
+	if size <= 0 {
+		return nil
+	}
+	return &ttlCache{name: name, ttl: ttl, size: size, entries: make(map[string]*list.Element), lru: list.New()}
+}
+
+func (c *ttlCache) get(key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*cacheEntry)
+	if time.Now().After(e.expires) {
+		c.remove(el)
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return e.value, true
+}
+
+func (c *ttlCache) put(key string, value interface{}) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expires := time.Now().Add(c.ttl)
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*cacheEntry)
+		e.value, e.expires = value, expires
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, value: value, expires: expires})
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+		cacheEvictions.WithLabelValues(c.name).Inc()
+	}
+	cacheEntries.WithLabelValues(c.name).Set(float64(c.lru.Len()))
+}
+
+func (c *ttlCache) invalidate(key string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+}
+
+// purge drops every entry, used after bulk imports.
+func (c *ttlCache) purge() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+	cacheEntries.WithLabelValues(c.name).Set(0)
+}
+
+// remove must be called with c.mu held.
+func (c *ttlCache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*cacheEntry).key)
+	cacheEntries.WithLabelValues(c.name).Set(float64(c.lru.Len()))
+}
+
+// cacheHints carries the caller's bypass request in and the cache outcome
+// out. *gin.Context implements it with HTTP headers, grpcHints with gRPC
+// metadata.
+type cacheHints interface {
+	GetHeader(key string) string
+	Header(key string, value string)
+}
+
+// lookup is get for request handlers: it honours the bypass header, counts
+// the outcome and reports it in the X-Cache response header.
+func (c *ttlCache) lookup(hints cacheHints, key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	if hints.GetHeader(cacheBypassHeader) != "" {
+		cacheRequests.WithLabelValues(c.name, cacheBypass).Inc()
+		hints.Header(cacheStatusHeader, cacheBypass)
+		return nil, false
+	}
+	value, ok := c.get(key)
+	status := cacheMiss
+	if ok {
+		status = cacheHit
+	}
+	cacheRequests.WithLabelValues(c.name, status).Inc()
+	hints.Header(cacheStatusHeader, status)
+	return value, ok
+}
+
+// purgeCache empties the cache that holds the given bulk entity, if any.
+func (s *Server) purgeCache(entity string) {
+	switch entity {
+	case "policies":
+		s.policyCache.purge()
+	case "hierarchies":
+		s.hierarchyCache.purge()
+	case "users":
+		s.userAttrsCache.purge()
+	}
+}
+
+func hierarchyKey(obj_id string, action string) string {
+	return obj_id + "\x00" + action
+}
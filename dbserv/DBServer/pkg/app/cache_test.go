@@ -0,0 +1,105 @@
+package app
+
+import (
+	sqlctx "context"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// hints is cacheHints over plain header maps.
+type hints struct {
+	in, out http.Header
+}
+
+func newHints() hints { return hints{in: http.Header{}, out: http.Header{}} }
+
+func (h hints) GetHeader(key string) string     { return h.in.Get(key) }
+func (h hints) Header(key string, value string) { h.out.Set(key, value) }
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newTTLCache("test_lru", time.Minute, 2)
+	evictions := testutil.ToFloat64(cacheEvictions.WithLabelValues("test_lru"))
+	c.put("a", 1)
+	c.put("b", 2)
+	c.get("a")
+	c.put("c", 3)
+	if _, ok := c.get("b"); ok {
+		t.Error("b, the least recently used entry, was kept")
+	}
+	for _, k := range []string{"a", "c"} {
+		if _, ok := c.get(k); !ok {
+			t.Errorf("%s was evicted", k)
+		}
+	}
+	if testutil.ToFloat64(cacheEvictions.WithLabelValues("test_lru"))-evictions != 1 {
+		t.Error("the eviction was not counted")
+	}
+}
+
+func TestCacheExpiresAndInvalidates(t *testing.T) {
+	c := newTTLCache("test_ttl", 10*time.Millisecond, 10)
+	c.put("a", 1)
+	c.put("b", 2)
+	c.invalidate("b")
+	if _, ok := c.get("b"); ok {
+		t.Error("an invalidated entry was served")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := c.get("a"); ok {
+		t.Error("an expired entry was served")
+	}
+	c.put("c", 3)
+	c.purge()
+	if _, ok := c.get("c"); ok {
+		t.Error("an entry survived purge")
+	}
+
+	off := newTTLCache("test_off", time.Minute, 0)
+	off.put("a", 1)
+	if _, ok := off.get("a"); ok {
+		t.Error("a cache of size 0 held an entry")
+	}
+}
+
+func TestCacheLookupHeaders(t *testing.T) {
+	c := newTTLCache("test_lookup", time.Minute, 10)
+	h := newHints()
+	c.lookup(h, "a")
+	if h.out.Get(cacheStatusHeader) != cacheMiss {
+		t.Errorf("X-Cache %q, want MISS", h.out.Get(cacheStatusHeader))
+	}
+	c.put("a", 1)
+	c.lookup(h, "a")
+	if h.out.Get(cacheStatusHeader) != cacheHit {
+		t.Errorf("X-Cache %q, want HIT", h.out.Get(cacheStatusHeader))
+	}
+	h.in.Set(cacheBypassHeader, "1")
+	if _, ok := c.lookup(h, "a"); ok || h.out.Get(cacheStatusHeader) != cacheBypass {
+		t.Errorf("bypass: X-Cache %q", h.out.Get(cacheStatusHeader))
+	}
+}
+
+// A policy read twice is fetched once, and a write drops the cached copy.
+func TestPolicyReadThrough(t *testing.T) {
+	s, mock := newTestServer(t)
+	s.policyCache = newTTLCache("policy", time.Minute, 10)
+	ctx := sqlctx.Background()
+	rows := func() *sqlmock.Rows { return sqlmock.NewRows([]string{"ref", "content"}).AddRow("p1", "package p1") }
+
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WithArgs("p1").WillReturnRows(rows())
+	for i := 0; i < 2; i++ {
+		if p, err := s.findPolicy(ctx, newHints(), "p1"); err != nil || p.Content != "package p1" {
+			t.Fatalf("read %d: %+v, %v", i, p, err)
+		}
+	}
+	s.policyCache.invalidate("p1")
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WithArgs("p1").WillReturnRows(rows())
+	if _, err := s.findPolicy(ctx, newHints(), "p1"); err != nil {
+		t.Fatal(err)
+	}
+}
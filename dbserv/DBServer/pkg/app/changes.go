@@ -0,0 +1,348 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// Change events tell clients that a policy, user or device was written. Each
+// event is stored in change_events before it is pushed, so its seq is a
+// stable position: a client that reconnects with the last seq it saw (SSE
+// Last-Event-ID or ?since=) is replayed everything after it, then continues
+// live.
+//
+// Streams always read events from change_events, in seq order; the hub only
+// wakes them when something was recorded. This server records its events
+// one at a time, so a stream never reads a seq before the ones under it have
+// committed, and a slow stream falls behind instead of being dropped.
+
+const (
+	ChangePolicy = "policy"
+	ChangeUser   = "user"
+	ChangeDevice = "device"
+
+	changeReplayMax      = 1000
+	changeKeepalive      = 25 * time.Second
+	changeRecordAttempts = 3
+	changeRecordBackoff  = 50 * time.Millisecond
+)
+
+type changeSubscriber struct {
+	// wake holds at most one pending signal; it is closed when the hub
+	// drops the subscriber.
+	wake chan struct{}
+}
+
+// changeHub records change events in order and wakes the open streams.
+type changeHub struct {
+	// record serialises inserting an event with waking the streams
+	record sync.Mutex
+	mu     sync.Mutex
+	subs   map[*changeSubscriber]struct{}
+	closed bool
+}
+
+func newChangeHub() *changeHub {
+	return &changeHub{subs: make(map[*changeSubscriber]struct{})}
+}
+
+func (h *changeHub) subscribe() *changeSubscriber {
+	sub := &changeSubscriber{wake: make(chan struct{}, 1)}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		close(sub.wake)
+		return sub
+	}
+	h.subs[sub] = struct{}{}
+	return sub
+}
+
+func (h *changeHub) unsubscribe(sub *changeSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.wake)
+	}
+}
+
+// notify wakes every stream. A stream that has not yet caught up with the
+// previous signal already has one pending.
+func (h *changeHub) notify() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		select {
+		case sub.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// close ends every stream; used when the server shuts down.
+func (h *changeHub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for sub := range h.subs {
+		delete(h.subs, sub)
+		close(sub.wake)
+	}
+}
+
+// publishChange records that entity_type/entity_id was written and wakes
+// the streams. Call it after the write has committed. Recording is retried,
+// among other things for another server taking the same version; an event
+// that still cannot be recorded is logged, since the write itself already
+// succeeded.
+func (s *Server) publishChange(ctx sqlctx.Context, entity_type string, entity_id string) {
+	ctx = sqlctx.WithoutCancel(ctx)
+	ts := time.Now().UTC().Format(auditTimeLayout)
+
+	s.changes.record.Lock()
+	defer s.changes.record.Unlock()
+	var err error
+	for attempt := 1; attempt <= changeRecordAttempts; attempt++ {
+		done := timeQuery(ctx, InsertChangeEventQuery)
+		_, err = s.conn.ExecContext(ctx, InsertChangeEventQuery, ts, entity_type, entity_id, entity_type, entity_id)
+		done(err)
+		if err == nil {
+			break
+		}
+		s.log.WarnContext(ctx, "retrying change event", "entity_type", entity_type, "entity_id", entity_id, "attempt", attempt, "err", err)
+		time.Sleep(time.Duration(attempt) * changeRecordBackoff)
+	}
+	if err != nil {
+		s.log.ErrorContext(ctx, "unable to record change event", "entity_type", entity_type, "entity_id", entity_id, "err", err)
+		return
+	}
+	s.changes.notify()
+}
+
+func (s *Server) changesSince(ctx sqlctx.Context, since int64) ([]ChangeEvent, error) {
+	done := timeQuery(ctx, ListChangesSinceQuery)
+	res, err := s.conn.QueryContext(ctx, ListChangesSinceQuery, since, changeReplayMax)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var events []ChangeEvent
+	for res.Next() {
+		var ev ChangeEvent
+		var ts sql.NullString
+		if err := res.Scan(&ev.Seq, &ts, &ev.Entity_type, &ev.Entity_id, &ev.Version); err != nil {
+			return nil, err
+		}
+		ev.Ts = ts.String
+		events = append(events, ev)
+	}
+	return events, res.Err()
+}
+
+// changeStream is what SSE and WebSocket share: where to resume, which
+// entity types to send, and the subscription.
+type changeStream struct {
+	since  int64
+	resume bool
+	filter map[string]bool
+	sub    *changeSubscriber
+}
+
+func (s *Server) openChangeStream(context *gin.Context) (*changeStream, error) {
+	cs := &changeStream{}
+	raw := context.Query("since")
+	if raw == "" {
+		raw = context.GetHeader("Last-Event-ID")
+	}
+	if raw != "" {
+		since, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || since < 0 {
+			return nil, fmt.Errorf("invalid resume sequence %q", raw)
+		}
+		cs.since = since
+		cs.resume = true
+	}
+	if types := context.Query("entity"); types != "" {
+		cs.filter = make(map[string]bool)
+		for _, t := range strings.Split(types, ",") {
+			switch t {
+			case ChangePolicy, ChangeUser, ChangeDevice:
+				cs.filter[t] = true
+			default:
+				return nil, fmt.Errorf("unknown entity type %q", t)
+			}
+		}
+	}
+	// subscribe before reading so nothing recorded in between is missed
+	cs.sub = s.changes.subscribe()
+	return cs, nil
+}
+
+func (cs *changeStream) wants(ev ChangeEvent) bool {
+	return cs.filter == nil || cs.filter[ev.Entity_type]
+}
+
+// catchUp sends every event recorded after cs.since, in pages.
+func (s *Server) catchUp(ctx sqlctx.Context, cs *changeStream, send func(ChangeEvent) error) error {
+	for {
+		events, err := s.changesSince(ctx, cs.since)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			if cs.wants(ev) {
+				if err := send(ev); err != nil {
+					return err
+				}
+			}
+			cs.since = ev.Seq
+		}
+		if len(events) < changeReplayMax {
+			return nil
+		}
+	}
+}
+
+// runChangeStream replays missed events, then sends new ones as they are
+// recorded until the client goes away, the hub closes or send fails. A
+// stream that did not ask to resume starts after the latest event.
+func (s *Server) runChangeStream(ctx sqlctx.Context, cs *changeStream, send func(ChangeEvent) error, keepalive func() error) error {
+	defer s.changes.unsubscribe(cs.sub)
+
+	if !cs.resume {
+		done := timeQuery(ctx, FindLastChangeQuery)
+		err := s.conn.QueryRowContext(ctx, FindLastChangeQuery).Scan(&cs.since)
+		done(err)
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.catchUp(ctx, cs, send); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(changeKeepalive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := keepalive(); err != nil {
+				return err
+			}
+		case _, ok := <-cs.sub.wake:
+			if !ok {
+				return nil
+			}
+			if err := s.catchUp(ctx, cs, send); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// StreamChanges serves /changes as Server-Sent Events. Each event's id is
+// its seq, so browsers resume automatically via Last-Event-ID. Query
+// parameters: since (resume after this seq) and entity (comma separated
+// policy, user, device).
+func (s *Server) StreamChanges() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		cs, err := s.openChangeStream(context)
+		if err != nil {
+			context.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		context.Header("Content-Type", "text/event-stream")
+		context.Header("Cache-Control", "no-cache")
+		context.Header("Connection", "keep-alive")
+		context.Status(http.StatusOK)
+		context.Writer.Flush()
+
+		w := context.Writer
+		send := func(ev ChangeEvent) error {
+			data, err := json.Marshal(ev)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: change\ndata: %s\n\n", ev.Seq, data); err != nil {
+				return err
+			}
+			w.Flush()
+			return nil
+		}
+		keepalive := func() error {
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return err
+			}
+			w.Flush()
+			return nil
+		}
+		if err := s.runChangeStream(context.Request.Context(), cs, send, keepalive); err != nil {
+			s.log.WarnContext(context.Request.Context(), "change stream ended", "err", err)
+		}
+	}
+}
+
+var changeUpgrader = websocket.Upgrader{
+	// the API is already served with permissive CORS
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// StreamChangesWS serves the same stream over a WebSocket at /changes/ws,
+// one JSON ChangeEvent per text message. It takes the same parameters as
+// StreamChanges.
+func (s *Server) StreamChangesWS() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		cs, err := s.openChangeStream(context)
+		if err != nil {
+			context.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		conn, err := changeUpgrader.Upgrade(context.Writer, context.Request, nil)
+		if err != nil {
+			s.changes.unsubscribe(cs.sub)
+			s.log.WarnContext(context.Request.Context(), "websocket upgrade failed", "err", err)
+			return
+		}
+		defer conn.Close()
+
+		// the read side only has to notice the client closing
+		ctx, cancelfunc := sqlctx.WithCancel(context.Request.Context())
+		defer cancelfunc()
+		go func() {
+			defer cancelfunc()
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+
+		send := func(ev ChangeEvent) error {
+			conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			return conn.WriteJSON(ev)
+		}
+		keepalive := func() error {
+			return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(10*time.Second))
+		}
+		err = s.runChangeStream(ctx, cs, send, keepalive)
+		if err != nil {
+			s.log.WarnContext(ctx, "change stream ended", "err", err)
+		}
+		conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	}
+}
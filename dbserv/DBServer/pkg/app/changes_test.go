@@ -0,0 +1,104 @@
+package app
+
+import (
+	sqlctx "context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+var changeColumns = []string{"seq", "ts", "entity_type", "entity_id", "version"}
+
+func TestChangeHubWakes(t *testing.T) {
+	h := newChangeHub()
+	a, b := h.subscribe(), h.subscribe()
+	h.notify()
+	h.notify()
+	if len(a.wake) != 1 || len(b.wake) != 1 {
+		t.Fatalf("pending wakes %d and %d, want one each", len(a.wake), len(b.wake))
+	}
+	h.unsubscribe(a)
+	h.close()
+	<-b.wake
+	if _, ok := <-b.wake; ok {
+		t.Error("close left a stream open")
+	}
+	if _, ok := <-h.subscribe().wake; ok {
+		t.Error("a stream opened after close is not closed")
+	}
+}
+
+func TestOpenChangeStreamParameters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, _ := newTestServer(t)
+	s.changes = newChangeHub()
+	open := func(target string, lastEventID string) (*changeStream, error) {
+		context, _ := gin.CreateTestContext(httptest.NewRecorder())
+		context.Request = httptest.NewRequest(http.MethodGet, target, nil)
+		if lastEventID != "" {
+			context.Request.Header.Set("Last-Event-ID", lastEventID)
+		}
+		return s.openChangeStream(context)
+	}
+	cs, err := open("/changes?entity=policy,user", "42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cs.resume || cs.since != 42 || !cs.wants(ChangeEvent{Entity_type: ChangeUser}) || cs.wants(ChangeEvent{Entity_type: ChangeDevice}) {
+		t.Errorf("stream = %+v", cs)
+	}
+	for _, target := range []string{"/changes?since=-1", "/changes?since=x", "/changes?entity=policy,role"} {
+		if _, err := open(target, ""); err == nil {
+			t.Errorf("%s was accepted", target)
+		}
+	}
+}
+
+// A resumed stream replays what it missed, then follows new events as they
+// are recorded, skipping entity types it did not ask for.
+func TestRunChangeStream(t *testing.T) {
+	s, mock := newTestServer(t)
+	s.changes = newChangeHub()
+	cs := &changeStream{since: 5, resume: true, filter: map[string]bool{ChangePolicy: true}, sub: s.changes.subscribe()}
+
+	mock.ExpectQuery(regexp.QuoteMeta(ListChangesSinceQuery)).WithArgs(5, changeReplayMax).
+		WillReturnRows(sqlmock.NewRows(changeColumns).AddRow(6, "", ChangePolicy, "p1", 1).AddRow(7, "", ChangeUser, "alice", 1))
+	mock.ExpectExec(regexp.QuoteMeta(InsertChangeEventQuery)).WillReturnError(errors.New("duplicate version"))
+	mock.ExpectExec(regexp.QuoteMeta(InsertChangeEventQuery)).WillReturnResult(sqlmock.NewResult(8, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(ListChangesSinceQuery)).WithArgs(7, changeReplayMax).
+		WillReturnRows(sqlmock.NewRows(changeColumns).AddRow(8, "", ChangePolicy, "p2", 1))
+
+	ctx, cancel := sqlctx.WithCancel(sqlctx.Background())
+	defer cancel()
+	sent := make(chan ChangeEvent, 10)
+	ended := make(chan error, 1)
+	go func() {
+		ended <- s.runChangeStream(ctx, cs, func(ev ChangeEvent) error { sent <- ev; return nil }, func() error { return nil })
+	}()
+
+	want := []int64{6, 8}
+	for i, seq := range want {
+		if i == 1 {
+			// retried after the first insert fails
+			s.publishChange(sqlctx.Background(), ChangePolicy, "p2")
+		}
+		select {
+		case ev := <-sent:
+			if ev.Seq != seq {
+				t.Fatalf("sent seq %d, want %d", ev.Seq, seq)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("seq %d was never sent", seq)
+		}
+	}
+	cancel()
+	if err := <-ended; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"log/slog"
+	"time"
+)
+
+// Config holds the server settings that are not wired into routes.
+type Config struct {
+	// AuditFile, when set, receives every audit record as one JSON line in
+	// addition to the audit_log table.
+	AuditFile string
+	// AuditKeyFile holds the hex encoded ed25519 seed used to sign audit
+	// checkpoints. Checkpoints are disabled when it is empty.
+	AuditKeyFile string
+	// AuditCheckpointInterval is how often the chain head is signed.
+	AuditCheckpointInterval time.Duration
+	// GRPCAddr is where the gRPC API listens, next to the HTTP API. gRPC
+	// is not served when it is empty, the default. Every call needs an
+	// admin token, so it cannot be set without AdminTokenFile.
+	GRPCAddr string
+	// ShutdownTimeout is how long in-flight requests may run after a
+	// shutdown signal before they are cancelled.
+	ShutdownTimeout time.Duration
+	// FaultFile holds fault injection rules (see package faultinject). Nothing is
+	// injected when it is empty.
+	FaultFile string
+	// CacheTTL and CacheSize bound each of the policy, hierarchy and user
+	// attribute caches. A CacheSize of 0 disables caching.
+	CacheTTL  time.Duration
+	CacheSize int
+	// WebhookFile lists the endpoints told about grant updates (see
+	// webhooks.go). No webhooks are sent when it is empty.
+	WebhookFile string
+	// JWTKeyFile holds the HS256 key grant tokens are signed and verified
+	// with. The historical built-in key is used when it is empty.
+	JWTKeyFile string
+	// GrantIssuerFile lists who may mint grant tokens through the API (see
+	// grantissue.go). Nobody may when it is empty. It needs JWTKeyFile.
+	GrantIssuerFile string
+	// SweepInterval is how often expiring grants are announced and long
+	// expired ones archived (see sweeper.go). The sweeper does not run when
+	// it is 0.
+	SweepInterval time.Duration
+	// ExpiryWindowDays is how far ahead a grant's expiry is announced.
+	ExpiryWindowDays int
+	// ArchiveAfterDays is how long an expired grant stays in db_access, where
+	// a grant token or access request can still renew it, before it is
+	// moved to db_access_history.
+	ArchiveAfterDays int
+	// AdminTokenFile lists who may use the admin routes (see
+	// adminauth.go). Nobody may when it is empty.
+	AdminTokenFile string
+	// OPABinary is the opa executable used to evaluate Rego policies (see
+	// opa.go); a bare name is looked up in PATH.
+	OPABinary string
+	// Logger receives all server logs. slog.Default() is used when nil.
+	Logger *slog.Logger
+}
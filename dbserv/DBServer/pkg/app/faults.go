@@ -0,0 +1,12 @@
+package app
+
+import sqlctx "context"
+
+// Fault injection rules and the middleware that applies them are shared
+// with httpboth through the faultinject module; see its package comment for
+// the rules file. This file ties them to the server's metrics and log.
+
+func (s *Server) observeFault(ctx sqlctx.Context, route string, kind string) {
+	faultsInjected.WithLabelValues(route, kind).Inc()
+	s.log.DebugContext(ctx, "fault injection", "route", route, "kind", kind)
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"faultinject"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// A decision forced by fault injection is answered but is not a permission
+// check: it stays out of the audit log and the decision metrics.
+func TestForcedDecisionsAreNotRecorded(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, _ := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s.audit.file = f
+	s.faults = faultinject.New([]faultinject.Rule{{Route: "/check/:user_id", Decision_rate: 1, Allow_ratio: 1}})
+	s.faults.Observe = s.observeFault
+
+	var allowed bool
+	r := gin.New()
+	r.Use(s.faults.Middleware())
+	r.GET("/check/:user_id", func(context *gin.Context) {
+		allowed = s.checkAuthServerPerm(context.Request.Context(), context.Param("user_id"), "user_attrs")
+	})
+
+	checks := grantDecisions.WithLabelValues("check", DecisionAllow)
+	before := testutil.ToFloat64(checks)
+	injected := faultsInjected.WithLabelValues("/check/:user_id", faultinject.KindDecision)
+	beforeInjected := testutil.ToFloat64(injected)
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/check/alice", nil))
+
+	if !allowed {
+		t.Fatal("the forced allow was not used")
+	}
+	if testutil.ToFloat64(checks) != before {
+		t.Error("the forced decision was counted as a check")
+	}
+	if testutil.ToFloat64(injected)-beforeInjected != 1 {
+		t.Error("the injected decision was not counted as a fault")
+	}
+	if raw, _ := os.ReadFile(path); len(raw) != 0 {
+		t.Errorf("the forced decision was audited: %s", raw)
+	}
+}
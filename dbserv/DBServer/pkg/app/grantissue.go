@@ -0,0 +1,362 @@
+package app
+
+import (
+	sqlctx "context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// Grant tokens used to be minted only outside the server, by whoever held
+// the shared HS256 key. POST /v1/grants/tokens/issue mints them here for
+// callers listed in the grant issuers file, within the limits set there.
+//
+// The issuers file is a JSON array, for example:
+//
+//	[
+//	  {"name": "oncall", "token_sha256": "<hex sha256 of the bearer token>",
+//	   "tables": ["orders", "billing_*"], "max_days": 7, "allow_once": true},
+//	  {"name": "dba", "token_sha256": "...", "users": ["svc_*"], "tables": ["*"],
+//	   "max_days": 90, "allow_always": true, "max_ttl_seconds": 3600}
+//	]
+//
+// Issuers authenticate with "Authorization: Bearer <token>"; only the token's
+// SHA-256 is stored. Tables and users are path.Match patterns, and users
+// defaults to everyone. An allow may last at most max_days days, and only
+// issuers with allow_always or allow_once may grant those; any issuer may
+// deny a table it covers.
+//
+// Issued tokens carry user, sub, iss, iat, exp and a random jti, and are
+// recorded in issued_grants. Issuers need a key of their own (-jwt-key);
+// the server will not issue with the legacy key everyone knows. While
+// issuers are configured, /jwt only accepts tokens issued here, each once;
+// tokens without a jti or with one it did not issue are refused.
+
+const (
+	// legacyJWTKey is the key grant tokens were always verified with; it
+	// stays the default so existing token minters keep working.
+	legacyJWTKey = "12345"
+
+	defaultGrantTTL = 10 * time.Minute
+	minJWTKeyLength = 16
+
+	authorizationHeader = "Authorization"
+)
+
+var (
+	errInvalidGrant   = errors.New("invalid grant request")
+	errGrantForbidden = errors.New("grant not permitted")
+	errGrantReplayed  = errors.New("grant token has already been used")
+	errGrantUnissued  = errors.New("grant token was not issued here")
+	errGrantRefused   = errors.New("grant token refused")
+)
+
+// LoadJWTKey reads the HS256 grant token key from path. Surrounding
+// whitespace is ignored.
+func LoadJWTKey(path string) ([]byte, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key := []byte(strings.TrimSpace(string(raw)))
+	if len(key) < minJWTKeyLength {
+		return nil, fmt.Errorf("jwt key %s: expected at least %d bytes, got %d", path, minJWTKeyLength, len(key))
+	}
+	return key, nil
+}
+
+func LoadGrantIssuers(path string) ([]GrantIssuer, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var issuers []GrantIssuer
+	if err := json.Unmarshal(raw, &issuers); err != nil {
+		return nil, fmt.Errorf("grant issuers %s: %v", path, err)
+	}
+	seen := make(map[string]bool)
+	for i := range issuers {
+		if err := issuers[i].validate(); err != nil {
+			return nil, fmt.Errorf("grant issuers %s: issuer %d: %v", path, i, err)
+		}
+		if seen[issuers[i].Name] {
+			return nil, fmt.Errorf("grant issuers %s: duplicate issuer name %q", path, issuers[i].Name)
+		}
+		seen[issuers[i].Name] = true
+	}
+	return issuers, nil
+}
+
+func (g *GrantIssuer) validate() error {
+	if g.Name == "" {
+		return errors.New("name is required")
+	}
+	g.Token_sha256 = strings.ToLower(g.Token_sha256)
+	if b, err := hex.DecodeString(g.Token_sha256); err != nil || len(b) != sha256.Size {
+		return errors.New("token_sha256 must be 64 hex digits")
+	}
+	if len(g.Tables) == 0 {
+		return errors.New("tables is required")
+	}
+	for _, p := range append(append([]string{}, g.Tables...), g.Users...) {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("bad pattern %q", p)
+		}
+	}
+	if g.Max_days < 0 {
+		return errors.New("max_days must not be negative")
+	}
+	if g.Max_ttl_seconds < 0 {
+		return errors.New("max_ttl_seconds must not be negative")
+	}
+	if g.Max_ttl_seconds == 0 {
+		g.Max_ttl_seconds = int(defaultGrantTTL / time.Second)
+	}
+	return nil
+}
+
+// matchAny reports whether s matches one of patterns; no patterns match
+// everything.
+func matchAny(patterns []string, s string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// rule renders spec in the form accessDateUpdate parses, e.g. "allow 7 days".
+func (spec GrantSpec) rule() (string, error) {
+	if spec.Table_name == "" || strings.ContainsAny(spec.Table_name, ",:") {
+		return "", fmt.Errorf("%w: table_name %q", errInvalidGrant, spec.Table_name)
+	}
+	if spec.Days < 0 {
+		return "", fmt.Errorf("%w: %s: days must not be negative", errInvalidGrant, spec.Table_name)
+	}
+	switch spec.Decision {
+	case DecisionAllow:
+		set := 0
+		for _, b := range []bool{spec.Days > 0, spec.Always, spec.Once} {
+			if b {
+				set++
+			}
+		}
+		if set != 1 {
+			return "", fmt.Errorf("%w: %s: an allow needs exactly one of days, always and once", errInvalidGrant, spec.Table_name)
+		}
+		switch {
+		case spec.Always:
+			return "allow always", nil
+		case spec.Once:
+			return "allow once", nil
+		}
+		return fmt.Sprintf("allow %d days", spec.Days), nil
+	case DecisionDeny:
+		if spec.Always || spec.Once {
+			return "", fmt.Errorf("%w: %s: a deny takes days only", errInvalidGrant, spec.Table_name)
+		}
+		return fmt.Sprintf("deny %d days", spec.Days), nil
+	}
+	return "", fmt.Errorf("%w: %s: decision must be allow or deny", errInvalidGrant, spec.Table_name)
+}
+
+// authorize checks req against the issuer's limits and returns the token's
+// sub claim and lifetime.
+func (g GrantIssuer) authorize(req IssueGrantRequest) (string, time.Duration, error) {
+	if req.User_id == "" {
+		return "", 0, fmt.Errorf("%w: user_id is required", errInvalidGrant)
+	}
+	if len(req.Grants) == 0 {
+		return "", 0, fmt.Errorf("%w: grants is required", errInvalidGrant)
+	}
+	if req.Ttl_seconds < 0 {
+		return "", 0, fmt.Errorf("%w: ttl_seconds must not be negative", errInvalidGrant)
+	}
+	ttl := defaultGrantTTL
+	if req.Ttl_seconds > 0 {
+		ttl = time.Duration(req.Ttl_seconds) * time.Second
+	}
+	if limit := time.Duration(g.Max_ttl_seconds) * time.Second; ttl > limit {
+		return "", 0, fmt.Errorf("%w: ttl_seconds is above this issuer's %d", errGrantForbidden, g.Max_ttl_seconds)
+	}
+	if !matchAny(g.Users, req.User_id) {
+		return "", 0, fmt.Errorf("%w: user %s", errGrantForbidden, req.User_id)
+	}
+
+	pairs := make([]string, 0, len(req.Grants))
+	seen := make(map[string]bool)
+	for _, spec := range req.Grants {
+		rule, err := spec.rule()
+		if err != nil {
+			return "", 0, err
+		}
+		if seen[spec.Table_name] {
+			return "", 0, fmt.Errorf("%w: table %s appears twice", errInvalidGrant, spec.Table_name)
+		}
+		seen[spec.Table_name] = true
+		if !matchAny(g.Tables, spec.Table_name) {
+			return "", 0, fmt.Errorf("%w: table %s", errGrantForbidden, spec.Table_name)
+		}
+		if spec.Decision == DecisionAllow {
+			switch {
+			case spec.Always && !g.Allow_always:
+				return "", 0, fmt.Errorf("%w: %s: allow always", errGrantForbidden, spec.Table_name)
+			case spec.Once && !g.Allow_once:
+				return "", 0, fmt.Errorf("%w: %s: allow once", errGrantForbidden, spec.Table_name)
+			case spec.Days > g.Max_days:
+				return "", 0, fmt.Errorf("%w: %s: at most %d days", errGrantForbidden, spec.Table_name, g.Max_days)
+			}
+		}
+		pairs = append(pairs, spec.Table_name+":"+rule)
+	}
+	return strings.Join(pairs, ","), ttl, nil
+}
+
+// grantIssuer finds the issuer whose token the request carries.
+func (s *Server) grantIssuer(context *gin.Context) (GrantIssuer, bool) {
+	hash, ok := bearerHash(context.GetHeader(authorizationHeader))
+	if !ok {
+		return GrantIssuer{}, false
+	}
+	for _, g := range s.issuers {
+		if subtle.ConstantTimeCompare(hash, []byte(g.Token_sha256)) == 1 {
+			return g, true
+		}
+	}
+	return GrantIssuer{}, false
+}
+
+// issueGrant signs a grant token for user_id and records its jti.
+func (s *Server) issueGrant(ctx sqlctx.Context, issuer GrantIssuer, user_id string, sub string, ttl time.Duration) (IssuedGrant, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return IssuedGrant{}, err
+	}
+	jti := hex.EncodeToString(b[:])
+	now := time.Now().UTC()
+	exp := now.Add(ttl)
+	claims := jwt.MapClaims{
+		"user": user_id,
+		"sub":  sub,
+		"iss":  issuer.Name,
+		"iat":  now.Unix(),
+		"exp":  exp.Unix(),
+		"jti":  jti,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.jwtKey)
+	if err != nil {
+		return IssuedGrant{}, err
+	}
+
+	ctx, cancelfunc := sqlctx.WithTimeout(ctx, queryTimeout)
+	defer cancelfunc()
+	done := timeQuery(ctx, InsertIssuedGrantQuery)
+	_, err = s.conn.ExecContext(ctx, InsertIssuedGrantQuery, jti, issuer.Name, user_id, sub, now, exp)
+	done(err)
+	if err != nil {
+		return IssuedGrant{}, err
+	}
+
+	issued := IssuedGrant{Jti: jti, Issuer: issuer.Name, User_id: user_id, Sub: sub,
+		Issued_at: now.Format(time.RFC3339), Expires_at: exp.Format(time.RFC3339)}
+	s.audit.Record(ctx, AuditRecord{Action: AuditIssue, Target_type: "grant_token", Target_id: jti,
+		After: toAuditJSON(issued), Decision: DecisionAllow})
+	issued.Token = token
+	return issued, nil
+}
+
+// useIssuedGrant marks a token issued here as used within tx, so the mark
+// stands only if the grant it carries is applied. Tokens minted elsewhere
+// are not in issued_grants; they are let through as before unless issuers
+// are configured.
+func (s *Server) useIssuedGrant(ctx sqlctx.Context, tx *sql.Tx, jti string) error {
+	if jti == "" {
+		if len(s.issuers) > 0 {
+			return errGrantUnissued
+		}
+		return nil
+	}
+	done := timeQuery(ctx, UseIssuedGrantQuery)
+	res, err := tx.ExecContext(ctx, UseIssuedGrantQuery, time.Now().UTC(), jti)
+	done(err)
+	if err != nil {
+		return err
+	}
+	if rows, err := res.RowsAffected(); err != nil || rows == 1 {
+		return err
+	}
+	var used_at sql.NullTime
+	done = timeQuery(ctx, FindIssuedGrantQuery)
+	err = tx.QueryRowContext(ctx, FindIssuedGrantQuery, jti).Scan(&used_at)
+	done(err)
+	if errors.Is(err, sql.ErrNoRows) {
+		if len(s.issuers) > 0 {
+			return errGrantUnissued
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return errGrantReplayed
+}
+
+// IssueGrantV1 mints a grant token for an authenticated issuer.
+func (s *Server) IssueGrantV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		issuer, ok := s.grantIssuer(context)
+		if !ok {
+			grantTokensIssued.WithLabelValues("unknown", "unauthenticated").Inc()
+			s.audit.Record(context.Request.Context(), AuditRecord{Action: AuditIssue, Target_type: "grant_token", Decision: DecisionDeny})
+			context.Header("WWW-Authenticate", "Bearer")
+			v1Error(context, http.StatusUnauthorized, "a grant issuer token is required")
+			return
+		}
+		ctx := WithActor(context.Request.Context(), "issuer:"+issuer.Name)
+		context.Request = context.Request.WithContext(ctx)
+
+		var req IssueGrantRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		sub, ttl, err := issuer.authorize(req)
+		switch {
+		case errors.Is(err, errGrantForbidden):
+			grantTokensIssued.WithLabelValues(issuer.Name, "forbidden").Inc()
+			s.log.WarnContext(ctx, "grant token refused", "issuer", issuer.Name, "user_id", req.User_id, "err", err)
+			s.audit.Record(ctx, AuditRecord{Action: AuditIssue, Target_type: "grant_token", Target_id: req.User_id, Decision: DecisionDeny})
+			v1Error(context, http.StatusForbidden, err.Error())
+			return
+		case err != nil:
+			v1Error(context, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		issued, err := s.issueGrant(ctx, issuer, req.User_id, sub, ttl)
+		if err != nil {
+			s.log.ErrorContext(ctx, "issuing grant token failed", "err", err)
+			v1Error(context, http.StatusInternalServerError, "database error")
+			return
+		}
+		grantTokensIssued.WithLabelValues(issuer.Name, "issued").Inc()
+		s.log.InfoContext(ctx, "grant token issued", "issuer", issuer.Name, "user_id", issued.User_id, "sub", issued.Sub, "jti", issued.Jti)
+		context.JSON(http.StatusCreated, issued)
+	}
+}
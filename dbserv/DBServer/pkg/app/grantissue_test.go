@@ -0,0 +1,301 @@
+package app
+
+import (
+	sqlctx "context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+var oncall = GrantIssuer{Name: "oncall", Token_sha256: tokenHash("pager"), Users: []string{"svc_*"},
+	Tables: []string{"orders", "billing_*"}, Max_days: 7, Allow_once: true, Max_ttl_seconds: 600}
+
+func TestLoadGrantIssuers(t *testing.T) {
+	write := func(body string) string {
+		path := filepath.Join(t.TempDir(), "issuers.json")
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	hash := strings.ToUpper(tokenHash("pager"))
+	issuers, err := LoadGrantIssuers(write(`[{"name": "oncall", "token_sha256": "` + hash + `", "tables": ["orders"]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issuers[0].Token_sha256 != tokenHash("pager") || issuers[0].Max_ttl_seconds != int(defaultGrantTTL/time.Second) {
+		t.Errorf("issuer = %+v", issuers[0])
+	}
+
+	for _, bad := range []string{
+		`[{"token_sha256": "` + hash + `", "tables": ["orders"]}]`,
+		`[{"name": "a", "token_sha256": "abc", "tables": ["orders"]}]`,
+		`[{"name": "a", "token_sha256": "` + hash + `"}]`,
+		`[{"name": "a", "token_sha256": "` + hash + `", "tables": ["[orders"]}]`,
+		`[{"name": "a", "token_sha256": "` + hash + `", "tables": ["orders"], "max_days": -1}]`,
+		`[{"name": "a", "token_sha256": "` + hash + `", "tables": ["orders"]}, {"name": "a", "token_sha256": "` + hash + `", "tables": ["x"]}]`,
+	} {
+		if _, err := LoadGrantIssuers(write(bad)); err == nil {
+			t.Errorf("%s was accepted", bad)
+		}
+	}
+}
+
+func TestGrantSpecRule(t *testing.T) {
+	for _, tc := range []struct {
+		spec GrantSpec
+		want string
+	}{
+		{GrantSpec{Table_name: "orders", Decision: DecisionAllow, Days: 3}, "allow 3 days"},
+		{GrantSpec{Table_name: "orders", Decision: DecisionAllow, Always: true}, "allow always"},
+		{GrantSpec{Table_name: "orders", Decision: DecisionAllow, Once: true}, "allow once"},
+		{GrantSpec{Table_name: "orders", Decision: DecisionDeny, Days: 2}, "deny 2 days"},
+	} {
+		got, err := tc.spec.rule()
+		if err != nil || got != tc.want {
+			t.Errorf("%+v: %q, %v, want %q", tc.spec, got, err, tc.want)
+		}
+	}
+	for _, bad := range []GrantSpec{
+		{Decision: DecisionAllow, Days: 1},
+		{Table_name: "a,b", Decision: DecisionAllow, Days: 1},
+		{Table_name: "a:b", Decision: DecisionAllow, Days: 1},
+		{Table_name: "orders", Decision: DecisionAllow},
+		{Table_name: "orders", Decision: DecisionAllow, Days: 1, Once: true},
+		{Table_name: "orders", Decision: DecisionDeny, Always: true},
+		{Table_name: "orders", Decision: DecisionAllow, Days: -1},
+		{Table_name: "orders", Decision: "maybe", Days: 1},
+	} {
+		if _, err := bad.rule(); !errors.Is(err, errInvalidGrant) {
+			t.Errorf("%+v: %v, want errInvalidGrant", bad, err)
+		}
+	}
+}
+
+func TestIssuerAuthorize(t *testing.T) {
+	sub, ttl, err := oncall.authorize(IssueGrantRequest{User_id: "svc_etl", Grants: []GrantSpec{
+		{Table_name: "orders", Decision: DecisionAllow, Days: 7},
+		{Table_name: "billing_eu", Decision: DecisionAllow, Once: true},
+		{Table_name: "billing_us", Decision: DecisionDeny, Days: 30},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub != "orders:allow 7 days,billing_eu:allow once,billing_us:deny 30 days" || ttl != defaultGrantTTL {
+		t.Errorf("sub %q, ttl %v", sub, ttl)
+	}
+	// the server must be able to apply what it issues
+	if mp, err := parseGrantSub(sub); err != nil || len(mp) != 3 {
+		t.Errorf("parseGrantSub(%q) = %v, %v", sub, mp, err)
+	}
+
+	for _, tc := range []struct {
+		req  IssueGrantRequest
+		want error
+	}{
+		{IssueGrantRequest{Grants: []GrantSpec{{Table_name: "orders", Decision: DecisionAllow, Days: 1}}}, errInvalidGrant},
+		{IssueGrantRequest{User_id: "svc_etl"}, errInvalidGrant},
+		{IssueGrantRequest{User_id: "svc_etl", Grants: []GrantSpec{
+			{Table_name: "orders", Decision: DecisionAllow, Days: 1}, {Table_name: "orders", Decision: DecisionDeny, Days: 1}}}, errInvalidGrant},
+		{IssueGrantRequest{User_id: "alice", Grants: []GrantSpec{{Table_name: "orders", Decision: DecisionAllow, Days: 1}}}, errGrantForbidden},
+		{IssueGrantRequest{User_id: "svc_etl", Grants: []GrantSpec{{Table_name: "payroll", Decision: DecisionDeny, Days: 1}}}, errGrantForbidden},
+		{IssueGrantRequest{User_id: "svc_etl", Grants: []GrantSpec{{Table_name: "orders", Decision: DecisionAllow, Days: 8}}}, errGrantForbidden},
+		{IssueGrantRequest{User_id: "svc_etl", Grants: []GrantSpec{{Table_name: "orders", Decision: DecisionAllow, Always: true}}}, errGrantForbidden},
+		{IssueGrantRequest{User_id: "svc_etl", Ttl_seconds: 601, Grants: []GrantSpec{{Table_name: "orders", Decision: DecisionAllow, Days: 1}}}, errGrantForbidden},
+	} {
+		if _, _, err := oncall.authorize(tc.req); !errors.Is(err, tc.want) {
+			t.Errorf("%+v: %v, want %v", tc.req, err, tc.want)
+		}
+	}
+}
+
+// A token issued here is accepted once; with issuers configured, tokens
+// the server did not issue are refused.
+func TestUseIssuedGrant(t *testing.T) {
+	ctx := sqlctx.Background()
+	s, mock := newTestServer(t)
+	mock.ExpectBegin()
+	tx, err := s.conn.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.useIssuedGrant(ctx, tx, ""); err != nil {
+		t.Errorf("a token without jti and no issuers: %v", err)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(UseIssuedGrantQuery)).WithArgs(sqlmock.AnyArg(), "j1").WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := s.useIssuedGrant(ctx, tx, "j1"); err != nil {
+		t.Errorf("first use: %v", err)
+	}
+	mock.ExpectExec(regexp.QuoteMeta(UseIssuedGrantQuery)).WithArgs(sqlmock.AnyArg(), "j1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(FindIssuedGrantQuery)).WithArgs("j1").
+		WillReturnRows(sqlmock.NewRows([]string{"used_at"}).AddRow(time.Now()))
+	if err := s.useIssuedGrant(ctx, tx, "j1"); !errors.Is(err, errGrantReplayed) {
+		t.Errorf("second use: %v, want errGrantReplayed", err)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(UseIssuedGrantQuery)).WithArgs(sqlmock.AnyArg(), "elsewhere").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(FindIssuedGrantQuery)).WithArgs("elsewhere").WillReturnError(sql.ErrNoRows)
+	if err := s.useIssuedGrant(ctx, tx, "elsewhere"); err != nil {
+		t.Errorf("a token minted elsewhere without issuers: %v", err)
+	}
+
+	s.issuers = []GrantIssuer{oncall}
+	if err := s.useIssuedGrant(ctx, tx, ""); !errors.Is(err, errGrantUnissued) {
+		t.Errorf("a token without jti: %v, want errGrantUnissued", err)
+	}
+	mock.ExpectExec(regexp.QuoteMeta(UseIssuedGrantQuery)).WithArgs(sqlmock.AnyArg(), "elsewhere").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(FindIssuedGrantQuery)).WithArgs("elsewhere").WillReturnError(sql.ErrNoRows)
+	if err := s.useIssuedGrant(ctx, tx, "elsewhere"); !errors.Is(err, errGrantUnissued) {
+		t.Errorf("a token minted elsewhere: %v, want errGrantUnissued", err)
+	}
+	mock.ExpectRollback()
+	tx.Rollback()
+}
+
+// signGrant signs a grant token for svc_etl as the oncall issuer would.
+func signGrant(t *testing.T, s *Server, jti string, sub string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"user": "svc_etl", "sub": sub, "jti": jti, "iss": "oncall"}).
+		SignedString(s.jwtKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// The token is used up in the transaction that applies its grant, so a
+// grant that cannot be applied leaves the token good for another try.
+func TestSubmitGrantUsesTokenWithGrant(t *testing.T) {
+	s, mock := newTestServer(t)
+	s.webhooks = newWebhookDispatcher(nil)
+	s.jwtKey = []byte("0123456789abcdef")
+	ctx := sqlctx.Background()
+	token := signGrant(t, s, "j1", "orders:allow 2 days,billing_eu:deny 3 days")
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(UseIssuedGrantQuery)).WithArgs(sqlmock.AnyArg(), "j1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(LockAccessDateQuery)).WithArgs("svc_etl", "billing_eu").WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	if err := s.submitGrant(ctx, token); !errors.Is(err, errNoGrantRow) || errors.Is(err, errGrantRefused) {
+		t.Fatalf("missing row: %v, want errNoGrantRow", err)
+	}
+
+	rows := func(tbl string) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"user_id", "tbl_name", "db_access_date", "db_deny_date"}).AddRow("svc_etl", tbl, "2024-01-01", nil)
+	}
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(UseIssuedGrantQuery)).WithArgs(sqlmock.AnyArg(), "j1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(LockAccessDateQuery)).WithArgs("svc_etl", "billing_eu").WillReturnRows(rows("billing_eu"))
+	mock.ExpectExec(regexp.QuoteMeta(UpdateSecureDBDenyQuery)).
+		WithArgs(time.Now().AddDate(0, 0, 3).Format("2006-01-02"), "svc_etl", "billing_eu").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(LockAccessDateQuery)).WithArgs("svc_etl", "orders").WillReturnRows(rows("orders"))
+	mock.ExpectExec(regexp.QuoteMeta(UpdateSecureDBAllowQuery)).
+		WithArgs(time.Now().AddDate(0, 0, 2).Format("2006-01-02"), "svc_etl", "orders").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectAudit(mock, "jwt:oncall", AuditGrant)
+	expectAudit(mock, "jwt:oncall", AuditGrant)
+	if err := s.submitGrant(ctx, token); err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(UseIssuedGrantQuery)).WithArgs(sqlmock.AnyArg(), "j1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(FindIssuedGrantQuery)).WithArgs("j1").
+		WillReturnRows(sqlmock.NewRows([]string{"used_at"}).AddRow(time.Now()))
+	mock.ExpectRollback()
+	expectAudit(mock, "jwt:oncall", AuditJWT)
+	if err := s.submitGrant(ctx, token); !errors.Is(err, errGrantRefused) || !errors.Is(err, errGrantReplayed) {
+		t.Errorf("replay: %v, want errGrantRefused", err)
+	}
+}
+
+func TestIssueGrantOverHTTP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, mock := newTestServer(t)
+	s.issuers = []GrantIssuer{oncall}
+	s.jwtKey = []byte("0123456789abcdef")
+	r := gin.New()
+	r.POST("/v1/grants/tokens/issue", s.IssueGrantV1())
+	issue := func(token string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/grants/tokens/issue", strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	expectAudit(mock, "system", AuditIssue)
+	if w := issue("wrong", `{}`); w.Code != http.StatusUnauthorized {
+		t.Fatalf("unknown issuer: status %d, want 401", w.Code)
+	}
+	expectAudit(mock, "issuer:oncall", AuditIssue)
+	if w := issue("pager", `{"user_id": "svc_etl", "grants": [{"table_name": "payroll", "decision": "allow", "days": 1}]}`); w.Code != http.StatusForbidden {
+		t.Fatalf("table outside the issuer's: status %d, want 403", w.Code)
+	}
+	if w := issue("pager", `{"user_id": "svc_etl", "grants": []}`); w.Code != http.StatusBadRequest {
+		t.Fatalf("no grants: status %d, want 400", w.Code)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(InsertIssuedGrantQuery)).
+		WithArgs(sqlmock.AnyArg(), "oncall", "svc_etl", "orders:allow 2 days", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectAudit(mock, "issuer:oncall", AuditIssue)
+	w := issue("pager", `{"user_id": "svc_etl", "grants": [{"table_name": "orders", "decision": "allow", "days": 2}], "ttl_seconds": 60}`)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("issue: status %d %s", w.Code, w.Body)
+	}
+	var issued IssuedGrant
+	if err := json.Unmarshal(w.Body.Bytes(), &issued); err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.Parse(issued.Token, func(*jwt.Token) (interface{}, error) { return s.jwtKey, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	if claims["user"] != "svc_etl" || claims["sub"] != "orders:allow 2 days" || claims["iss"] != "oncall" || claims["jti"] != issued.Jti {
+		t.Errorf("claims = %v", claims)
+	}
+	if exp, iat := claims["exp"].(float64), claims["iat"].(float64); exp-iat != 60 {
+		t.Errorf("token lives %vs, want 60s", exp-iat)
+	}
+}
+
+func TestNewServerRefusesIssuersWithoutKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	path := filepath.Join(t.TempDir(), "issuers.json")
+	body, _ := json.Marshal([]GrantIssuer{oncall})
+	if err := os.WriteFile(path, body, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := NewServer(gin.New(), db, Config{GrantIssuerFile: path}); err == nil {
+		t.Fatal("grant issuers without -jwt-key were accepted")
+	}
+}
@@ -0,0 +1,395 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/url"
+	"runtime/debug"
+	"time"
+
+	"RemoteTestServer/pkg/abacpb"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// The gRPC API (pkg/abacpb/abac.proto) is served next to gin on
+// Config.GRPCAddr. Each method calls the same operation as its HTTP
+// endpoint (see ops.go); this file only converts messages and errors.
+//
+// The gRPC API is off unless an address is configured, and every call must
+// carry an admin token (see adminauth.go) in "authorization" metadata, as
+// "Bearer <token>".
+
+// grpcHints carries the cache bypass request and X-Cache outcome in gRPC
+// metadata, lower-cased as gRPC requires.
+type grpcHints struct {
+	ctx sqlctx.Context
+}
+
+func (h grpcHints) GetHeader(key string) string {
+	return firstMetadata(h.ctx, key)
+}
+
+func (h grpcHints) Header(key string, value string) {
+	grpc.SetHeader(h.ctx, metadata.Pairs(key, value))
+}
+
+func firstMetadata(ctx sqlctx.Context, key string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// metadataCarrier lets the trace propagator read traceparent from gRPC
+// metadata.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// grpcUnary is the gRPC counterpart of the gin middleware chain: tracing,
+// request id and actor, access log and metrics.
+func (s *Server) grpcUnary(ctx sqlctx.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+	id := firstMetadata(ctx, requestIDHeader)
+	if id == "" || len(id) > 64 {
+		id = newRequestID()
+	}
+	client := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		client = p.Addr.String()
+	}
+	actor := "anonymous@" + client
+	ctx = sqlctx.WithValue(ctx, requestMetaKey{}, requestMeta{requestID: id, actor: actor})
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+
+	ctx, span := tracer.Start(ctx, info.FullMethod,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.method", info.FullMethod),
+			attribute.String("client.address", client),
+			attribute.String("request_id", id),
+		))
+	resp, err := handler(ctx, req)
+	code := status.Code(err)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+	level := slog.LevelInfo
+	switch code {
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss:
+		span.SetStatus(otelcodes.Error, code.String())
+		level = slog.LevelError
+	}
+	span.End()
+
+	grpcRequests.WithLabelValues(info.FullMethod, code.String()).Inc()
+	grpcDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+	s.log.Log(ctx, level, "rpc",
+		"method", info.FullMethod,
+		"code", code.String(),
+		"latency_ms", time.Since(start).Milliseconds(),
+		"client_ip", client,
+		"actor", actor)
+	return resp, err
+}
+
+// grpcRecover turns a panicking handler into an Internal error, as
+// gin.Recovery does for HTTP, so one bad request cannot stop the server.
+func (s *Server) grpcRecover(ctx sqlctx.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			s.log.ErrorContext(ctx, "rpc panic", "method", info.FullMethod, "panic", p, "stack", string(debug.Stack()))
+			resp, err = nil, status.Error(codes.Internal, "internal error")
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// grpcAuth refuses calls without an admin token and records the admin as
+// the actor of the call.
+func (s *Server) grpcAuth(ctx sqlctx.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	a, err := s.admin(firstMetadata(ctx, "authorization"))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return handler(WithActor(ctx, "admin:"+a.Name), req)
+}
+
+func (s *Server) newGRPCServer() *grpc.Server {
+	gs := grpc.NewServer(grpc.ChainUnaryInterceptor(s.grpcUnary, s.grpcRecover, s.grpcAuth))
+	abacpb.RegisterAbacServer(gs, &grpcService{s: s})
+	return gs
+}
+
+// grpcError maps operation errors onto status codes. Database errors are
+// not passed through; they are in the server log.
+func grpcError(err error) error {
+	var lerr listError
+	var terr policyTestError
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "not found")
+	case errors.Is(err, errNoAccess):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &lerr):
+		return status.Error(codes.InvalidArgument, lerr.msg)
+	case errors.As(err, &terr):
+		return status.Error(codes.FailedPrecondition, terr.Error())
+	case errors.Is(err, errOPAUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, sqlctx.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	case errors.Is(err, sqlctx.Canceled):
+		return status.Error(codes.Canceled, "canceled")
+	}
+	return status.Error(codes.Internal, "database error")
+}
+
+type grpcService struct {
+	abacpb.UnimplementedAbacServer
+	s *Server
+}
+
+func (g *grpcService) read(ctx sqlctx.Context, err error, rec AuditRecord) error {
+	if err != nil {
+		return grpcError(err)
+	}
+	g.s.audit.Record(ctx, rec)
+	return nil
+}
+
+func writeResponse(rows int64, err error) (*abacpb.WriteResponse, error) {
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &abacpb.WriteResponse{Rows: rows}, nil
+}
+
+// list runs spec with the request's paging and filters and audits it the
+// way /list_* does, with the filters as the target.
+func (g *grpcService) list(ctx sqlctx.Context, spec listSpec, req *abacpb.ListRequest) ([]interface{}, string, error) {
+	p := listParams{limit: int(req.GetLimit()), sort: req.GetSort(), order: req.GetOrder(), cursor: req.GetCursor()}
+	filters := req.GetFilters()
+	q := func(name string) (string, bool) {
+		v, ok := filters[name]
+		return v, ok
+	}
+	result, err := g.s.runList(ctx, spec, p, q)
+	if err != nil {
+		return nil, "", grpcError(err)
+	}
+	target := url.Values{}
+	for k, v := range filters {
+		target.Set(k, v)
+	}
+	g.s.audit.Record(ctx, AuditRecord{Action: AuditList, Target_type: spec.entity, Target_id: target.Encode()})
+	return result.Items.([]interface{}), result.Next_cursor, nil
+}
+
+func (g *grpcService) GetPolicy(ctx sqlctx.Context, req *abacpb.GetPolicyRequest) (*abacpb.Policy, error) {
+	p, err := g.s.findPolicy(ctx, grpcHints{ctx}, req.GetRef())
+	if err := g.read(ctx, err, AuditRecord{Action: AuditRead, Target_type: "policy", Target_id: req.GetRef()}); err != nil {
+		return nil, err
+	}
+	return &abacpb.Policy{Ref: p.Ref, Content: p.Content}, nil
+}
+
+func (g *grpcService) ListPolicies(ctx sqlctx.Context, req *abacpb.ListRequest) (*abacpb.ListPoliciesResponse, error) {
+	items, next, err := g.list(ctx, policyList, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &abacpb.ListPoliciesResponse{NextCursor: next}
+	for _, item := range items {
+		p := item.(Policy)
+		resp.Items = append(resp.Items, &abacpb.Policy{Ref: p.Ref, Content: p.Content})
+	}
+	return resp, nil
+}
+
+func (g *grpcService) CreatePolicy(ctx sqlctx.Context, req *abacpb.Policy) (*abacpb.WriteResponse, error) {
+	return writeResponse(g.s.insertPolicy(ctx, InsertPolicyRequest{Ref: req.GetRef(), Content: req.GetContent()}))
+}
+
+func (g *grpcService) UpdatePolicy(ctx sqlctx.Context, req *abacpb.Policy) (*abacpb.WriteResponse, error) {
+	return writeResponse(g.s.updatePolicy(ctx, UpdatePolicyRequest{Ref: req.GetRef(), Content: req.GetContent()}))
+}
+
+func (g *grpcService) GetHierarchy(ctx sqlctx.Context, req *abacpb.GetHierarchyRequest) (*abacpb.Hierarchy, error) {
+	h, err := g.s.findHierarchy(ctx, grpcHints{ctx}, req.GetObjId(), req.GetAction())
+	if err := g.read(ctx, err, AuditRecord{Action: AuditRead, Target_type: "hierarchy", Target_id: req.GetObjId() + "/" + req.GetAction()}); err != nil {
+		return nil, err
+	}
+	return &abacpb.Hierarchy{ObjId: h.Obj_id, Action: h.Action, Hierarchy: h.Hierarchy}, nil
+}
+
+func (g *grpcService) ListHierarchies(ctx sqlctx.Context, req *abacpb.ListRequest) (*abacpb.ListHierarchiesResponse, error) {
+	items, next, err := g.list(ctx, hierarchyList, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &abacpb.ListHierarchiesResponse{NextCursor: next}
+	for _, item := range items {
+		h := item.(Hierarchy)
+		resp.Items = append(resp.Items, &abacpb.Hierarchy{ObjId: h.Obj_id, Action: h.Action, Hierarchy: h.Hierarchy})
+	}
+	return resp, nil
+}
+
+func (g *grpcService) CreateHierarchy(ctx sqlctx.Context, req *abacpb.Hierarchy) (*abacpb.WriteResponse, error) {
+	return writeResponse(g.s.insertHierarchy(ctx, InsertObjectHierarchyRequest{Obj_id: req.GetObjId(), Action: req.GetAction(), Hierarchy: req.GetHierarchy()}))
+}
+
+func (g *grpcService) UpdateHierarchy(ctx sqlctx.Context, req *abacpb.Hierarchy) (*abacpb.WriteResponse, error) {
+	return writeResponse(g.s.updateHierarchy(ctx, UpdateObjectHierarchyRequest{Obj_id: req.GetObjId(), Action: req.GetAction(), Hierarchy: req.GetHierarchy()}))
+}
+
+func (g *grpcService) GetUserAttrs(ctx sqlctx.Context, req *abacpb.GetUserRequest) (*abacpb.UserAttrs, error) {
+	u, err := g.s.readUserAttrs(ctx, grpcHints{ctx}, req.GetUserId())
+	if err := g.read(ctx, err, AuditRecord{Action: AuditRead, Target_type: "user", Target_id: req.GetUserId()}); err != nil {
+		return nil, err
+	}
+	return &abacpb.UserAttrs{UserId: u.User_id, Attrs: u.Attrs}, nil
+}
+
+func (g *grpcService) ListUsers(ctx sqlctx.Context, req *abacpb.ListRequest) (*abacpb.ListUsersResponse, error) {
+	items, next, err := g.list(ctx, userList, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &abacpb.ListUsersResponse{NextCursor: next}
+	for _, item := range items {
+		u := item.(UserAttrs)
+		resp.Items = append(resp.Items, &abacpb.UserAttrs{UserId: u.User_id, Attrs: u.Attrs})
+	}
+	return resp, nil
+}
+
+func (g *grpcService) CreateUser(ctx sqlctx.Context, req *abacpb.CreateUserRequest) (*abacpb.WriteResponse, error) {
+	return writeResponse(g.s.insertUserAttrs(ctx, InsertUserAttrsRequest{User_id: req.GetUserId(), Password: req.GetPassword(), Attrs: req.GetAttrs()}))
+}
+
+func (g *grpcService) UpdateUserAttrs(ctx sqlctx.Context, req *abacpb.UserAttrs) (*abacpb.WriteResponse, error) {
+	return writeResponse(g.s.updateUserAttrs(ctx, UpdateUserAttrsRequest{User_id: req.GetUserId(), Attrs: req.GetAttrs()}))
+}
+
+func (g *grpcService) GetDeviceAttrs(ctx sqlctx.Context, req *abacpb.GetDeviceRequest) (*abacpb.DeviceAttrs, error) {
+	d, err := g.s.findDevAttrs(ctx, req.GetDevId())
+	if err := g.read(ctx, err, AuditRecord{Action: AuditRead, Target_type: "device", Target_id: req.GetDevId()}); err != nil {
+		return nil, err
+	}
+	return &abacpb.DeviceAttrs{DevId: d.Dev_id, Attrs: d.Attrs}, nil
+}
+
+func (g *grpcService) GetDeviceActions(ctx sqlctx.Context, req *abacpb.GetDeviceRequest) (*abacpb.DeviceActions, error) {
+	d, err := g.s.findDevActions(ctx, req.GetDevId())
+	if err := g.read(ctx, err, AuditRecord{Action: AuditRead, Target_type: "device", Target_id: req.GetDevId()}); err != nil {
+		return nil, err
+	}
+	return &abacpb.DeviceActions{DevId: d.Dev_id, Actions: d.Actions}, nil
+}
+
+func (g *grpcService) ListDevices(ctx sqlctx.Context, req *abacpb.ListRequest) (*abacpb.ListDevicesResponse, error) {
+	items, next, err := g.list(ctx, deviceList, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &abacpb.ListDevicesResponse{NextCursor: next}
+	for _, item := range items {
+		d := item.(DevInfo)
+		resp.Items = append(resp.Items, &abacpb.Device{DevId: d.Dev_id, DevType: d.Dev_type, Actions: d.Actions, Attrs: d.Attrs})
+	}
+	return resp, nil
+}
+
+func (g *grpcService) CreateDevice(ctx sqlctx.Context, req *abacpb.CreateDeviceRequest) (*abacpb.WriteResponse, error) {
+	return writeResponse(g.s.insertDevInfoFull(ctx, InsertDevInfoFullRequest{Dev_id: req.GetDevId(), Dev_type: req.GetDevType(),
+		Action: req.GetActions(), Token: req.GetToken(), Attrs: req.GetAttrs()}))
+}
+
+func (g *grpcService) GetAccess(ctx sqlctx.Context, req *abacpb.GetAccessRequest) (*abacpb.Access, error) {
+	a, err := g.s.findDBAccess(ctx, req.GetUserId(), req.GetTableName())
+	if err := g.read(ctx, err, AuditRecord{Action: AuditRead, Target_type: "grant", Target_id: req.GetUserId() + "/" + req.GetTableName()}); err != nil {
+		return nil, err
+	}
+	return accessMessage(a), nil
+}
+
+func accessMessage(a DBAccess) *abacpb.Access {
+	return &abacpb.Access{UserId: a.User_id, TableName: a.Table_name, DbAccessDate: a.Db_access_date, DbDenyDate: a.Db_deny_date}
+}
+
+func (g *grpcService) ListAccess(ctx sqlctx.Context, req *abacpb.ListRequest) (*abacpb.ListAccessResponse, error) {
+	items, next, err := g.list(ctx, accessList, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &abacpb.ListAccessResponse{NextCursor: next}
+	for _, item := range items {
+		resp.Items = append(resp.Items, accessMessage(item.(DBAccess)))
+	}
+	return resp, nil
+}
+
+func (g *grpcService) CreateAccess(ctx sqlctx.Context, req *abacpb.Access) (*abacpb.WriteResponse, error) {
+	return writeResponse(g.s.insertPermInfo(ctx, InsertPermInfoQueryRequest{User_id: req.GetUserId(), Tbl_name: req.GetTableName(),
+		Db_access_date: req.GetDbAccessDate(), Db_deny_date: req.GetDbDenyDate()}))
+}
+
+func (g *grpcService) SetAccessDate(ctx sqlctx.Context, req *abacpb.SetAccessDateRequest) (*abacpb.WriteResponse, error) {
+	return writeResponse(g.s.updateDBAllow(ctx, UpdateSecureDBAllowRequest{User_id: req.GetUserId(), Tbl_name: req.GetTableName(), Db_access_date: req.GetDbAccessDate()}))
+}
+
+func (g *grpcService) SetDenyDate(ctx sqlctx.Context, req *abacpb.SetDenyDateRequest) (*abacpb.WriteResponse, error) {
+	return writeResponse(g.s.updateDBDeny(ctx, UpdateSecureDBDenyRequest{User_id: req.GetUserId(), Tbl_name: req.GetTableName(), Db_deny_date: req.GetDbDenyDate()}))
+}
+
+func (g *grpcService) CheckAccess(ctx sqlctx.Context, req *abacpb.CheckAccessRequest) (*abacpb.CheckAccessResponse, error) {
+	return &abacpb.CheckAccessResponse{Allowed: g.s.checkAuthServerPerm(ctx, req.GetUserId(), req.GetTableName())}, nil
+}
+
+func (g *grpcService) SubmitGrant(ctx sqlctx.Context, req *abacpb.SubmitGrantRequest) (*abacpb.SubmitGrantResponse, error) {
+	err := g.s.submitGrant(ctx, req.GetToken())
+	switch {
+	case errors.Is(err, errGrantRefused):
+		return nil, status.Error(codes.InvalidArgument, "wrong JWT signature!")
+	case errors.Is(err, errNoGrantRow):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, grpcError(err)
+	}
+	return &abacpb.SubmitGrantResponse{Message: "JWT received!"}, nil
+}
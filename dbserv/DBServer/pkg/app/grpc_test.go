@@ -0,0 +1,116 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"net"
+	"regexp"
+	"testing"
+
+	"RemoteTestServer/pkg/abacpb"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dialGRPC serves s's gRPC API over an in-memory listener and returns a
+// client for it.
+func dialGRPC(t *testing.T, s *Server) abacpb.AbacClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	gs := s.newGRPCServer()
+	go gs.Serve(lis)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx sqlctx.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		gs.Stop()
+	})
+	return abacpb.NewAbacClient(conn)
+}
+
+func TestGRPCNeedsAnAdminToken(t *testing.T) {
+	s, mock := newTestServer(t)
+	withAdmin(s, "ops", "s3cret")
+	client := dialGRPC(t, s)
+
+	for _, token := range []string{"", "Bearer wrong"} {
+		ctx := sqlctx.Background()
+		if token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", token)
+		}
+		_, err := client.GetPolicy(ctx, &abacpb.GetPolicyRequest{Ref: "p1"})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("authorization %q: %v, want Unauthenticated", token, err)
+		}
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WithArgs("p1").
+		WillReturnRows(sqlmock.NewRows([]string{"ref", "content"}).AddRow("p1", "package p1"))
+	expectAudit(mock, "admin:ops", AuditRead)
+	ctx := metadata.AppendToOutgoingContext(sqlctx.Background(), "authorization", "Bearer s3cret", requestIDHeader, "req-1")
+	var header metadata.MD
+	p, err := client.GetPolicy(ctx, &abacpb.GetPolicyRequest{Ref: "p1"}, grpc.Header(&header))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.GetContent() != "package p1" {
+		t.Errorf("policy = %v", p)
+	}
+	if got := header.Get(requestIDHeader); len(got) != 1 || got[0] != "req-1" {
+		t.Errorf("request id header = %v, want req-1", got)
+	}
+}
+
+func TestGRPCErrorCodes(t *testing.T) {
+	s, mock := newTestServer(t)
+	withAdmin(s, "ops", "s3cret")
+	client := dialGRPC(t, s)
+	ctx := metadata.AppendToOutgoingContext(sqlctx.Background(), "authorization", "Bearer s3cret")
+
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WillReturnError(sql.ErrNoRows)
+	if _, err := client.GetPolicy(ctx, &abacpb.GetPolicyRequest{Ref: "gone"}); status.Code(err) != codes.NotFound {
+		t.Errorf("missing policy: %v, want NotFound", err)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WillReturnError(sql.ErrConnDone)
+	_, err := client.GetPolicy(ctx, &abacpb.GetPolicyRequest{Ref: "p1"})
+	if status.Code(err) != codes.Internal || status.Convert(err).Message() != "database error" {
+		t.Errorf("database failure: %v, want Internal without the database error", err)
+	}
+	if _, err := client.ListPolicies(ctx, &abacpb.ListRequest{Sort: "colour"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("bad sort: %v, want InvalidArgument", err)
+	}
+}
+
+func TestGRPCRecover(t *testing.T) {
+	s, _ := newTestServer(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/abac.Abac/GetPolicy"}
+	_, err := s.grpcRecover(sqlctx.Background(), nil, info, func(ctx sqlctx.Context, req interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("panicking handler: %v, want Internal", err)
+	}
+}
+
+func TestNewServerRefusesGRPCWithoutAdmins(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := NewServer(gin.New(), db, Config{GRPCAddr: "127.0.0.1:0"}); err == nil {
+		t.Fatal("a gRPC address without admin tokens was accepted")
+	}
+}
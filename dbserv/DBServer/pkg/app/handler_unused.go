@@ -1,14 +1,8 @@
 package app
 
 import (
-	sqlctx "context"
-	"database/sql"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
-	"log"
-	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	_ "github.com/go-sql-driver/mysql"
@@ -19,47 +13,8 @@ func (s *Server) FindPolicy() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		ref := context.Param("ref")
-		res, err := s.conn.Query(FindPolicyQuery, ref)
-		fmt.Printf("query temp: %v, params: %v\n", FindPolicyQuery, ref)
-
-		if err != nil {
-			fmt.Printf("Unable to execute sql_query, template: %v, params: %v, err: %v\n",
-				FindPolicyQuery, ref, err)
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		defer func(res *sql.Rows) {
-			err := res.Close()
-			if err != nil {
-				fmt.Printf("close res err: %v\n", err)
-			}
-		}(res)
-
-		var result Policy
-
-		if res.Next() {
-			if err := res.Scan(&result.Ref, &result.Content); err != nil {
-				fmt.Printf("scan err: %v\n", err)
-				context.JSON(http.StatusBadRequest, nil)
-				return
-			}
-		} else {
-			fmt.Printf("empty query result\n")
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		fmt.Printf("policy: %+v\n", result)
-
-		time.Sleep(100 * time.Millisecond)
-
-		ret, err := json.Marshal(result)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		context.String(http.StatusOK, string(ret))
+		result, err := s.findPolicy(context.Request.Context(), context, ref)
+		s.writeFound(context, result, err, AuditRecord{Action: AuditRead, Target_type: "policy", Target_id: ref})
 	}
 }
 
@@ -69,47 +24,8 @@ func (s *Server) FindHierarchy() gin.HandlerFunc {
 
 		obj_id := context.Param("obj_id")
 		action := context.Param("action")
-		res, err := s.conn.Query(FindHierarchyQuery, obj_id, action)
-		fmt.Printf("query temp: %v, params: %v, %v\n", FindHierarchyQuery, obj_id, action)
-
-		if err != nil {
-			fmt.Printf("Unable to execute sql_query, template: %v, params: %v, %v, err: %v\n",
-				FindHierarchyQuery, obj_id, action, err)
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		defer func(res *sql.Rows) {
-			err := res.Close()
-			if err != nil {
-				fmt.Printf("close res err: %v\n", err)
-			}
-		}(res)
-
-		var result Hierarchy
-
-		if res.Next() {
-			if err := res.Scan(&result.Obj_id, &result.Action, &result.Hierarchy); err != nil {
-				fmt.Printf("scan err: %v\n", err)
-				context.JSON(http.StatusBadRequest, nil)
-				return
-			}
-		} else {
-			fmt.Printf("empty query result\n")
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		fmt.Printf("hierarchy: %+v\n", result)
-
-		time.Sleep(100 * time.Millisecond)
-
-		ret, err := json.Marshal(result)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		context.String(http.StatusOK, string(ret))
+		result, err := s.findHierarchy(context.Request.Context(), context, obj_id, action)
+		s.writeFound(context, result, err, AuditRecord{Action: AuditRead, Target_type: "hierarchy", Target_id: obj_id + "/" + action})
 	}
 }
 
@@ -118,47 +34,8 @@ func (s *Server) FindDevCheckInfo() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		dev_id := context.Param("dev_id")
-		res, err := s.conn.Query(FindDevCheckInfoQuery, dev_id)
-		fmt.Printf("query temp: %v, params: %v \n", FindDevCheckInfoQuery, dev_id)
-
-		if err != nil {
-			fmt.Printf("Unable to execute sql_query, template: %v, params: %v, err: %v\n",
-				FindDevCheckInfoQuery, dev_id, err)
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		defer func(res *sql.Rows) {
-			err := res.Close()
-			if err != nil {
-				fmt.Printf("close res err: %v\n", err)
-			}
-		}(res)
-
-		var result DevCheckInfo
-
-		if res.Next() {
-			if err := res.Scan(&result.Dev_id, &result.Dev_type, &result.Token); err != nil {
-				fmt.Printf("scan err: %v\n", err)
-				context.JSON(http.StatusBadRequest, nil)
-				return
-			}
-		} else {
-			fmt.Printf("empty query result\n")
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		fmt.Printf("hierarchy: %+v\n", result)
-
-		time.Sleep(100 * time.Millisecond)
-
-		ret, err := json.Marshal(result)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		context.String(http.StatusOK, string(ret))
+		result, err := s.findDevCheckInfo(context.Request.Context(), dev_id)
+		s.writeFound(context, result, err, AuditRecord{Action: AuditReadCheckInfo, Target_type: "device", Target_id: dev_id})
 	}
 }
 
@@ -167,48 +44,8 @@ func (s *Server) FindDevAttrs() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		dev_id := context.Param("dev_id")
-
-		res, err := s.conn.Query(FindDevAttrsQuery, dev_id)
-		fmt.Printf("query temp: %v, params: %v\n", FindUserAttrsQuery, dev_id)
-
-		if err != nil {
-			fmt.Printf("Unable to execute sql_query, template: %v, params: %v, err: %v\n",
-				FindDevAttrsQuery, dev_id, err)
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		defer func(res *sql.Rows) {
-			err := res.Close()
-			if err != nil {
-				fmt.Printf("close res err: %v\n", err)
-			}
-		}(res)
-
-		var result DevAttrs
-
-		if res.Next() {
-			if err := res.Scan(&result.Dev_id, &result.Attrs); err != nil {
-				fmt.Printf("scan err: %v\n", err)
-				context.JSON(http.StatusBadRequest, nil)
-				return
-			}
-		} else {
-			fmt.Printf("empty query result\n")
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		fmt.Printf("attrs: %+v\n", result)
-
-		time.Sleep(100 * time.Millisecond)
-
-		ret, err := json.Marshal(result)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		context.String(http.StatusOK, string(ret))
+		result, err := s.findDevAttrs(context.Request.Context(), dev_id)
+		s.writeFound(context, result, err, AuditRecord{Action: AuditRead, Target_type: "device", Target_id: dev_id})
 	}
 }
 
@@ -217,48 +54,8 @@ func (s *Server) FindDevActions() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		dev_id := context.Param("dev_id")
-
-		res, err := s.conn.Query(FindDevActionsQuery, dev_id)
-		fmt.Printf("query temp: %v, params: %v\n", FindDevActionsQuery, dev_id)
-
-		if err != nil {
-			fmt.Printf("Unable to execute sql_query, template: %v, params: %v, err: %v\n",
-				FindDevActionsQuery, dev_id, err)
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		defer func(res *sql.Rows) {
-			err := res.Close()
-			if err != nil {
-				fmt.Printf("close res err: %v\n", err)
-			}
-		}(res)
-
-		var result DevActions
-
-		if res.Next() {
-			if err := res.Scan(&result.Dev_id, &result.Actions); err != nil {
-				fmt.Printf("scan err: %v\n", err)
-				context.JSON(http.StatusBadRequest, nil)
-				return
-			}
-		} else {
-			fmt.Printf("empty query result\n")
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		fmt.Printf("actions: %+v\n", result)
-
-		time.Sleep(100 * time.Millisecond)
-
-		ret, err := json.Marshal(result)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		context.String(http.StatusOK, string(ret))
+		result, err := s.findDevActions(context.Request.Context(), dev_id)
+		s.writeFound(context, result, err, AuditRecord{Action: AuditRead, Target_type: "device", Target_id: dev_id})
 	}
 }
 
@@ -266,34 +63,16 @@ func (s *Server) InsertPolicy() gin.HandlerFunc {
 	return func(context *gin.Context) {
 		context.Header("Content-Type", "application/json")
 
-		ref := context.Param("ref")
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-
-		var reqdata InsertPolicyRequest
-		json.Unmarshal(reqBody, &reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, InsertPolicyQuery)
 		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, ref, reqdata.Content)
-		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
-			return
-		}
-		rows, err := res.RowsAffected()
-		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
-			return
-		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows inserted ")
+		var reqdata InsertPolicyRequest
+		json.Unmarshal(reqBody, &reqdata)
+		reqdata.Ref = context.Param("ref")
+		rows, err := s.insertPolicy(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "inserted")
 	}
 }
 
@@ -302,32 +81,14 @@ func (s *Server) InsertObjectHierarchy() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-
-		var reqdata InsertObjectHierarchyRequest
-		json.Unmarshal(reqBody, &reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, InsertObjectHierarchyQuery)
-		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
-			return
-		}
-
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, reqdata.Obj_id, reqdata.Action, reqdata.Hierarchy)
 		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-		rows, err := res.RowsAffected()
-		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
-			return
-		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows inserted ")
+		var reqdata InsertObjectHierarchyRequest
+		json.Unmarshal(reqBody, &reqdata)
+		rows, err := s.insertHierarchy(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "inserted")
 	}
 }
 
@@ -336,32 +97,14 @@ func (s *Server) InsertDevInfo() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-
-		var reqdata InsertDevInfoRequest
-		json.Unmarshal(reqBody, &reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, InsertDevInfoQuery)
-		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
-			return
-		}
-
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, reqdata.Dev_id, reqdata.Dev_type, reqdata.Token, reqdata.Attrs)
 		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-		rows, err := res.RowsAffected()
-		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
-			return
-		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows inserted ")
+		var reqdata InsertDevInfoRequest
+		json.Unmarshal(reqBody, &reqdata)
+		rows, err := s.insertDevInfo(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "inserted")
 	}
 }
 
@@ -370,32 +113,14 @@ func (s *Server) InsertDevInfoFull() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-
-		var reqdata InsertDevInfoFullRequest
-		json.Unmarshal(reqBody, &reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, InsertDevInfoFullQuery)
 		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, reqdata.Dev_id, reqdata.Dev_type, reqdata.Action, reqdata.Token, reqdata.Attrs)
-		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
-			return
-		}
-		rows, err := res.RowsAffected()
-		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
-			return
-		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows inserted ")
+		var reqdata InsertDevInfoFullRequest
+		json.Unmarshal(reqBody, &reqdata)
+		rows, err := s.insertDevInfoFull(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "inserted")
 	}
 }
 
@@ -403,34 +128,16 @@ func (s *Server) UpdatePolicy() gin.HandlerFunc {
 	return func(context *gin.Context) {
 		context.Header("Content-Type", "application/json")
 
-		ref := context.Param("ref")
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-
-		var reqdata UpdatePolicyRequest
-		json.Unmarshal(reqBody, &reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, InsertPolicyQuery)
-		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
-			return
-		}
-
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, ref, reqdata.Content)
-		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
-			return
-		}
-		rows, err := res.RowsAffected()
 		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows inserted ")
+		var reqdata UpdatePolicyRequest
+		json.Unmarshal(reqBody, &reqdata)
+		reqdata.Ref = context.Param("ref")
+		rows, err := s.updatePolicy(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "updated")
 	}
 }
 func (s *Server) UpdateObjectHierarchy() gin.HandlerFunc {
@@ -438,33 +145,14 @@ func (s *Server) UpdateObjectHierarchy() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-		fmt.Printf(string(reqBody))
-		var reqdata UpdateObjectHierarchyRequest
-
-		json.Unmarshal(reqBody, &reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, UpdateObjectHierarchyQuery)
-		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
-			return
-		}
-
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, reqdata.Hierarchy, reqdata.Obj_id, reqdata.Action)
-		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
-			return
-		}
-		rows, err := res.RowsAffected()
 		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows updated ")
-		return
+		s.log.DebugContext(context.Request.Context(), "request body", "body", redactBody(reqBody))
+		var reqdata UpdateObjectHierarchyRequest
+		json.Unmarshal(reqBody, &reqdata)
+		rows, err := s.updateHierarchy(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "updated")
 	}
 }
@@ -4,18 +4,19 @@ import (
 	sqlctx "context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"faultinject"
 	"github.com/gin-gonic/gin"
 	_ "github.com/go-sql-driver/mysql"
-	jwt "github.com/golang-jwt/jwt/v4"
 )
 
 func (s *Server) FindUserAttrs() gin.HandlerFunc { //don't have enough permission
@@ -23,53 +24,12 @@ func (s *Server) FindUserAttrs() gin.HandlerFunc { //don't have enough permissio
 		context.Header("Content-Type", "application/json")
 
 		id := context.Param("id")
-
-		if !s.checkAuthServerPerm(id, "user_attrs") {
+		result, err := s.readUserAttrs(context.Request.Context(), context, id)
+		if errors.Is(err, errNoAccess) {
 			context.String(http.StatusBadRequest, "Don't have access to DB")
 			return
 		}
-
-		res, err := s.conn.Query(FindUserAttrsQuery, id)
-		fmt.Printf("query temp: %v, params: %v\n", FindUserAttrsQuery, id)
-
-		if err != nil {
-			fmt.Printf("Unable to execute sql_query, template: %v, params: %v, err: %v\n",
-				FindUserAttrsQuery, id, err)
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		defer func(res *sql.Rows) {
-			err := res.Close()
-			if err != nil {
-				fmt.Printf("close res err: %v\n", err)
-			}
-		}(res)
-
-		var result UserAttrs
-
-		if res.Next() {
-			if err := res.Scan(&result.User_id, &result.Attrs); err != nil {
-				fmt.Printf("scan err: %v\n", err)
-				context.JSON(http.StatusBadRequest, nil)
-				return
-			}
-		} else {
-			fmt.Printf("empty query result\n")
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		fmt.Printf("attrs: %+v\n", result.Attrs)
-
-		time.Sleep(100 * time.Millisecond)
-
-		ret, err := json.Marshal(result)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		context.String(http.StatusOK, string(ret))
+		s.writeFound(context, result, err, AuditRecord{Action: AuditRead, Target_type: "user", Target_id: id})
 	}
 }
 
@@ -78,100 +38,56 @@ func (s *Server) FindUserCheckInfo() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		user_id := context.Param("user_id")
-		res, err := s.conn.Query(FindUserCheckInfoQuery, user_id)
-		fmt.Printf("query temp: %v, params: %v \n", FindUserCheckInfoQuery, user_id)
-
-		if err != nil {
-			fmt.Printf("Unable to execute sql_query, template: %v, params: %v, err: %v\n",
-				FindUserCheckInfoQuery, user_id, err)
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		defer func(res *sql.Rows) {
-			err := res.Close()
-			if err != nil {
-				fmt.Printf("close res err: %v\n", err)
-			}
-		}(res)
-
-		var result UserCheckInfo
-
-		if res.Next() {
-			if err := res.Scan(&result.User_id, &result.Password); err != nil {
-				fmt.Printf("scan err: %v\n", err)
-				context.JSON(http.StatusBadRequest, nil)
-				return
-			}
-		} else {
-			fmt.Printf("empty query result\n")
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		fmt.Printf("hierarchy: %+v\n", result)
-
-		time.Sleep(100 * time.Millisecond)
-
-		ret, err := json.Marshal(result)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		context.String(http.StatusOK, string(ret))
+		result, err := s.findUserCheckInfo(context.Request.Context(), user_id)
+		s.writeFound(context, result, err, AuditRecord{Action: AuditReadCheckInfo, Target_type: "user", Target_id: user_id})
 	}
 }
 
 func (s *Server) FindDBAccess() gin.HandlerFunc {
-	fmt.Println("here find db access")
 	return func(context *gin.Context) {
 		context.Header("Content-Type", "application/json")
 
 		user_id := context.Param("user_id")
 		table_name := context.Param("table_name")
+		result, err := s.findDBAccess(context.Request.Context(), user_id, table_name)
+		s.writeFound(context, result, err, AuditRecord{Action: AuditRead, Target_type: "grant", Target_id: user_id + "/" + table_name})
+	}
+}
 
-		res, err := s.conn.Query(FindAccessDateQuery, user_id, table_name)
-		fmt.Printf("query temp: %v, params: %v, %v\n", FindAccessDateQuery, user_id, table_name)
-
-		if err != nil {
-			fmt.Printf("Unable to execute sql_query, template: %v, params: %v, %v, err: %v\n",
-				FindAccessDateQuery, user_id, table_name, err)
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
+// writeFound answers a single-row read. A missing row or failed query is a
+// 400 with a null body; otherwise the read is audited and result returned.
+func (s *Server) writeFound(context *gin.Context, result interface{}, err error, rec AuditRecord) {
+	ctx := context.Request.Context()
+	if errors.Is(err, sql.ErrNoRows) {
+		s.log.DebugContext(ctx, "empty query result")
+		context.JSON(http.StatusBadRequest, nil)
+		return
+	}
+	if err != nil {
+		s.log.ErrorContext(ctx, "unable to execute sql query", "target", rec.Target_type, "id", rec.Target_id, "err", err)
+		context.JSON(http.StatusBadRequest, nil)
+		return
+	}
 
-		defer func(res *sql.Rows) {
-			err := res.Close()
-			if err != nil {
-				fmt.Printf("close res err: %v\n", err)
-			}
-		}(res)
+	s.log.DebugContext(ctx, "query result", "result", redact(result))
 
-		var result DBAccess
+	s.audit.Record(ctx, rec)
 
-		if res.Next() {
-			if err := res.Scan(&result.User_id, &result.Table_name, &result.Db_access_date, &result.Db_deny_date); err != nil {
-				fmt.Printf("scan err: %v\n", err)
-				context.JSON(http.StatusBadRequest, nil)
-				return
-			}
-		} else {
-			fmt.Printf("empty query result\n")
-			context.JSON(http.StatusBadRequest, nil)
-			return
-		}
-
-		fmt.Printf("actions: %+v\n", result)
-
-		time.Sleep(100 * time.Millisecond)
+	ret, err := json.Marshal(result)
+	if err != nil {
+		s.log.ErrorContext(ctx, "marshal response failed", "err", err)
+		return
+	}
+	context.String(http.StatusOK, string(ret))
+}
 
-		ret, err := json.Marshal(result)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		context.String(http.StatusOK, string(ret))
+// writeRows answers a write with the number of rows it touched. Failures
+// have been logged by exec and get no body, as before.
+func writeRows(context *gin.Context, rows int64, err error, verb string) {
+	if err != nil {
+		return
 	}
+	context.String(http.StatusOK, strconv.FormatInt(rows, 10)+" rows "+verb+" ")
 }
 
 func (s *Server) InsertUserAttrs() gin.HandlerFunc {
@@ -179,67 +95,32 @@ func (s *Server) InsertUserAttrs() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-
-		var reqdata InsertUserAttrsRequest
-		json.Unmarshal(reqBody, &reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, InsertUserAttrsQuery)
 		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, reqdata.User_id, reqdata.Password, reqdata.Attrs)
-		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
-			return
-		}
-		rows, err := res.RowsAffected()
-		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
-			return
-		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows inserted ")
+		s.log.DebugContext(context.Request.Context(), "request body", "body", redactBody(reqBody))
+		var reqdata InsertUserAttrsRequest
+		json.Unmarshal(reqBody, &reqdata)
+		rows, err := s.insertUserAttrs(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "inserted")
 	}
 }
 
 func (s *Server) InsertPermInfo() gin.HandlerFunc {
 	return func(context *gin.Context) {
 		context.Header("Content-Type", "application/json")
-		reqBody, err := ioutil.ReadAll(context.Request.Body)
-		// fmt.Printf(string(reqBody))
-		var reqdata InsertPermInfoQueryRequest
-		json.Unmarshal(reqBody, &reqdata)
-		fmt.Printf("%+v\n", reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, InsertPermInfoQuery)
-		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
-			return
-		}
 
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, reqdata.User_id, reqdata.Tbl_name, reqdata.Db_access_date, reqdata.Db_deny_date)
-		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
-			return
-		}
-		rows, err := res.RowsAffected()
+		reqBody, err := ioutil.ReadAll(context.Request.Body)
 		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows inserted ")
-		return
+		s.log.DebugContext(context.Request.Context(), "request body", "body", redactBody(reqBody))
+		var reqdata InsertPermInfoQueryRequest
+		json.Unmarshal(reqBody, &reqdata)
+		rows, err := s.insertPermInfo(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "inserted")
 	}
 }
 
@@ -248,34 +129,15 @@ func (s *Server) UpdateSecureDBAllow() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-		fmt.Printf(string(reqBody))
-		var reqdata UpdateSecureDBAllowRequest
-
-		json.Unmarshal(reqBody, &reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, UpdateSecureDBAllowQuery)
-		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
-			return
-		}
-
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, reqdata.Db_access_date, reqdata.User_id, reqdata.Tbl_name)
 		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-		rows, err := res.RowsAffected()
-		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
-			return
-		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows updated ")
-		return
+		s.log.DebugContext(context.Request.Context(), "request body", "body", redactBody(reqBody))
+		var reqdata UpdateSecureDBAllowRequest
+		json.Unmarshal(reqBody, &reqdata)
+		rows, err := s.updateDBAllow(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "updated")
 	}
 }
 
@@ -284,34 +146,15 @@ func (s *Server) UpdateSecureDBDeny() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-		fmt.Printf(string(reqBody))
-		var reqdata UpdateSecureDBDenyRequest
-
-		json.Unmarshal(reqBody, &reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, UpdateSecureDBDenyQuery)
-		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
-			return
-		}
-
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, reqdata.Db_deny_date, reqdata.User_id, reqdata.Tbl_name)
 		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-		rows, err := res.RowsAffected()
-		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
-			return
-		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows updated ")
-		return
+		s.log.DebugContext(context.Request.Context(), "request body", "body", redactBody(reqBody))
+		var reqdata UpdateSecureDBDenyRequest
+		json.Unmarshal(reqBody, &reqdata)
+		rows, err := s.updateDBDeny(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "updated")
 	}
 }
 
@@ -320,34 +163,15 @@ func (s *Server) UpdateUserAttrs() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-		fmt.Printf(string(reqBody))
-		var reqdata UpdateUserAttrsRequest
-
-		json.Unmarshal(reqBody, &reqdata)
-		ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-		defer cancelfunc()
-		stmt, err := s.conn.PrepareContext(ctx, UpdateSecureDBAllowQuery)
-		if err != nil {
-			fmt.Printf("Error %s when preparing SQL statement", err)
-			return
-		}
-
-		defer stmt.Close()
-		res, err := stmt.ExecContext(ctx, reqdata.User_id, reqdata.Password, reqdata.Attrs)
-		if err != nil {
-			fmt.Printf("Error %s when inserting row into products table", err)
-			return
-		}
-		rows, err := res.RowsAffected()
 		if err != nil {
-			fmt.Printf("Error %s when finding rows affected", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 			return
 		}
-
-		log.Printf("%d rows inserted ", rows)
-
-		context.String(http.StatusOK, string(rows)+" rows updated ")
-		return
+		s.log.DebugContext(context.Request.Context(), "request body", "body", redactBody(reqBody))
+		var reqdata UpdateUserAttrsRequest
+		json.Unmarshal(reqBody, &reqdata)
+		rows, err := s.updateUserAttrs(context.Request.Context(), reqdata)
+		writeRows(context, rows, err, "updated")
 	}
 }
 
@@ -356,199 +180,257 @@ func (s *Server) SendJWT() gin.HandlerFunc {
 		context.Header("Content-Type", "application/json")
 
 		reqBody, err := ioutil.ReadAll(context.Request.Body)
-		fmt.Printf(string(reqBody))
+		s.log.DebugContext(context.Request.Context(), "request body", "body", redactBody(reqBody))
 
 		if err != nil {
-			fmt.Printf("server: could not read request body: %s\n", err)
+			s.log.ErrorContext(context.Request.Context(), "could not read request body", "err", err)
 		}
 
 		var reqdata JWTRequest
 		json.Unmarshal(reqBody, &reqdata)
-		tokenString := reqdata.ClientMessage
-
-		testkey := "12345"
-		parts := strings.Split(tokenString, ".")
-		method := jwt.GetSigningMethod("HS256")
-		err2 := method.Verify(strings.Join(parts[0:2], "."), parts[2], []byte(testkey))
-		if err2 != nil {
-			fmt.Printf("Error while verifying key: %v", err2)
-		} else {
-			fmt.Println("Correct key")
-		}
-
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Don't forget to validate the alg is what you expect:
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(testkey), nil
-		})
-
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			fmt.Printf("%+v \n", claims)
-			// fmt.Println(claims["sub"])
-			s.accessDateUpdate(claims["user"].(string), claims["sub"].(string))
-			context.String(http.StatusOK, `{"server_message": "JWT received!"}`)
-		} else {
-			fmt.Println(err)
+		err = s.submitGrant(context.Request.Context(), reqdata.ClientMessage)
+		switch {
+		case errors.Is(err, errGrantRefused):
 			context.String(http.StatusBadRequest, `{"server_message": "wrong JWT signature!"}`)
+			return
+		case errors.Is(err, errNoGrantRow):
+			context.String(http.StatusConflict, `{"server_message": "no grant row for this table"}`)
+			return
+		case err != nil:
+			s.log.ErrorContext(context.Request.Context(), "unable to apply grant", "err", err)
+			context.String(http.StatusInternalServerError, `{"server_message": "database error"}`)
+			return
 		}
+		context.String(http.StatusOK, `{"server_message": "JWT received!"}`)
 	}
-
 }
 
-func (s *Server) accessDateUpdate(user_id string, dbauth string) { //given db_auth, do the proper update
-	//parse dbauth
-	list := strings.Split(dbauth, ",")
-	mp := make(map[string]string)
-	for _, line := range list {
-		kv := strings.Split(line, ":")
-		mp[kv[0]] = kv[1]
-	}
-	fmt.Println("%+v", mp)
-	//query date
-	for tbl, element := range mp {
-		res, err := s.conn.Query(FindAccessDateQuery, user_id, tbl)
-		fmt.Printf("query temp: %v, params: %v, %v\n", FindAccessDateQuery, user_id, tbl)
+var grantDays = regexp.MustCompile("[0-9]+")
 
-		if err != nil {
-			fmt.Printf("Unable to execute sql_query, template: %v, params: %v, %v, err: %v\n",
-				FindAccessDateQuery, user_id, tbl, err)
-			return
+// parseGrantSub splits a grant's sub claim, "table:rule,table:rule", into
+// rules by table. Every rule must say always or once, or carry a number of
+// days.
+func parseGrantSub(sub string) (map[string]string, error) {
+	mp := make(map[string]string)
+	for _, line := range strings.Split(sub, ",") {
+		tbl, rule, ok := strings.Cut(line, ":")
+		if !ok || tbl == "" {
+			return nil, fmt.Errorf("grant %q is not table:rule", line)
 		}
-
-		defer func(res *sql.Rows) {
-			err := res.Close()
-			if err != nil {
-				fmt.Printf("close res err: %v\n", err)
-			}
-		}(res)
-
-		var result DBAccess
-
-		if res.Next() {
-			if err := res.Scan(&result.User_id, &result.Table_name, &result.Db_access_date, &result.Db_deny_date); err != nil {
-				fmt.Printf("scan err: %v\n", err)
-				return
-			}
-		} else {
-			fmt.Printf("empty query result\n")
-			return
+		if !strings.Contains(rule, "always") && !strings.Contains(rule, "once") && !grantDays.MatchString(rule) {
+			return nil, fmt.Errorf("grant rule %q has no always, once or number of days", rule)
 		}
+		mp[tbl] = rule
+	}
+	return mp, nil
+}
 
-		fmt.Printf("db perm: %+v\n", result)
+// accessDateUpdate applies a verified grant's sub to user_id. The token's
+// jti is used up in the same transaction as the new dates, so a grant that
+// cannot be applied, for instance because a table has no db_access row,
+// leaves the token unused and changes nothing.
+func (s *Server) accessDateUpdate(reqctx sqlctx.Context, user_id string, dbauth string, jti string) error {
+	mp, err := parseGrantSub(dbauth)
+	if err != nil {
+		return err
+	}
+	s.log.DebugContext(reqctx, "parsed grant", "user_id", user_id, "grant", mp)
+	tables := make([]string, 0, len(mp))
+	for tbl := range mp {
+		tables = append(tables, tbl)
+	}
+	// rows are locked in the same order by every grant
+	sort.Strings(tables)
+
+	ctx, cancelfunc := sqlctx.WithTimeout(reqctx, queryTimeout)
+	defer cancelfunc()
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := s.useIssuedGrant(ctx, tx, jti); err != nil {
+		return err
+	}
 
-		//update date
+	var changes []grantChange
+	var once []Mapkey
+	for _, tbl := range tables {
+		element := mp[tbl]
 		days := 0
 		if strings.Contains(element, "always") {
 			days = 9999
-		} else if strings.Contains(element, "once") {
-			if strings.Contains(element, "allow") {
-				var mk = Mapkey{user_id, tbl}
-				s.allow_once[mk] = true
-			}
-			days = 0
-		} else {
-			re := regexp.MustCompile("[0-9]+")
-			days, err = strconv.Atoi(re.FindAllString(element, -1)[0])
+		} else if !strings.Contains(element, "once") {
+			days, err = strconv.Atoi(grantDays.FindString(element))
 			if err != nil {
 				days = 0
 			}
 		}
-		// fmt.Println(days)
-		if strings.Contains(element, "allow") {
-			newallowdate, _ := time.Parse("2006-01-02", result.Db_access_date)
-			newallowdate = time.Now().AddDate(0, 0, days)
-			fmt.Println("new allow date : %v", newallowdate.Format("2006-01-02"))
-			ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-			defer cancelfunc()
-			stmt, err := s.conn.PrepareContext(ctx, UpdateSecureDBAllowQuery)
-			if err != nil {
-				fmt.Printf("Error %s when preparing SQL statement", err)
-				return
-			}
-			defer stmt.Close()
+		allow := strings.Contains(element, "allow")
+		g, err := s.applyGrant(ctx, tx, user_id, tbl, allow, days)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, g)
+		if allow && strings.Contains(element, "once") {
+			once = append(once, Mapkey{user_id, tbl})
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
 
-			res, err := stmt.ExecContext(ctx, newallowdate.Format("2006-01-02"), &result.User_id, &result.Table_name)
-			if err != nil {
-				fmt.Printf("Error %s when inserting row into products table", err)
-				return
-			}
-			rows, err := res.RowsAffected()
-			if err != nil {
-				fmt.Printf("Error %s when finding rows affected", err)
-				return
-			}
+	for _, mk := range once {
+		s.allow_once[mk] = true
+	}
+	for _, g := range changes {
+		s.reportGrant(reqctx, g)
+	}
+	s.webhooks.notify()
+	return nil
+}
 
-			log.Printf("%d rows inserted ", rows)
-		} else {
-			newdenydate, _ := time.Parse("2006-01-02", result.Db_deny_date)
-			newdenydate = time.Now().AddDate(0, 0, days)
-			fmt.Println("new deny date : %v", newdenydate.Format("2006-01-02"))
-			ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), 5*time.Second)
-			defer cancelfunc()
-			stmt, err := s.conn.PrepareContext(ctx, UpdateSecureDBDenyQuery)
-			if err != nil {
-				fmt.Printf("Error %s when preparing SQL statement", err)
-				return
-			}
-			defer stmt.Close()
+// grantChange is a grant written by applyGrant, with the db_access row
+// before and after.
+type grantChange struct {
+	before, after DBAccess
+	allow         bool
+}
 
-			res, err := stmt.ExecContext(ctx, newdenydate.Format("2006-01-02"), &result.User_id, &result.Table_name)
-			if err != nil {
-				fmt.Printf("Error %s when inserting row into products table", err)
-				return
-			}
-			rows, err := res.RowsAffected()
-			if err != nil {
-				fmt.Printf("Error %s when finding rows affected", err)
-				return
-			}
+// applyGrant moves user_id's access date for tbl_name, or its deny date
+// when allow is false, to days from now within tx, and queues the webhook
+// event in the same transaction. It is how every grant reaches db_access,
+// whether it comes from a token or an approved access request. A user
+// without a row for the table is errNoGrantRow. Once tx commits, the caller
+// reports each change with reportGrant and calls s.webhooks.notify().
+func (s *Server) applyGrant(ctx sqlctx.Context, tx *sql.Tx, user_id string, tbl_name string, allow bool, days int) (grantChange, error) {
+	g := grantChange{allow: allow}
+	var deny sql.NullString
+	done := timeQuery(ctx, LockAccessDateQuery)
+	err := tx.QueryRowContext(ctx, LockAccessDateQuery, user_id, tbl_name).
+		Scan(&g.before.User_id, &g.before.Table_name, &g.before.Db_access_date, &deny)
+	done(err)
+	if errors.Is(err, sql.ErrNoRows) {
+		return g, fmt.Errorf("%w: %s", errNoGrantRow, tbl_name)
+	}
+	if err != nil {
+		return g, err
+	}
+	g.before.Db_deny_date = deny.String
+	g.after = g.before
+	date := time.Now().AddDate(0, 0, days).Format("2006-01-02")
+	query, event := UpdateSecureDBDenyQuery, WebhookGrantDeny
+	if allow {
+		query, event = UpdateSecureDBAllowQuery, WebhookGrantAllow
+		g.after.Db_access_date = date
+	} else {
+		g.after.Db_deny_date = date
+	}
+	done = timeQuery(ctx, query)
+	_, err = tx.ExecContext(ctx, query, date, user_id, tbl_name)
+	done(err)
+	if err != nil {
+		return g, err
+	}
+	err = s.queueWebhook(ctx, tx, WebhookEvent{Type: event, User_id: user_id, Table_name: tbl_name, Days: days,
+		Db_access_date: g.after.Db_access_date, Db_deny_date: g.after.Db_deny_date})
+	return g, err
+}
 
-			log.Printf("%d rows inserted ", rows)
-		}
+// reportGrant logs, audits and counts a committed grantChange.
+func (s *Server) reportGrant(ctx sqlctx.Context, g grantChange) {
+	user_id, tbl := g.after.User_id, g.after.Table_name
+	decision := DecisionDeny
+	if g.allow {
+		decision = DecisionAllow
+		s.log.InfoContext(ctx, "new allow date", "user_id", user_id, "table", tbl, "date", g.after.Db_access_date)
+	} else {
+		s.log.InfoContext(ctx, "new deny date", "user_id", user_id, "table", tbl, "date", g.after.Db_deny_date)
+	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditGrant, Target_type: "grant", Target_id: user_id + "/" + tbl,
+		Before: toAuditJSON(g.before), After: toAuditJSON(g.after), Decision: decision})
+	countDecision("update", g.allow)
+}
 
+// checkAuthServerPerm decides whether user_id may currently read tbl_name
+// and records the decision in the audit log. A decision forced by fault
+// injection is returned without being recorded, so it reaches neither the
+// audit log nor the decision metrics.
+func (s *Server) checkAuthServerPerm(ctx sqlctx.Context, user_id string, tbl_name string) bool {
+	if allowed, ok := faultinject.Decision(ctx); ok {
+		s.log.DebugContext(ctx, "fault injection: forced decision", "user_id", user_id, "table", tbl_name, "allowed", allowed)
+		return allowed
+	}
+	allowed := s.decideAuthServerPerm(ctx, user_id, tbl_name)
+	decision := DecisionDeny
+	if allowed {
+		decision = DecisionAllow
 	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditCheck, Target_type: "grant", Target_id: user_id + "/" + tbl_name,
+		After: toAuditJSON(accessCheck{User_id: user_id, Table_name: tbl_name}), Decision: decision})
+	countDecision("check", allowed)
+	return allowed
 }
 
-func (s *Server) checkAuthServerPerm(user_id string, tbl_name string) bool {
+func (s *Server) decideAuthServerPerm(ctx sqlctx.Context, user_id string, tbl_name string) bool {
 	var mk = Mapkey{user_id, tbl_name}
 	if s.allow_once[mk] == true {
 		delete(s.allow_once, mk)
 		return true
 	}
-	res, err := s.conn.Query(FindAccessDateQuery, user_id, tbl_name)
-	fmt.Printf("query temp: %v, params: %v, %v\n", FindAccessDateQuery, user_id, tbl_name)
+	allowed, denied := s.directGrant(ctx, user_id, tbl_name)
+	if allowed {
+		return true
+	}
+	if denied {
+		// a deny on the user's own row also holds against their roles
+		s.log.DebugContext(ctx, "role grants overridden by deny date", "user_id", user_id, "table", tbl_name)
+		return false
+	}
+	role, ok := s.roleGrantAllows(ctx, user_id, tbl_name)
+	if ok {
+		s.log.DebugContext(ctx, "allowed through role", "user_id", user_id, "table", tbl_name, "role", role)
+	}
+	return ok
+}
+
+// directGrant reports whether the access date of the user's own db_access
+// row for the table is still ahead, and whether its deny date is. A row that
+// cannot be read counts as denied, so that roles do not stand in for it.
+func (s *Server) directGrant(ctx sqlctx.Context, user_id string, tbl_name string) (allowed bool, denied bool) {
+	done := timeQuery(ctx, FindAccessDateQuery)
+	res, err := s.conn.QueryContext(ctx, FindAccessDateQuery, user_id, tbl_name)
+	done(err)
+	s.log.DebugContext(ctx, "sql query", "query", FindAccessDateQuery, "params", []interface{}{user_id, tbl_name})
 
 	if err != nil {
-		fmt.Printf("Unable to execute sql_query, template: %v, params: %v, %v, err: %v\n",
-			FindAccessDateQuery, user_id, tbl_name, err)
-		return false
+		s.log.ErrorContext(ctx, "unable to execute sql query", "query", FindAccessDateQuery, "params", []interface{}{user_id, tbl_name}, "err", err)
+		return false, true
 	}
 
 	defer func(res *sql.Rows) {
 		err := res.Close()
 		if err != nil {
-			fmt.Printf("close res err: %v\n", err)
+			s.log.WarnContext(ctx, "close rows failed", "err", err)
 		}
 	}(res)
 
 	var result DBAccess
+	var deny sql.NullString
 
 	if res.Next() {
-		if err := res.Scan(&result.User_id, &result.Table_name, &result.Db_access_date, &result.Db_deny_date); err != nil {
-			fmt.Printf("scan err: %v\n", err)
-			return false
+		if err := res.Scan(&result.User_id, &result.Table_name, &result.Db_access_date, &deny); err != nil {
+			s.log.ErrorContext(ctx, "scan failed", "err", err)
+			return false, true
 		}
 	} else {
-		fmt.Printf("empty query result\n")
-		return false
+		s.log.DebugContext(ctx, "empty query result")
+		return false, false
 	}
 
-	fmt.Printf("actions: %+v\n", result)
+	result.Db_deny_date = deny.String
+	s.log.DebugContext(ctx, "query result", "result", redact(result))
 	allowdate, _ := time.Parse("2006-01-02", result.Db_access_date)
-	if time.Now().Before(allowdate) {
-		return true
-	}
-	return false
+	denydate, err := time.Parse("2006-01-02", result.Db_deny_date)
+	return time.Now().Before(allowdate), err == nil && time.Now().Before(denydate)
 }
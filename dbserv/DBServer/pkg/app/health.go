@@ -0,0 +1,199 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Version is stamped at build time:
+//
+//	go build -ldflags "-X RemoteTestServer/pkg/app.Version=1.2.3" ./cmd/server
+var Version = "dev"
+
+const (
+	checkOK       = "ok"
+	readyTimeout  = 2 * time.Second
+	warmupBackoff = 5 * time.Second
+)
+
+// Warm loads the policy repository into the policy cache so that the first
+// lookups do not pay for it. /readyz reports not ready until it has succeeded once.
+func (s *Server) Warm(ctx sqlctx.Context) error {
+	done := timeQuery(ctx, ListPolicyQuery)
+	res, err := s.conn.QueryContext(ctx, ListPolicyQuery)
+	done(err)
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+	var count int64
+	for res.Next() {
+		var p Policy
+		if err := res.Scan(&p.Ref, &p.Content); err != nil {
+			return err
+		}
+		s.policyCache.put(p.Ref, p)
+		count++
+	}
+	if err := res.Err(); err != nil {
+		return err
+	}
+	s.policies.Store(count)
+	s.warmed.Store(true)
+	s.log.InfoContext(ctx, "policy cache warmed", "policies", count)
+	return nil
+}
+
+// warmUntilReady retries Warm until it succeeds or ctx is done, so a server
+// started before its database still becomes ready on its own.
+func (s *Server) warmUntilReady(ctx sqlctx.Context) {
+	for {
+		err := s.Warm(ctx)
+		if err == nil {
+			return
+		}
+		s.log.WarnContext(ctx, "policy cache warm-up failed, retrying", "err", err, "in", warmupBackoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(warmupBackoff):
+		}
+	}
+}
+
+// Healthz answers as long as the process is serving HTTP.
+func (s *Server) Healthz() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		context.Header("Content-Type", "application/json")
+		context.String(http.StatusOK, `{"status": "ok"}`)
+	}
+}
+
+// Readyz reports whether the server can take traffic: the database answers,
+// every migration is applied and the policy cache is warm. Each check is
+// reported by name; any failure makes the response a 503.
+func (s *Server) Readyz() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		context.Header("Content-Type", "application/json")
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), readyTimeout)
+		defer cancelfunc()
+
+		report := ReadyReport{Status: checkOK, Checks: make(map[string]string)}
+		fail := func(check string, reason string) {
+			report.Status = "unavailable"
+			report.Checks[check] = reason
+		}
+
+		if err := s.conn.PingContext(ctx); err != nil {
+			fail("database", err.Error())
+		} else {
+			report.Checks["database"] = checkOK
+		}
+
+		if version, err := SchemaVersion(ctx, s.conn); err != nil {
+			fail("migrations", err.Error())
+		} else if version < LatestSchemaVersion() {
+			fail("migrations", "schema version "+strconv.Itoa(version)+", want "+strconv.Itoa(LatestSchemaVersion()))
+		} else {
+			report.Checks["migrations"] = checkOK
+		}
+
+		if s.warmed.Load() {
+			report.Checks["policy_cache"] = checkOK
+		} else {
+			fail("policy_cache", "not warmed yet")
+		}
+
+		ret, err := json.Marshal(report)
+		if err != nil {
+			s.log.ErrorContext(context.Request.Context(), "marshal ready report", "err", err)
+			return
+		}
+		status := http.StatusOK
+		if report.Status != checkOK {
+			status = http.StatusServiceUnavailable
+		}
+		context.String(status, string(ret))
+	}
+}
+
+// DebugStatus serves /debug/status: build, uptime, the non-secret parts of
+// the configuration and connection pool statistics.
+func (s *Server) DebugStatus() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		context.Header("Content-Type", "application/json")
+
+		report := StatusReport{
+			Version:        Version,
+			Go_version:     runtime.Version(),
+			Started_at:     s.started.UTC().Format(time.RFC3339),
+			Uptime_seconds: int64(time.Since(s.started).Seconds()),
+			Policies:       int(s.policies.Load()),
+			Config:         s.configSummary(),
+			Pool:           poolStats(s.conn.Stats()),
+		}
+		if info, ok := debug.ReadBuildInfo(); ok {
+			for _, setting := range info.Settings {
+				if setting.Key == "vcs.revision" {
+					report.Revision = setting.Value
+				}
+			}
+		}
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), readyTimeout)
+		defer cancelfunc()
+		if version, err := SchemaVersion(ctx, s.conn); err == nil {
+			report.Schema_version = version
+		}
+
+		ret, err := json.Marshal(report)
+		if err != nil {
+			s.log.ErrorContext(context.Request.Context(), "marshal status report", "err", err)
+			return
+		}
+		context.String(http.StatusOK, string(ret))
+	}
+}
+
+// configSummary lists settings without exposing file contents or keys.
+func (s *Server) configSummary() map[string]string {
+	return map[string]string{
+		"listen_addr":               listenAddr,
+		"grpc_addr":                 s.cfg.GRPCAddr,
+		"shutdown_timeout":          s.cfg.ShutdownTimeout.String(),
+		"fault_rules":               strconv.Itoa(s.faults.Len()),
+		"cache_ttl":                 s.cfg.CacheTTL.String(),
+		"cache_size":                strconv.Itoa(s.cfg.CacheSize),
+		"audit_file":                s.cfg.AuditFile,
+		"audit_signing":             strconv.FormatBool(s.cfg.AuditKeyFile != ""),
+		"audit_checkpoint_interval": s.cfg.AuditCheckpointInterval.String(),
+		"webhooks":                  strconv.Itoa(len(s.webhooks.order)),
+		"jwt_key_file":              s.cfg.JWTKeyFile,
+		"grant_issuers":             strconv.Itoa(len(s.issuers)),
+		"admin_tokens":              strconv.Itoa(len(s.admins)),
+		"sweep_interval":            s.cfg.SweepInterval.String(),
+		"expiry_window_days":        strconv.Itoa(s.cfg.ExpiryWindowDays),
+		"archive_after_days":        strconv.Itoa(s.cfg.ArchiveAfterDays),
+		"opa":                       s.cfg.OPABinary,
+	}
+}
+
+func poolStats(st sql.DBStats) PoolStats {
+	return PoolStats{
+		Max_open:            st.MaxOpenConnections,
+		Open:                st.OpenConnections,
+		In_use:              st.InUse,
+		Idle:                st.Idle,
+		Wait_count:          st.WaitCount,
+		Wait_seconds:        st.WaitDuration.Seconds(),
+		Max_idle_closed:     st.MaxIdleClosed,
+		Max_lifetime_closed: st.MaxLifetimeClosed,
+	}
+}
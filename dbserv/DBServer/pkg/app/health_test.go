@@ -0,0 +1,62 @@
+package app
+
+import (
+	sqlctx "context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+func readyz(t *testing.T, r *gin.Engine) (int, ReadyReport) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var report ReadyReport
+	if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+		t.Fatalf("readyz body %q: %v", w.Body.String(), err)
+	}
+	return w.Code, report
+}
+
+func TestReadyz(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, mock := newTestServer(t)
+	s.policyCache = newTTLCache("policy", defaultCacheTTL, 10)
+	r := gin.New()
+	r.GET("/healthz", s.Healthz())
+	r.GET("/readyz", s.Readyz())
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("healthz: status %d", w.Code)
+	}
+
+	// an old schema and a cold cache each keep the server out of rotation
+	mock.ExpectQuery(regexp.QuoteMeta(FindSchemaVersionQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(LatestSchemaVersion() - 1))
+	code, report := readyz(t, r)
+	if code != http.StatusServiceUnavailable || report.Checks["database"] != checkOK ||
+		report.Checks["migrations"] == checkOK || report.Checks["policy_cache"] == checkOK {
+		t.Fatalf("before migrating and warming: %d %+v", code, report)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(ListPolicyQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"ref", "content"}).AddRow("p1", "package p1"))
+	if err := s.Warm(sqlctx.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.policyCache.get("p1"); !ok || s.policies.Load() != 1 {
+		t.Fatal("Warm did not fill the policy cache")
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(FindSchemaVersionQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(LatestSchemaVersion()))
+	if code, report := readyz(t, r); code != http.StatusOK || report.Status != checkOK {
+		t.Fatalf("once migrated and warm: %d %+v", code, report)
+	}
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// newTestServer returns a Server over a sqlmock database. Expectations are
+// checked when the test ends.
+func newTestServer(t *testing.T) (*Server, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+		db.Close()
+	})
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := &Server{conn: db, allow_once: make(map[Mapkey]bool), log: logger, audit: &Auditor{conn: db, log: logger}}
+	return s, mock
+}
+
+// expectAudit expects one audit record by actor for action to be chained
+// onto the log.
+func expectAudit(mock sqlmock.Sqlmock, actor string, action string) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(LockAuditHeadQuery)).WillReturnRows(sqlmock.NewRows([]string{"hash"}).AddRow(""))
+	mock.ExpectExec(regexp.QuoteMeta(InsertAuditLogQuery)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), actor, action, sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(MoveAuditHeadQuery)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+}
+
+// withAdmin gives s an admin named name whose bearer token is token.
+func withAdmin(s *Server, name string, token string) {
+	sum := sha256.Sum256([]byte(token))
+	s.admins = append(s.admins, AdminToken{Name: name, Token_sha256: hex.EncodeToString(sum[:])})
+}
@@ -0,0 +1,506 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+var attrKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// listSpec describes one listable table. query is the base SELECT from
+// typedef.go; columns must match its select list. keys is the primary key,
+// appended to every ORDER BY so that cursors are stable. order is the
+// default direction, asc when empty. entity names the audit target type.
+type listSpec struct {
+	entity   string
+	query    string
+	columns  []string
+	keys     []string
+	sortable []string
+	// nullable are the sortable columns that may be NULL. They are sorted
+	// and paged as COALESCE(col, ''), since a NULL never compares greater
+	// or less than a cursor and its rows would be skipped.
+	nullable []string
+	order    string
+	build    func(row map[string]string) interface{}
+	// filter turns the endpoint's own query parameters into conditions.
+	filter func(q listQuery, f *listFilter) error
+}
+
+// listQuery looks up one query parameter: gin's GetQuery over HTTP, the
+// filters map over gRPC.
+type listQuery func(name string) (string, bool)
+
+func (q listQuery) get(name string) string {
+	v, _ := q(name)
+	return v
+}
+
+// listParams are the paging and sorting parameters every list takes. Zero
+// values select the defaults.
+type listParams struct {
+	limit  int
+	sort   string
+	order  string
+	cursor string
+}
+
+// listError is a mistake in the caller's parameters, answered with a 400.
+type listError struct {
+	msg string
+}
+
+func (e listError) Error() string {
+	return e.msg
+}
+
+type listFilter struct {
+	conds []string
+	args  []interface{}
+}
+
+func (f *listFilter) add(cond string, args ...interface{}) {
+	f.conds = append(f.conds, cond)
+	f.args = append(f.args, args...)
+}
+
+// listCursor is handed out as next_cursor. It remembers the sort it was
+// created with so a caller cannot page with one order and resume with another.
+type listCursor struct {
+	Sort   string   `json:"s"`
+	Order  string   `json:"o"`
+	Values []string `json:"v"`
+}
+
+func encodeCursor(c listCursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(s string) (listCursor, error) {
+	var c listCursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, errors.New("malformed cursor")
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, errors.New("malformed cursor")
+	}
+	return c, nil
+}
+
+func (spec listSpec) orderColumns(sort string) []string {
+	cols := []string{sort}
+	for _, k := range spec.keys {
+		if k != sort {
+			cols = append(cols, k)
+		}
+	}
+	return cols
+}
+
+// sortExpr is what col is sorted and compared by.
+func (spec listSpec) sortExpr(col string) string {
+	for _, c := range spec.nullable {
+		if c == col {
+			return "COALESCE(" + col + ", '')"
+		}
+	}
+	return col
+}
+
+func (spec listSpec) canSort(col string) bool {
+	for _, c := range spec.sortable {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
+
+// likePrefix escapes LIKE wildcards so user input only ever matches literally.
+func likePrefix(p string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return r.Replace(p) + "%"
+}
+
+// queryListParams reads the paging parameters shared by every list route.
+func queryListParams(context *gin.Context) (listParams, error) {
+	var p listParams
+	if l := context.Query("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n <= 0 {
+			return p, listError{"limit must be a positive integer"}
+		}
+		p.limit = n
+	}
+	p.sort = context.Query("sort")
+	p.order = context.Query("order")
+	p.cursor = context.Query("cursor")
+	return p, nil
+}
+
+func (s *Server) list(context *gin.Context, spec listSpec) {
+	context.Header("Content-Type", "application/json")
+
+	p, err := queryListParams(context)
+	var result ListResult
+	if err == nil {
+		result, err = s.runList(context.Request.Context(), spec, p, context.GetQuery)
+	}
+	var lerr listError
+	if errors.As(err, &lerr) {
+		context.String(http.StatusBadRequest, lerr.msg)
+		return
+	}
+	if err != nil {
+		context.JSON(http.StatusBadRequest, nil)
+		return
+	}
+
+	s.audit.Record(context.Request.Context(), AuditRecord{Action: AuditList, Target_type: spec.entity, Target_id: context.Request.URL.RawQuery})
+
+	ret, err := json.Marshal(result)
+	if err != nil {
+		s.log.ErrorContext(context.Request.Context(), "marshal list result", "err", err)
+		return
+	}
+	context.String(http.StatusOK, string(ret))
+}
+
+// runList fetches one page of spec. Parameter problems come back as
+// listError; recording the read in the audit log is left to the caller.
+func (s *Server) runList(ctx sqlctx.Context, spec listSpec, p listParams, q listQuery) (ListResult, error) {
+	var result ListResult
+
+	limit := p.limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	sort := p.sort
+	if sort == "" {
+		sort = spec.keys[0]
+	}
+	if !spec.canSort(sort) {
+		return result, listError{fmt.Sprintf("cannot sort by %q", sort)}
+	}
+	order := strings.ToLower(p.order)
+	if order == "" {
+		order = spec.order
+	}
+	if order == "" {
+		order = "asc"
+	}
+	if order != "asc" && order != "desc" {
+		return result, listError{"order must be asc or desc"}
+	}
+
+	var f listFilter
+	if spec.filter != nil {
+		if err := spec.filter(q, &f); err != nil {
+			return result, listError{err.Error()}
+		}
+	}
+
+	orderCols := spec.orderColumns(sort)
+	if p.cursor != "" {
+		cur, err := decodeCursor(p.cursor)
+		if err != nil {
+			return result, listError{err.Error()}
+		}
+		if cur.Sort != sort || cur.Order != order || len(cur.Values) != len(orderCols) {
+			return result, listError{"cursor does not match sort and order"}
+		}
+		cmp := ">"
+		if order == "desc" {
+			cmp = "<"
+		}
+		marks := strings.TrimSuffix(strings.Repeat("?, ", len(orderCols)), ", ")
+		exprs := make([]string, len(orderCols))
+		for i, c := range orderCols {
+			exprs[i] = spec.sortExpr(c)
+		}
+		cond := fmt.Sprintf("(%s) %s (%s)", strings.Join(exprs, ", "), cmp, marks)
+		args := make([]interface{}, len(cur.Values))
+		for i, v := range cur.Values {
+			args[i] = v
+		}
+		f.add(cond, args...)
+	}
+
+	query := spec.query
+	if len(f.conds) > 0 {
+		query += " WHERE " + strings.Join(f.conds, " AND ")
+	}
+	terms := make([]string, len(orderCols))
+	for i, c := range orderCols {
+		terms[i] = spec.sortExpr(c) + " " + strings.ToUpper(order)
+	}
+	query += " ORDER BY " + strings.Join(terms, ", ")
+	// fetch one extra row to learn whether another page exists
+	query += " LIMIT " + strconv.Itoa(limit+1)
+
+	// the statement is labelled with the base query constant it extends
+	done := timeQuery(ctx, spec.query)
+	res, err := s.conn.QueryContext(ctx, query, f.args...)
+	done(err)
+	s.log.DebugContext(ctx, "list query", "query", query, "params", f.args)
+
+	if err != nil {
+		s.log.ErrorContext(ctx, "unable to execute sql query",
+			"query", query, "params", f.args, "err", err)
+		return result, err
+	}
+
+	defer func(res *sql.Rows) {
+		err := res.Close()
+		if err != nil {
+			s.log.ErrorContext(ctx, "close rows", "err", err)
+		}
+	}(res)
+
+	items := make([]interface{}, 0, limit)
+	var last map[string]string
+	more := false
+	for res.Next() {
+		if len(items) == limit {
+			more = true
+			break
+		}
+		vals := make([]sql.NullString, len(spec.columns))
+		ptrs := make([]interface{}, len(vals))
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+		if err := res.Scan(ptrs...); err != nil {
+			s.log.ErrorContext(ctx, "scan row", "err", err)
+			return result, err
+		}
+		row := make(map[string]string, len(spec.columns))
+		for i, c := range spec.columns {
+			row[c] = vals[i].String
+		}
+		items = append(items, spec.build(row))
+		last = row
+	}
+	if err := res.Err(); err != nil {
+		return result, err
+	}
+
+	result.Items = items
+	if more {
+		cur := listCursor{Sort: sort, Order: order}
+		for _, c := range orderCols {
+			cur.Values = append(cur.Values, last[c])
+		}
+		result.Next_cursor = encodeCursor(cur)
+	}
+	return result, nil
+}
+
+func parseDateParam(name string, v string) (string, error) {
+	if _, err := time.Parse("2006-01-02", v); err != nil {
+		return "", fmt.Errorf("%s must be a date in YYYY-MM-DD form", name)
+	}
+	return v, nil
+}
+
+// userList lists users. Passwords are never returned. Filters: prefix
+// (user_id), attr_key and optionally attr_value, matched against the JSON
+// stored in attrs.
+var userList = listSpec{
+	entity:   "user",
+	query:    ListUserAttrsQuery,
+	columns:  []string{"user_id", "attrs"},
+	keys:     []string{"user_id"},
+	sortable: []string{"user_id"},
+	build: func(row map[string]string) interface{} {
+		return UserAttrs{User_id: row["user_id"], Attrs: row["attrs"]}
+	},
+	filter: func(q listQuery, f *listFilter) error {
+		if p := q.get("prefix"); p != "" {
+			f.add("user_id LIKE ?", likePrefix(p))
+		}
+		key := q.get("attr_key")
+		value, hasValue := q("attr_value")
+		if key == "" {
+			if hasValue {
+				return errors.New("attr_value requires attr_key")
+			}
+			return nil
+		}
+		if !attrKeyPattern.MatchString(key) {
+			return fmt.Errorf("invalid attr_key %q", key)
+		}
+		path := `$."` + key + `"`
+		if hasValue {
+			f.add("JSON_VALID(attrs) AND JSON_UNQUOTE(JSON_EXTRACT(attrs, ?)) = ?", path, value)
+		} else {
+			f.add("JSON_VALID(attrs) AND JSON_CONTAINS_PATH(attrs, 'one', ?)", path)
+		}
+		return nil
+	},
+}
+
+// deviceList lists devices without their tokens. Filters: prefix (dev_id),
+// dev_type.
+var deviceList = listSpec{
+	entity:   "device",
+	query:    ListDevInfoQuery,
+	columns:  []string{"dev_id", "dev_type", "actions", "attrs"},
+	keys:     []string{"dev_id"},
+	sortable: []string{"dev_id", "dev_type"},
+	nullable: []string{"dev_type"},
+	build: func(row map[string]string) interface{} {
+		return DevInfo{Dev_id: row["dev_id"], Dev_type: row["dev_type"], Actions: row["actions"], Attrs: row["attrs"]}
+	},
+	filter: func(q listQuery, f *listFilter) error {
+		if p := q.get("prefix"); p != "" {
+			f.add("dev_id LIKE ?", likePrefix(p))
+		}
+		if t := q.get("dev_type"); t != "" {
+			f.add("dev_type = ?", t)
+		}
+		return nil
+	},
+}
+
+// policyList lists policies. Filters: prefix (ref).
+var policyList = listSpec{
+	entity:   "policy",
+	query:    ListPolicyQuery,
+	columns:  []string{"ref", "content"},
+	keys:     []string{"ref"},
+	sortable: []string{"ref"},
+	build: func(row map[string]string) interface{} {
+		return Policy{Ref: row["ref"], Content: row["content"]}
+	},
+	filter: func(q listQuery, f *listFilter) error {
+		if p := q.get("prefix"); p != "" {
+			f.add("ref LIKE ?", likePrefix(p))
+		}
+		return nil
+	},
+}
+
+// hierarchyList lists object-action hierarchies. Filters: obj_id, action.
+var hierarchyList = listSpec{
+	entity:   "hierarchy",
+	query:    ListHierarchyQuery,
+	columns:  []string{"obj_id", "action", "hierarchy"},
+	keys:     []string{"obj_id", "action"},
+	sortable: []string{"obj_id", "action"},
+	build: func(row map[string]string) interface{} {
+		return Hierarchy{Obj_id: row["obj_id"], Action: row["action"], Hierarchy: row["hierarchy"]}
+	},
+	filter: func(q listQuery, f *listFilter) error {
+		if o := q.get("obj_id"); o != "" {
+			f.add("obj_id = ?", o)
+		}
+		if a := q.get("action"); a != "" {
+			f.add("action = ?", a)
+		}
+		return nil
+	},
+}
+
+var accessDateFilters = []struct {
+	param string
+	cond  string
+}{
+	{"expires_before", "db_access_date < ?"},
+	{"expires_after", "db_access_date > ?"},
+	{"denied_before", "db_deny_date < ?"},
+	{"denied_after", "db_deny_date > ?"},
+}
+
+// accessList lists db_access grants. Filters: user_id, table_name,
+// expires_before and expires_after (compared with db_access_date),
+// denied_before and denied_after (compared with db_deny_date).
+var accessList = listSpec{
+	entity:   "grant",
+	query:    ListAccessDateQuery,
+	columns:  []string{"user_id", "tbl_name", "db_access_date", "db_deny_date"},
+	keys:     []string{"user_id", "tbl_name"},
+	sortable: []string{"user_id", "tbl_name", "db_access_date", "db_deny_date"},
+	nullable: []string{"db_deny_date"},
+	build: func(row map[string]string) interface{} {
+		return DBAccess{User_id: row["user_id"], Table_name: row["tbl_name"],
+			Db_access_date: row["db_access_date"], Db_deny_date: row["db_deny_date"]}
+	},
+	filter: func(q listQuery, f *listFilter) error {
+		if u := q.get("user_id"); u != "" {
+			f.add("user_id = ?", u)
+		}
+		if t := q.get("table_name"); t != "" {
+			f.add("tbl_name = ?", t)
+		}
+		for _, df := range accessDateFilters {
+			v := q.get(df.param)
+			if v == "" {
+				continue
+			}
+			d, err := parseDateParam(df.param, v)
+			if err != nil {
+				return err
+			}
+			f.add(df.cond, d)
+		}
+		return nil
+	},
+}
+
+// ListUserAttrs serves /list_users (see userList).
+func (s *Server) ListUserAttrs() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		s.list(context, userList)
+	}
+}
+
+// ListDevInfo serves /list_devices (see deviceList).
+func (s *Server) ListDevInfo() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		s.list(context, deviceList)
+	}
+}
+
+// ListPolicy serves /list_policies (see policyList).
+func (s *Server) ListPolicy() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		s.list(context, policyList)
+	}
+}
+
+// ListHierarchy serves /list_hierarchies (see hierarchyList).
+func (s *Server) ListHierarchy() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		s.list(context, hierarchyList)
+	}
+}
+
+// ListDBAccess serves /list_db_access (see accessList).
+func (s *Server) ListDBAccess() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		s.list(context, accessList)
+	}
+}
@@ -0,0 +1,148 @@
+package app
+
+import (
+	sqlctx "context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeys are blanked out wherever they appear: as log attributes, as
+// JSON keys in logged bodies and in audit before/after values.
+var sensitiveKeys = map[string]bool{
+	"pwd":      true,
+	"password": true,
+	"token":    true,
+	"secret":   true,
+	// the signed grant token posted to /jwt
+	"client_message": true,
+}
+
+func isSensitive(key string) bool {
+	return sensitiveKeys[strings.ToLower(key)]
+}
+
+// NewLogger builds the server logger. format is "json" or "text", level one
+// of debug, info, warn or error. Every record logged with a request context
+// carries that request's id.
+func NewLogger(w io.Writer, format string, level string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("log level %q: %v", level, err)
+	}
+	opts := &slog.HandlerOptions{
+		Level: lvl,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if isSensitive(a.Key) {
+				return slog.String(a.Key, redactedValue)
+			}
+			return a
+		},
+	}
+	var h slog.Handler
+	switch format {
+	case "json":
+		h = slog.NewJSONHandler(w, opts)
+	case "text", "":
+		h = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q, expected json or text", format)
+	}
+	return slog.New(requestIDHandler{h}), nil
+}
+
+// requestIDHandler adds the request id stored by RequestContext and, when
+// tracing is on, the trace id.
+type requestIDHandler struct {
+	slog.Handler
+}
+
+func (h requestIDHandler) Handle(ctx sqlctx.Context, r slog.Record) error {
+	if id := metaFrom(ctx).requestID; id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h requestIDHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return requestIDHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h requestIDHandler) WithGroup(name string) slog.Handler {
+	return requestIDHandler{h.Handler.WithGroup(name)}
+}
+
+// redact returns v in a form that is safe to log: structs and maps are
+// turned into JSON objects with sensitive keys replaced, at any depth.
+func redact(v interface{}) interface{} {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return v
+	}
+	return redactValue(generic)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, inner := range t {
+			if isSensitive(k) {
+				t[k] = redactedValue
+			} else {
+				t[k] = redactValue(inner)
+			}
+		}
+		return t
+	case []interface{}:
+		for i := range t {
+			t[i] = redactValue(t[i])
+		}
+		return t
+	}
+	return v
+}
+
+// redactBody is redact for raw request bodies, which are not always JSON.
+func redactBody(body []byte) interface{} {
+	var generic interface{}
+	if err := json.Unmarshal(body, &generic); err != nil {
+		return fmt.Sprintf("[%d bytes, not JSON]", len(body))
+	}
+	return redactValue(generic)
+}
+
+// AccessLog replaces gin's default logger with one structured line per
+// request.
+func (s *Server) AccessLog() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		start := time.Now()
+		context.Next()
+		level := slog.LevelInfo
+		if context.Writer.Status() >= 500 {
+			level = slog.LevelError
+		}
+		s.log.Log(context.Request.Context(), level, "request",
+			"method", context.Request.Method,
+			"path", context.Request.URL.Path,
+			"route", context.FullPath(),
+			"status", context.Writer.Status(),
+			"latency_ms", time.Since(start).Milliseconds(),
+			"client_ip", context.ClientIP(),
+			"actor", metaFrom(context.Request.Context()).actor)
+	}
+}
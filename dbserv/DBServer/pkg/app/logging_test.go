@@ -0,0 +1,52 @@
+package app
+
+import (
+	"bytes"
+	sqlctx "context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNewLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := NewLogger(&buf, "json", "info")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := sqlctx.WithValue(sqlctx.Background(), requestMetaKey{}, requestMeta{requestID: "req-1"})
+	logger.DebugContext(ctx, "hidden")
+	logger.InfoContext(ctx, "login", "user_id", "alice", "Password", "hunter2")
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("want exactly one JSON line, got %q: %v", buf.String(), err)
+	}
+	if line["msg"] != "login" || line["request_id"] != "req-1" || line["user_id"] != "alice" || line["Password"] != redactedValue {
+		t.Fatalf("log line = %v", line)
+	}
+
+	if _, err := NewLogger(&buf, "xml", "info"); err == nil {
+		t.Error("an unknown format was accepted")
+	}
+	if _, err := NewLogger(&buf, "text", "loud"); err == nil {
+		t.Error("an unknown level was accepted")
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	body := `{"user_id": "alice", "pwd": "hunter2", "client_message": "eyJhbGciOi", "devices": [{"token": "t1", "dev_id": "d1"}]}`
+	raw, _ := json.Marshal(redactBody([]byte(body)))
+	got := string(raw)
+	for _, secret := range []string{"hunter2", "eyJhbGciOi", "t1"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("redactBody kept %q: %s", secret, got)
+		}
+	}
+	if !strings.Contains(got, `"dev_id":"d1"`) || !strings.Contains(got, `"user_id":"alice"`) {
+		t.Errorf("redactBody dropped plain fields: %s", got)
+	}
+	if got := redactBody([]byte("user=alice&pwd=x")); got != "[16 bytes, not JSON]" {
+		t.Errorf("redactBody(form) = %v", got)
+	}
+}
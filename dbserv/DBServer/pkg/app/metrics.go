@@ -0,0 +1,356 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "abac"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by route, method and status code.",
+	}, []string{"route", "method", "status"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by route and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method"})
+
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "grpc_requests_total",
+		Help:      "gRPC calls by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "gRPC call latency by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	sqlDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "sql_query_duration_seconds",
+		Help:      "SQL statement latency by query constant.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"query"})
+
+	sqlErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "sql_query_errors_total",
+		Help:      "Failed SQL statements by query constant. sql.ErrNoRows is not counted.",
+	}, []string{"query"})
+
+	grantDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "grant_decisions_total",
+		Help:      "Allow/deny outcomes of permission checks (source=check) and JWT grant updates (source=update).",
+	}, []string{"source", "decision"})
+
+	cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "cache_requests_total",
+		Help:      "Cache lookups by cache and result (HIT, MISS, BYPASS).",
+	}, []string{"cache", "result"})
+
+	cacheEntries = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "cache_entries",
+		Help:      "Entries currently held by each cache.",
+	}, []string{"cache"})
+
+	cacheEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "cache_evictions_total",
+		Help:      "Entries evicted because a cache was full.",
+	}, []string{"cache"})
+
+	faultsInjected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "faults_injected_total",
+		Help:      "Faults added by the fault injection middleware by route and kind (latency, error, decision).",
+	}, []string{"route", "kind"})
+
+	webhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "webhook_deliveries_total",
+		Help:      "Webhook delivery attempts by endpoint and result (delivered, retry, failed).",
+	}, []string{"webhook", "result"})
+
+	webhookDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "webhook_delivery_duration_seconds",
+		Help:      "Time taken by webhook endpoints to answer a delivery.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"webhook"})
+
+	grantTokensIssued = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "grant_tokens_issued_total",
+		Help:      "Grant token requests by issuer and result (issued, forbidden, unauthenticated).",
+	}, []string{"issuer", "result"})
+
+	accessRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "access_requests_total",
+		Help:      "Access request transitions by action (submitted, approved, rejected).",
+	}, []string{"action"})
+
+	sweeperRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "sweeper_runs_total",
+		Help:      "Grant expiry sweeps by result (ok, error).",
+	}, []string{"result"})
+
+	sweeperDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "sweeper_run_duration_seconds",
+		Help:      "Time taken by one grant expiry sweep.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	sweeperGrants = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "sweeper_grants_total",
+		Help:      "Grants handled by the expiry sweeper by action (notified, archived).",
+	}, []string{"action"})
+
+	sweeperLastSuccess = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "sweeper_last_success_timestamp_seconds",
+		Help:      "Unix time the last grant expiry sweep finished without error.",
+	})
+
+	opaRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "opa_runs_total",
+		Help:      "Runs of the opa binary by result (ok, error).",
+	}, []string{"result"})
+
+	opaDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "opa_run_duration_seconds",
+		Help:      "Time taken by one run of the opa binary.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	simulations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "simulated_decisions_total",
+		Help:      "Decisions evaluated by /v1/simulate by outcome (unchanged, flipped, error).",
+	}, []string{"outcome"})
+	policyTestRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "policy_test_runs_total",
+		Help:      "Runs of stored policy tests by result (pass, fail, error).",
+	}, []string{"result"})
+)
+
+// queryNames maps the SQL text of every query constant in typedef.go back to
+// the constant's name, which is what metrics are labelled with.
+var queryNames = map[string]string{
+	FindUserAttrsQuery:            "FindUserAttrsQuery",
+	FindUserCheckInfoQuery:        "FindUserCheckInfoQuery",
+	FindAccessDateQuery:           "FindAccessDateQuery",
+	FindPolicyQuery:               "FindPolicyQuery",
+	FindHierarchyQuery:            "FindHierarchyQuery",
+	FindDevCheckInfoQuery:         "FindDevCheckInfoQuery",
+	FindDevAttrsQuery:             "FindDevAttrsQuery",
+	FindDevActionsQuery:           "FindDevActionsQuery",
+	InsertUserAttrsQuery:          "InsertUserAttrsQuery",
+	InsertPermInfoQuery:           "InsertPermInfoQuery",
+	InsertPolicyQuery:             "InsertPolicyQuery",
+	InsertObjectHierarchyQuery:    "InsertObjectHierarchyQuery",
+	InsertDevInfoQuery:            "InsertDevInfoQuery",
+	InsertDevInfoFullQuery:        "InsertDevInfoFullQuery",
+	UpdateSecureDBAllowQuery:      "UpdateSecureDBAllowQuery",
+	UpdateSecureDBDenyQuery:       "UpdateSecureDBDenyQuery",
+	UpdateObjectHierarchyQuery:    "UpdateObjectHierarchyQuery",
+	UpdatePolicyQuery:             "UpdatePolicyQuery",
+	UpdateUserAttrsQuery:          "UpdateUserAttrsQuery",
+	ListPolicyQuery:               "ListPolicyQuery",
+	ListHierarchyQuery:            "ListHierarchyQuery",
+	ListUserAttrsQuery:            "ListUserAttrsQuery",
+	ListDevInfoQuery:              "ListDevInfoQuery",
+	ListAccessDateQuery:           "ListAccessDateQuery",
+	ExportPolicyQuery:             "ExportPolicyQuery",
+	ExportHierarchyQuery:          "ExportHierarchyQuery",
+	ExportUserAttrsQuery:          "ExportUserAttrsQuery",
+	ExportDevInfoQuery:            "ExportDevInfoQuery",
+	ExportAccessDateQuery:         "ExportAccessDateQuery",
+	InsertAuditLogQuery:           "InsertAuditLogQuery",
+	ListAuditLogQuery:             "ListAuditLogQuery",
+	LockAuditHeadQuery:            "LockAuditHeadQuery",
+	MoveAuditHeadQuery:            "MoveAuditHeadQuery",
+	FindAuditHeadQuery:            "FindAuditHeadQuery",
+	FindAuditHashQuery:            "FindAuditHashQuery",
+	ScanAuditLogQuery:             "ScanAuditLogQuery",
+	InsertAuditCheckpointQuery:    "InsertAuditCheckpointQuery",
+	FindLastCheckpointQuery:       "FindLastCheckpointQuery",
+	ScanAuditCheckpointQuery:      "ScanAuditCheckpointQuery",
+	CreateSchemaMigrationsQuery:   "CreateSchemaMigrationsQuery",
+	FindSchemaVersionQuery:        "FindSchemaVersionQuery",
+	InsertSchemaVersionQuery:      "InsertSchemaVersionQuery",
+	CountColumnQuery:              "CountColumnQuery",
+	InsertChangeEventQuery:        "InsertChangeEventQuery",
+	FindLastChangeQuery:           "FindLastChangeQuery",
+	ListChangesSinceQuery:         "ListChangesSinceQuery",
+	InsertWebhookDeliveryQuery:    "InsertWebhookDeliveryQuery",
+	ListDueWebhookDeliveriesQuery: "ListDueWebhookDeliveriesQuery",
+	ClaimWebhookDeliveryQuery:     "ClaimWebhookDeliveryQuery",
+	UpdateWebhookDeliveryQuery:    "UpdateWebhookDeliveryQuery",
+	RetryWebhookDeliveryQuery:     "RetryWebhookDeliveryQuery",
+	ListWebhookDeliveriesQuery:    "ListWebhookDeliveriesQuery",
+	FindWebhookDeliveryQuery:      "FindWebhookDeliveryQuery",
+	InsertIssuedGrantQuery:        "InsertIssuedGrantQuery",
+	UseIssuedGrantQuery:           "UseIssuedGrantQuery",
+	FindIssuedGrantQuery:          "FindIssuedGrantQuery",
+	InsertAccessRequestQuery:      "InsertAccessRequestQuery",
+	DecideAccessRequestQuery:      "DecideAccessRequestQuery",
+	LockAccessDateQuery:           "LockAccessDateQuery",
+	ListAccessRequestsQuery:       "ListAccessRequestsQuery",
+	FindAccessRequestQuery:        "FindAccessRequestQuery",
+	InsertAccessRequestEventQuery: "InsertAccessRequestEventQuery",
+	ListAccessRequestEventsQuery:  "ListAccessRequestEventsQuery",
+	ListUnnotifiedExpiringQuery:   "ListUnnotifiedExpiringQuery",
+	InsertExpiryNoticeQuery:       "InsertExpiryNoticeQuery",
+	DeleteExpiryNoticesQuery:      "DeleteExpiryNoticesQuery",
+	LockExpiredGrantsQuery:        "LockExpiredGrantsQuery",
+	InsertGrantHistoryQuery:       "InsertGrantHistoryQuery",
+	ListGrantHistoryQuery:         "ListGrantHistoryQuery",
+	ListRolesQuery:                "ListRolesQuery",
+	FindRoleQuery:                 "FindRoleQuery",
+	InsertRoleQuery:               "InsertRoleQuery",
+	UpdateRoleQuery:               "UpdateRoleQuery",
+	DeleteRoleQuery:               "DeleteRoleQuery",
+	ListRoleGrantsQuery:           "ListRoleGrantsQuery",
+	InsertRoleGrantQuery:          "InsertRoleGrantQuery",
+	DeleteRoleGrantsQuery:         "DeleteRoleGrantsQuery",
+	ListRoleAssignmentsQuery:      "ListRoleAssignmentsQuery",
+	InsertRoleAssignmentQuery:     "InsertRoleAssignmentQuery",
+	DeleteRoleAssignmentQuery:     "DeleteRoleAssignmentQuery",
+	DeleteRoleAssignmentsQuery:    "DeleteRoleAssignmentsQuery",
+	ListGroupMembersQuery:         "ListGroupMembersQuery",
+	InsertGroupMemberQuery:        "InsertGroupMemberQuery",
+	DeleteGroupMemberQuery:        "DeleteGroupMemberQuery",
+	ListUserRolesQuery:            "ListUserRolesQuery",
+	FindRoleGrantForUserQuery:     "FindRoleGrantForUserQuery",
+	ListObjectsQuery:              "ListObjectsQuery",
+	FindObjectQuery:               "FindObjectQuery",
+	FindObjectParentQuery:         "FindObjectParentQuery",
+	LockObjectParentQuery:         "LockObjectParentQuery",
+	InsertObjectQuery:             "InsertObjectQuery",
+	UpdateObjectQuery:             "UpdateObjectQuery",
+	DeleteObjectQuery:             "DeleteObjectQuery",
+	ListObjectChildrenQuery:       "ListObjectChildrenQuery",
+	ListObjectPolicyRefsQuery:     "ListObjectPolicyRefsQuery",
+	ListObjectActionRefsQuery:     "ListObjectActionRefsQuery",
+	InsertObjectPolicyRefQuery:    "InsertObjectPolicyRefQuery",
+	DeleteObjectActionRefsQuery:   "DeleteObjectActionRefsQuery",
+	DeleteObjectPolicyRefsQuery:   "DeleteObjectPolicyRefsQuery",
+	ListPolicyTestsQuery:          "ListPolicyTestsQuery",
+	FindPolicyTestQuery:           "FindPolicyTestQuery",
+	InsertPolicyTestQuery:         "InsertPolicyTestQuery",
+	UpdatePolicyTestQuery:         "UpdatePolicyTestQuery",
+	DeletePolicyTestQuery:         "DeletePolicyTestQuery",
+}
+
+// queryName returns the constant name for query, or "other" for SQL that is
+// not one of the typedef.go constants, such as migration DDL.
+func queryName(query string) string {
+	if name, ok := queryNames[query]; ok {
+		return name
+	}
+	return "other"
+}
+
+// timeQuery starts timing and tracing one SQL statement. Call the returned
+// function with the statement's error once it has run.
+func timeQuery(ctx sqlctx.Context, query string) func(error) {
+	name := queryName(query)
+	span := startQuerySpan(ctx, name)
+	start := time.Now()
+	return func(err error) {
+		sqlDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+		}
+		if err != nil {
+			sqlErrors.WithLabelValues(name).Inc()
+		}
+		endSpan(span, err)
+	}
+}
+
+func countDecision(source string, allowed bool) {
+	decision := DecisionDeny
+	if allowed {
+		decision = DecisionAllow
+	}
+	grantDecisions.WithLabelValues(source, decision).Inc()
+}
+
+// dbPools maps each exported sql.DB pool to its db_name label.
+var dbPools = struct {
+	sync.Mutex
+	names map[*sql.DB]string
+}{names: map[*sql.DB]string{}}
+
+// registerDBStats exports the statistics of a sql.DB pool. The first pool
+// is labelled db_name="abac" and later ones abac_2, abac_3 and so on, so
+// that no pool's statistics hide another's. Exporting the same pool again
+// does nothing.
+func registerDBStats(conn *sql.DB) error {
+	dbPools.Lock()
+	defer dbPools.Unlock()
+	if _, ok := dbPools.names[conn]; ok {
+		return nil
+	}
+	name := metricsNamespace
+	if n := len(dbPools.names); n > 0 {
+		name = fmt.Sprintf("%s_%d", metricsNamespace, n+1)
+	}
+	if err := prometheus.Register(collectors.NewDBStatsCollector(conn, name)); err != nil {
+		return err
+	}
+	dbPools.names[conn] = name
+	return nil
+}
+
+// Metrics records the request count and latency of every route. Unmatched
+// paths share the "unmatched" route label to keep cardinality bounded.
+func (s *Server) Metrics() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		start := time.Now()
+		context.Next()
+		route := context.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		method := context.Request.Method
+		httpRequests.WithLabelValues(route, method, strconv.Itoa(context.Writer.Status())).Inc()
+		httpDuration.WithLabelValues(route, method).Observe(time.Since(start).Seconds())
+	}
+}
+
+// MetricsHandler serves /metrics in the Prometheus exposition format.
+func (s *Server) MetricsHandler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.Handler())
+}
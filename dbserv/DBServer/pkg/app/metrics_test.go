@@ -0,0 +1,118 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetricsLabelsRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, _ := newTestServer(t)
+	r := gin.New()
+	r.Use(s.Metrics())
+	r.GET("/metrics_test/:id", func(context *gin.Context) { context.Status(http.StatusTeapot) })
+	r.GET("/metrics", s.MetricsHandler())
+
+	matched := httpRequests.WithLabelValues("/metrics_test/:id", http.MethodGet, "418")
+	unmatched := httpRequests.WithLabelValues("unmatched", http.MethodGet, "404")
+	before, beforeUnmatched := testutil.ToFloat64(matched), testutil.ToFloat64(unmatched)
+	for _, path := range []string{"/metrics_test/1", "/metrics_test/2", "/no/such/route"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	if got := testutil.ToFloat64(matched) - before; got != 2 {
+		t.Errorf("requests labelled with the route pattern: %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(unmatched) - beforeUnmatched; got != 1 {
+		t.Errorf("requests labelled unmatched: %v, want 1", got)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if !strings.Contains(w.Body.String(), metricsNamespace+"_http_requests_total") {
+		t.Error("/metrics does not expose the request counter")
+	}
+}
+
+// A missing row is an answer, not a failed statement.
+func TestTimeQueryCountsErrors(t *testing.T) {
+	if queryName(FindPolicyQuery) != "FindPolicyQuery" || queryName("ALTER TABLE t ADD COLUMN c INT") != "other" {
+		t.Fatal("queryName does not map the query constants")
+	}
+	errs := sqlErrors.WithLabelValues("FindPolicyQuery")
+	before := testutil.ToFloat64(errs)
+	timeQuery(sqlctx.Background(), FindPolicyQuery)(nil)
+	timeQuery(sqlctx.Background(), FindPolicyQuery)(sql.ErrNoRows)
+	timeQuery(sqlctx.Background(), FindPolicyQuery)(errors.New("connection refused"))
+	if got := testutil.ToFloat64(errs) - before; got != 1 {
+		t.Fatalf("errors counted: %v, want 1", got)
+	}
+}
+
+func TestCountDecision(t *testing.T) {
+	allow, deny := grantDecisions.WithLabelValues("check", DecisionAllow), grantDecisions.WithLabelValues("check", DecisionDeny)
+	a, d := testutil.ToFloat64(allow), testutil.ToFloat64(deny)
+	countDecision("check", true)
+	countDecision("check", false)
+	countDecision("check", false)
+	if testutil.ToFloat64(allow)-a != 1 || testutil.ToFloat64(deny)-d != 2 {
+		t.Fatal("countDecision miscounted allows and denies")
+	}
+}
+
+// Every pool is exported under its own db_name, and a pool exported twice
+// is exported once.
+func TestRegisterDBStatsLabelsEachPool(t *testing.T) {
+	var names []string
+	for i := 0; i < 2; i++ {
+		db, _, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		if err := registerDBStats(db); err != nil {
+			t.Fatal(err)
+		}
+		if err := registerDBStats(db); err != nil {
+			t.Fatalf("exporting a pool twice: %v", err)
+		}
+		dbPools.Lock()
+		names = append(names, dbPools.names[db])
+		dbPools.Unlock()
+	}
+	if names[0] == "" || names[0] == names[1] {
+		t.Fatalf("db_name labels %q", names)
+	}
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exported := map[string]int{}
+	for _, f := range families {
+		if f.GetName() != "go_sql_open_connections" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "db_name" {
+					exported[l.GetValue()]++
+				}
+			}
+		}
+	}
+	for _, name := range names {
+		if exported[name] != 1 {
+			t.Errorf("pool %s exported %d times", name, exported[name])
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+// migration is one schema step owned by this server. The abac tables that
+// predate it (user_attrs, dev_info, db_access, ...) are created by hand and
+// are not managed here.
+//
+// MySQL commits every DDL statement on its own, so a migration that fails
+// halfway is simply run again from the start. Every step must therefore be
+// safe to repeat: CREATE ... IF NOT EXISTS, DROP ... IF EXISTS, INSERT
+// IGNORE, and columns added through columns, which are skipped when
+// information_schema already lists them.
+type migration struct {
+	version    int
+	name       string
+	columns    []migrationColumn
+	statements []string
+}
+
+// migrationColumn is a column a migration adds to an existing table, before
+// its statements run.
+type migrationColumn struct {
+	table      string
+	column     string
+	definition string
+}
+
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "create audit_log",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS audit_log (
+				id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+				ts DATETIME(6) NOT NULL,
+				request_id VARCHAR(64) NOT NULL DEFAULT '',
+				actor VARCHAR(255) NOT NULL DEFAULT '',
+				action VARCHAR(64) NOT NULL,
+				target_type VARCHAR(64) NOT NULL,
+				target_id VARCHAR(255) NOT NULL DEFAULT '',
+				before_val TEXT NULL,
+				after_val TEXT NULL,
+				decision VARCHAR(16) NOT NULL DEFAULT '',
+				KEY audit_log_actor_ts (actor, ts),
+				KEY audit_log_target_ts (target_type, target_id, ts),
+				KEY audit_log_ts (ts)
+			)`,
+			// the log is append-only; refuse edits even from this server
+			"DROP TRIGGER IF EXISTS audit_log_no_update",
+			"DROP TRIGGER IF EXISTS audit_log_no_delete",
+			`CREATE TRIGGER audit_log_no_update BEFORE UPDATE ON audit_log
+				FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_log is append-only'`,
+			`CREATE TRIGGER audit_log_no_delete BEFORE DELETE ON audit_log
+				FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_log is append-only'`,
+		},
+	},
+	{
+		version: 2,
+		name:    "chain audit_log and add audit_checkpoint",
+		// rows written before this migration keep empty hashes and are
+		// reported as legacy by VerifyAuditChain
+		columns: []migrationColumn{
+			{"audit_log", "prev_hash", "CHAR(64) NOT NULL DEFAULT ''"},
+			{"audit_log", "hash", "CHAR(64) NOT NULL DEFAULT ''"},
+		},
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS audit_checkpoint (
+				id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+				record_id BIGINT NOT NULL,
+				hash CHAR(64) NOT NULL,
+				key_id VARCHAR(64) NOT NULL,
+				signature VARCHAR(128) NOT NULL,
+				created_at DATETIME(6) NOT NULL
+			)`,
+			"DROP TRIGGER IF EXISTS audit_checkpoint_no_update",
+			"DROP TRIGGER IF EXISTS audit_checkpoint_no_delete",
+			`CREATE TRIGGER audit_checkpoint_no_update BEFORE UPDATE ON audit_checkpoint
+				FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_checkpoint is append-only'`,
+			`CREATE TRIGGER audit_checkpoint_no_delete BEFORE DELETE ON audit_checkpoint
+				FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_checkpoint is append-only'`,
+		},
+	},
+	{
+		version: 3,
+		name:    "create change_events",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS change_events (
+				seq BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+				ts DATETIME(6) NOT NULL,
+				entity_type VARCHAR(64) NOT NULL,
+				entity_id VARCHAR(255) NOT NULL,
+				version BIGINT NOT NULL,
+				UNIQUE KEY change_events_entity_version (entity_type, entity_id, version)
+			)`,
+		},
+	},
+	{
+		version: 4,
+		name:    "create webhook_outbox",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS webhook_outbox (
+				id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+				event_id VARCHAR(64) NOT NULL,
+				webhook VARCHAR(255) NOT NULL,
+				event_type VARCHAR(64) NOT NULL,
+				payload TEXT NOT NULL,
+				status VARCHAR(16) NOT NULL,
+				attempts INT NOT NULL DEFAULT 0,
+				next_attempt_at DATETIME(6) NOT NULL,
+				last_status INT NOT NULL DEFAULT 0,
+				last_error TEXT NULL,
+				created_at DATETIME(6) NOT NULL,
+				delivered_at DATETIME(6) NULL,
+				KEY webhook_outbox_due (status, next_attempt_at),
+				KEY webhook_outbox_event (event_id)
+			)`,
+		},
+	},
+	{
+		version: 5,
+		name:    "create issued_grants",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS issued_grants (
+				jti VARCHAR(64) NOT NULL PRIMARY KEY,
+				issuer VARCHAR(255) NOT NULL,
+				user_id VARCHAR(255) NOT NULL,
+				sub TEXT NOT NULL,
+				issued_at DATETIME(6) NOT NULL,
+				expires_at DATETIME(6) NOT NULL,
+				used_at DATETIME(6) NULL
+			)`,
+		},
+	},
+	{
+		version: 6,
+		name:    "create access_requests",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS access_requests (
+				id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+				user_id VARCHAR(255) NOT NULL,
+				table_name VARCHAR(255) NOT NULL,
+				days INT NOT NULL,
+				reason TEXT NULL,
+				status VARCHAR(16) NOT NULL,
+				decided_by VARCHAR(255) NULL,
+				decided_at DATETIME(6) NULL,
+				created_at DATETIME(6) NOT NULL,
+				KEY access_requests_status (status, id)
+			)`,
+			`CREATE TABLE IF NOT EXISTS access_request_events (
+				id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+				request_id BIGINT NOT NULL,
+				ts DATETIME(6) NOT NULL,
+				actor VARCHAR(255) NOT NULL,
+				action VARCHAR(16) NOT NULL,
+				comment TEXT NULL,
+				KEY access_request_events_request (request_id, id)
+			)`,
+		},
+	},
+	{
+		version: 7,
+		name:    "create grant expiry tables",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS grant_expiry_notices (
+				user_id VARCHAR(255) NOT NULL,
+				tbl_name VARCHAR(255) NOT NULL,
+				db_access_date DATE NOT NULL,
+				notified_at DATETIME(6) NOT NULL,
+				PRIMARY KEY (user_id, tbl_name, db_access_date)
+			)`,
+			`CREATE TABLE IF NOT EXISTS db_access_history (
+				id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+				user_id VARCHAR(255) NOT NULL,
+				tbl_name VARCHAR(255) NOT NULL,
+				db_access_date DATE NOT NULL,
+				db_deny_date DATE NULL,
+				archived_at DATETIME(6) NOT NULL,
+				KEY db_access_history_user (user_id, tbl_name)
+			)`,
+		},
+	},
+	{
+		version: 8,
+		name:    "create roles and groups",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS roles (
+				name VARCHAR(255) NOT NULL PRIMARY KEY,
+				description TEXT NULL,
+				attrs TEXT NULL,
+				updated_at DATETIME(6) NOT NULL
+			)`,
+			`CREATE TABLE IF NOT EXISTS role_grants (
+				role VARCHAR(255) NOT NULL,
+				tbl_name VARCHAR(255) NOT NULL,
+				db_access_date DATE NOT NULL,
+				PRIMARY KEY (role, tbl_name),
+				KEY role_grants_table (tbl_name)
+			)`,
+			`CREATE TABLE IF NOT EXISTS role_assignments (
+				role VARCHAR(255) NOT NULL,
+				subject_type VARCHAR(8) NOT NULL,
+				subject_id VARCHAR(255) NOT NULL,
+				PRIMARY KEY (role, subject_type, subject_id),
+				KEY role_assignments_subject (subject_type, subject_id)
+			)`,
+			`CREATE TABLE IF NOT EXISTS group_members (
+				grp VARCHAR(255) NOT NULL,
+				user_id VARCHAR(255) NOT NULL,
+				PRIMARY KEY (grp, user_id),
+				KEY group_members_user (user_id)
+			)`,
+		},
+	},
+	{
+		version: 9,
+		name:    "create object tree",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS object_tree (
+				obj_id VARCHAR(255) NOT NULL PRIMARY KEY,
+				parent_id VARCHAR(255) NULL,
+				kind VARCHAR(64) NULL,
+				updated_at DATETIME(6) NOT NULL,
+				KEY object_tree_parent (parent_id)
+			)`,
+			`CREATE TABLE IF NOT EXISTS object_policy_refs (
+				obj_id VARCHAR(255) NOT NULL,
+				action VARCHAR(255) NOT NULL,
+				policy_ref VARCHAR(255) NOT NULL,
+				position INT NOT NULL,
+				PRIMARY KEY (obj_id, action, policy_ref)
+			)`,
+		},
+	},
+	{
+		version: 10,
+		name:    "create policy tests",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS policy_tests (
+				policy_ref VARCHAR(255) NOT NULL,
+				name VARCHAR(64) NOT NULL,
+				content MEDIUMTEXT NOT NULL,
+				updated_at DATETIME(6) NOT NULL,
+				PRIMARY KEY (policy_ref, name)
+			)`,
+		},
+	},
+	{
+		version: 11,
+		name:    "create audit_head",
+		statements: []string{
+			// a single row holding the chain head; writers lock it, so the
+			// chain cannot fork even while audit_log is empty
+			`CREATE TABLE IF NOT EXISTS audit_head (
+				id TINYINT NOT NULL PRIMARY KEY,
+				hash CHAR(64) NOT NULL
+			)`,
+			`INSERT IGNORE INTO audit_head(id, hash)
+				SELECT 1, COALESCE((SELECT hash FROM audit_log ORDER BY id DESC LIMIT 1), '')`,
+		},
+	},
+}
+
+// addColumn adds c unless the table already has it.
+func addColumn(ctx sqlctx.Context, db *sql.DB, c migrationColumn) error {
+	var n int
+	done := timeQuery(ctx, CountColumnQuery)
+	err := db.QueryRowContext(ctx, CountColumnQuery, c.table, c.column).Scan(&n)
+	done(err)
+	if err != nil || n > 0 {
+		return err
+	}
+	_, err = db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", c.table, c.column, c.definition))
+	return err
+}
+
+// LatestSchemaVersion is the version Migrate brings the database to.
+func LatestSchemaVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+// SchemaVersion returns the highest migration applied to db, 0 if none.
+func SchemaVersion(ctx sqlctx.Context, db *sql.DB) (int, error) {
+	var version sql.NullInt64
+	done := timeQuery(ctx, FindSchemaVersionQuery)
+	err := db.QueryRowContext(ctx, FindSchemaVersionQuery).Scan(&version)
+	done(err)
+	if err != nil {
+		return 0, err
+	}
+	return int(version.Int64), nil
+}
+
+// Migrate applies every migration newer than the recorded schema version.
+func Migrate(ctx sqlctx.Context, db *sql.DB) error {
+	if _, err := db.ExecContext(ctx, CreateSchemaMigrationsQuery); err != nil {
+		return fmt.Errorf("creating schema_migrations: %w", err)
+	}
+	current, err := SchemaVersion(ctx, db)
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		// MySQL commits DDL implicitly, so each step is applied on its own
+		// and the version is recorded once they have all succeeded.
+		for _, c := range m.columns {
+			if err := addColumn(ctx, db, c); err != nil {
+				return fmt.Errorf("migration %d (%s): %w", m.version, m.name, err)
+			}
+		}
+		for _, stmt := range m.statements {
+			if _, err := db.ExecContext(ctx, stmt); err != nil {
+				return fmt.Errorf("migration %d (%s): %w", m.version, m.name, err)
+			}
+		}
+		if _, err := db.ExecContext(ctx, InsertSchemaVersionQuery, m.version, m.name); err != nil {
+			return fmt.Errorf("recording migration %d: %w", m.version, err)
+		}
+		slog.InfoContext(ctx, "applied migration", "version", m.version, "name", m.name)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	sqlctx "context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// A column left behind by a half applied migration is not added twice.
+func TestAddColumnIsIdempotent(t *testing.T) {
+	s, mock := newTestServer(t)
+	c := migrationColumn{"audit_log", "hash", "CHAR(64) NOT NULL DEFAULT ''"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(CountColumnQuery)).WithArgs("audit_log", "hash").
+		WillReturnRows(sqlmock.NewRows([]string{"n"}).AddRow(0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE audit_log ADD COLUMN hash CHAR(64) NOT NULL DEFAULT ''")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	if err := addColumn(sqlctx.Background(), s.conn, c); err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(CountColumnQuery)).WithArgs("audit_log", "hash").
+		WillReturnRows(sqlmock.NewRows([]string{"n"}).AddRow(1))
+	if err := addColumn(sqlctx.Background(), s.conn, c); err != nil {
+		t.Fatal(err)
+	}
+}
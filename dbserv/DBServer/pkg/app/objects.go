@@ -0,0 +1,466 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// The object tree models what object_action_policy_hierarchy keeps as one
+// opaque string: objects such as building/floor/room/device, each with an
+// optional parent, and policy refs attached to an object per action. An
+// object inherits the refs attached to its ancestors, so its effective chain
+// for an action runs from the root down to the object itself; refs further
+// down are more specific and come later.
+//
+// Writes keep the tree a tree. Re-parenting walks up from the new parent
+// with its rows locked and refuses a parent that is the object or one of its
+// descendants; two concurrent writes that would close a cycle between them
+// deadlock, and the database aborts one. Reads stop at maxObjectDepth, so a
+// cycle that got in some other way is reported rather than followed.
+
+const maxObjectDepth = 64
+
+var (
+	errInvalidObject = errors.New("invalid object")
+	errObjectCycle   = errors.New("object tree would contain a cycle")
+	errObjectExists  = errors.New("object already exists")
+	errObjectInUse   = errors.New("object has children")
+)
+
+var objectColumns = []string{"obj_id", "parent_id", "kind", "updated_at"}
+
+// objectList lists objects without their policies. Filters: prefix
+// (obj_id), parent_id and kind; root=true lists objects without a parent.
+var objectList = listSpec{
+	entity:   "object",
+	query:    ListObjectsQuery,
+	columns:  objectColumns,
+	keys:     []string{"obj_id"},
+	sortable: []string{"obj_id", "updated_at"},
+	build: func(row map[string]string) interface{} {
+		return ObjectNode{Obj_id: row["obj_id"], Parent_id: row["parent_id"], Kind: row["kind"], Updated_at: row["updated_at"]}
+	},
+	filter: func(q listQuery, f *listFilter) error {
+		if p := q.get("prefix"); p != "" {
+			f.add("obj_id LIKE ?", likePrefix(p))
+		}
+		if p := q.get("parent_id"); p != "" {
+			f.add("parent_id = ?", p)
+		}
+		if k := q.get("kind"); k != "" {
+			f.add("kind = ?", k)
+		}
+		if q.get("root") == "true" {
+			f.add("parent_id IS NULL")
+		}
+		return nil
+	},
+}
+
+// findObject reads one object with its attached policies and children.
+func (s *Server) findObject(ctx sqlctx.Context, obj_id string) (ObjectNode, error) {
+	var o ObjectNode
+	var parent, kind sql.NullString
+	done := timeQuery(ctx, FindObjectQuery)
+	err := s.conn.QueryRowContext(ctx, FindObjectQuery, obj_id).Scan(&o.Obj_id, &parent, &kind, &o.Updated_at)
+	done(err)
+	if err != nil {
+		return ObjectNode{}, err
+	}
+	o.Parent_id, o.Kind = parent.String, kind.String
+
+	done = timeQuery(ctx, ListObjectPolicyRefsQuery)
+	res, err := s.conn.QueryContext(ctx, ListObjectPolicyRefsQuery, obj_id)
+	done(err)
+	if err != nil {
+		return ObjectNode{}, err
+	}
+	defer res.Close()
+	for res.Next() {
+		var action, ref string
+		if err := res.Scan(&action, &ref); err != nil {
+			return ObjectNode{}, err
+		}
+		if n := len(o.Policies); n == 0 || o.Policies[n-1].Action != action {
+			o.Policies = append(o.Policies, ObjectPolicy{Action: action})
+		}
+		last := &o.Policies[len(o.Policies)-1]
+		last.Policy_refs = append(last.Policy_refs, ref)
+	}
+	if err := res.Err(); err != nil {
+		return ObjectNode{}, err
+	}
+
+	o.Children, err = s.objectChildren(ctx, obj_id)
+	return o, err
+}
+
+func (s *Server) objectChildren(ctx sqlctx.Context, obj_id string) ([]string, error) {
+	done := timeQuery(ctx, ListObjectChildrenQuery)
+	res, err := s.conn.QueryContext(ctx, ListObjectChildrenQuery, obj_id)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var children []string
+	for res.Next() {
+		var c string
+		if err := res.Scan(&c); err != nil {
+			return nil, err
+		}
+		children = append(children, c)
+	}
+	return children, res.Err()
+}
+
+// checkParent makes sure parent exists and that obj_id is not among parent
+// and its ancestors, locking the rows it walks so that the path cannot
+// change before tx commits.
+func checkParent(ctx sqlctx.Context, tx *sql.Tx, obj_id string, parent string) error {
+	path := []string{obj_id}
+	for cur := parent; cur != ""; {
+		path = append(path, cur)
+		if cur == obj_id {
+			return fmt.Errorf("%w: %s", errObjectCycle, strings.Join(path, " -> "))
+		}
+		if len(path) > maxObjectDepth {
+			return fmt.Errorf("%w: the tree is deeper than %d", errInvalidObject, maxObjectDepth)
+		}
+		var next sql.NullString
+		done := timeQuery(ctx, LockObjectParentQuery)
+		err := tx.QueryRowContext(ctx, LockObjectParentQuery, cur).Scan(&next)
+		done(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: parent %s does not exist", errInvalidObject, cur)
+		}
+		if err != nil {
+			return err
+		}
+		cur = next.String
+	}
+	return nil
+}
+
+// saveObject creates an object, or with replace moves an existing one and
+// changes its kind.
+func (s *Server) saveObject(ctx sqlctx.Context, o ObjectNode, replace bool) error {
+	if o.Obj_id == "" {
+		return fmt.Errorf("%w: obj_id is required", errInvalidObject)
+	}
+	before, err := s.findObject(ctx, o.Obj_id)
+	switch {
+	case errors.Is(err, sql.ErrNoRows) && replace:
+		return err
+	case err == nil && !replace:
+		return errObjectExists
+	case err != nil && !errors.Is(err, sql.ErrNoRows):
+		return err
+	}
+
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := checkParent(ctx, tx, o.Obj_id, o.Parent_id); err != nil {
+		return err
+	}
+	now := time.Now().UTC().Format(auditTimeLayout)
+	parent := sql.NullString{String: o.Parent_id, Valid: o.Parent_id != ""}
+	kind := sql.NullString{String: o.Kind, Valid: o.Kind != ""}
+	if replace {
+		done := timeQuery(ctx, UpdateObjectQuery)
+		_, err = tx.ExecContext(ctx, UpdateObjectQuery, parent, kind, now, o.Obj_id)
+		done(err)
+	} else {
+		done := timeQuery(ctx, InsertObjectQuery)
+		_, err = tx.ExecContext(ctx, InsertObjectQuery, o.Obj_id, parent, kind, now)
+		done(err)
+	}
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	o.Policies, o.Children = nil, nil
+	rec := AuditRecord{Action: AuditInsert, Target_type: "object", Target_id: o.Obj_id, After: toAuditJSON(o)}
+	if replace {
+		before.Policies, before.Children = nil, nil
+		rec.Action, rec.Before = AuditUpdate, toAuditJSON(before)
+	}
+	s.audit.Record(ctx, rec)
+	return nil
+}
+
+// deleteObject removes a leaf object and the policy refs attached to it.
+func (s *Server) deleteObject(ctx sqlctx.Context, obj_id string) error {
+	before, err := s.findObject(ctx, obj_id)
+	if err != nil {
+		return err
+	}
+	if len(before.Children) > 0 {
+		return fmt.Errorf("%w: move or delete %s first", errObjectInUse, strings.Join(before.Children, ", "))
+	}
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, q := range []string{DeleteObjectPolicyRefsQuery, DeleteObjectQuery} {
+		done := timeQuery(ctx, q)
+		_, err := tx.ExecContext(ctx, q, obj_id)
+		done(err)
+		if err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditDelete, Target_type: "object", Target_id: obj_id, Before: toAuditJSON(before)})
+	return nil
+}
+
+// setObjectPolicies replaces the refs attached to an object for action.
+// Every ref must name a stored policy; an empty list detaches them all.
+func (s *Server) setObjectPolicies(ctx sqlctx.Context, obj_id string, p ObjectPolicy) error {
+	if p.Action == "" {
+		return fmt.Errorf("%w: action is required", errInvalidObject)
+	}
+	before, err := s.findObject(ctx, obj_id)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(p.Policy_refs))
+	for _, ref := range p.Policy_refs {
+		if seen[ref] {
+			return fmt.Errorf("%w: policy %s is attached twice", errInvalidObject, ref)
+		}
+		seen[ref] = true
+		var found Policy
+		done := timeQuery(ctx, FindPolicyQuery)
+		err := s.conn.QueryRowContext(ctx, FindPolicyQuery, ref).Scan(&found.Ref, &found.Content)
+		done(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: policy %s does not exist", errInvalidObject, ref)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	done := timeQuery(ctx, DeleteObjectActionRefsQuery)
+	_, err = tx.ExecContext(ctx, DeleteObjectActionRefsQuery, obj_id, p.Action)
+	done(err)
+	if err != nil {
+		return err
+	}
+	for i, ref := range p.Policy_refs {
+		done := timeQuery(ctx, InsertObjectPolicyRefQuery)
+		_, err := tx.ExecContext(ctx, InsertObjectPolicyRefQuery, obj_id, p.Action, ref, i)
+		done(err)
+		if err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	old := ObjectPolicy{Action: p.Action}
+	for _, have := range before.Policies {
+		if have.Action == p.Action {
+			old = have
+		}
+	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditUpdate, Target_type: "object_policy", Target_id: obj_id + "/" + p.Action,
+		Before: toAuditJSON(old), After: toAuditJSON(p)})
+	return nil
+}
+
+// policyChain resolves what obj_id inherits for action.
+func (s *Server) policyChain(ctx sqlctx.Context, obj_id string, action string) (PolicyChain, error) {
+	chain := PolicyChain{Obj_id: obj_id, Action: action, Chain: []PolicyChainLink{}, Policy_refs: []string{}}
+
+	// ancestors, object first
+	var path []string
+	seen := map[string]bool{}
+	for cur := obj_id; cur != ""; {
+		if seen[cur] {
+			return chain, fmt.Errorf("%w: %s -> %s", errObjectCycle, strings.Join(path, " -> "), cur)
+		}
+		if len(path) == maxObjectDepth {
+			return chain, fmt.Errorf("%w: the tree is deeper than %d", errInvalidObject, maxObjectDepth)
+		}
+		seen[cur] = true
+		path = append(path, cur)
+		var parent sql.NullString
+		done := timeQuery(ctx, FindObjectParentQuery)
+		err := s.conn.QueryRowContext(ctx, FindObjectParentQuery, cur).Scan(&parent)
+		done(err)
+		if err != nil {
+			return chain, err
+		}
+		cur = parent.String
+	}
+
+	inChain := map[string]bool{}
+	for i := len(path) - 1; i >= 0; i-- {
+		link := PolicyChainLink{Obj_id: path[i], Policy_refs: []string{}}
+		done := timeQuery(ctx, ListObjectActionRefsQuery)
+		res, err := s.conn.QueryContext(ctx, ListObjectActionRefsQuery, path[i], action)
+		done(err)
+		if err != nil {
+			return chain, err
+		}
+		for res.Next() {
+			var ref string
+			if err := res.Scan(&ref); err != nil {
+				res.Close()
+				return chain, err
+			}
+			link.Policy_refs = append(link.Policy_refs, ref)
+			if !inChain[ref] {
+				inChain[ref] = true
+				chain.Policy_refs = append(chain.Policy_refs, ref)
+			}
+		}
+		err = res.Err()
+		res.Close()
+		if err != nil {
+			return chain, err
+		}
+		chain.Chain = append(chain.Chain, link)
+	}
+	return chain, nil
+}
+
+// writeObjectError answers a failed object write.
+func (s *Server) writeObjectError(context *gin.Context, err error) {
+	switch {
+	case errors.Is(err, errInvalidObject), errors.Is(err, errObjectCycle):
+		v1Error(context, http.StatusBadRequest, err.Error())
+	case errors.Is(err, errObjectExists), errors.Is(err, errObjectInUse):
+		v1Error(context, http.StatusConflict, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		v1Error(context, http.StatusNotFound, "object not found")
+	default:
+		s.log.ErrorContext(context.Request.Context(), "unable to update object tree", "err", err)
+		v1Error(context, http.StatusInternalServerError, "database error")
+	}
+}
+
+// CreateObjectV1 adds an object under an existing parent, or as a root.
+func (s *Server) CreateObjectV1() gin.HandlerFunc {
+	return s.saveObjectV1(false)
+}
+
+// UpdateObjectV1 moves an object and sets its kind; its subtree and
+// policies move with it.
+func (s *Server) UpdateObjectV1() gin.HandlerFunc {
+	return s.saveObjectV1(true)
+}
+
+func (s *Server) saveObjectV1(replace bool) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var o ObjectNode
+		if !s.bindV1(context, &o) {
+			return
+		}
+		code := http.StatusCreated
+		if replace {
+			o.Obj_id, code = context.Param("obj_id"), http.StatusOK
+		}
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		if err := s.saveObject(ctx, o, replace); err != nil {
+			s.writeObjectError(context, err)
+			return
+		}
+		saved, err := s.findObject(ctx, o.Obj_id)
+		if err != nil {
+			s.writeObjectError(context, err)
+			return
+		}
+		context.JSON(code, saved)
+	}
+}
+
+func (s *Server) GetObjectV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		obj_id := context.Param("obj_id")
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		result, err := s.findObject(ctx, obj_id)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditRead, Target_type: "object", Target_id: obj_id})
+	}
+}
+
+// DeleteObjectV1 deletes an object that has no children.
+func (s *Server) DeleteObjectV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		if err := s.deleteObject(ctx, context.Param("obj_id")); err != nil {
+			s.writeObjectError(context, err)
+			return
+		}
+		context.Status(http.StatusNoContent)
+	}
+}
+
+// SetObjectPoliciesV1 replaces the policy refs attached for one action.
+func (s *Server) SetObjectPoliciesV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var p ObjectPolicy
+		if !s.bindV1(context, &p) {
+			return
+		}
+		obj_id := context.Param("obj_id")
+		p.Action = context.Param("action")
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		if err := s.setObjectPolicies(ctx, obj_id, p); err != nil {
+			s.writeObjectError(context, err)
+			return
+		}
+		saved, err := s.findObject(ctx, obj_id)
+		if err != nil {
+			s.writeObjectError(context, err)
+			return
+		}
+		context.JSON(http.StatusOK, saved)
+	}
+}
+
+// GetPolicyChainV1 answers with the policy refs an object inherits for an
+// action.
+func (s *Server) GetPolicyChainV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		obj_id, action := context.Param("obj_id"), context.Param("action")
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		result, err := s.policyChain(ctx, obj_id, action)
+		if errors.Is(err, errObjectCycle) || errors.Is(err, errInvalidObject) {
+			s.log.ErrorContext(ctx, "object tree is corrupt", "obj_id", obj_id, "err", err)
+			v1Error(context, http.StatusInternalServerError, err.Error())
+			return
+		}
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditRead, Target_type: "object", Target_id: obj_id + "/" + action})
+	}
+}
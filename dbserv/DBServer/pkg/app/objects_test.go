@@ -0,0 +1,131 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// expectObject expects obj_id to be read back under parent, with children
+// and no policies.
+func expectObject(mock sqlmock.Sqlmock, obj_id string, parent interface{}, children ...string) {
+	mock.ExpectQuery(regexp.QuoteMeta(FindObjectQuery)).WithArgs(obj_id).
+		WillReturnRows(sqlmock.NewRows(objectColumns).AddRow(obj_id, parent, nil, "2024-01-01 00:00:00.000000"))
+	mock.ExpectQuery(regexp.QuoteMeta(ListObjectPolicyRefsQuery)).WithArgs(obj_id).
+		WillReturnRows(sqlmock.NewRows([]string{"action", "policy_ref"}))
+	rows := sqlmock.NewRows([]string{"obj_id"})
+	for _, c := range children {
+		rows.AddRow(c)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(ListObjectChildrenQuery)).WithArgs(obj_id).WillReturnRows(rows)
+}
+
+func expectLockParent(mock sqlmock.Sqlmock, obj_id string, parent interface{}) {
+	mock.ExpectQuery(regexp.QuoteMeta(LockObjectParentQuery)).WithArgs(obj_id).
+		WillReturnRows(sqlmock.NewRows([]string{"parent_id"}).AddRow(parent))
+}
+
+// Moving an object under one of its descendants is refused with the path
+// that would close the cycle, and nothing is written.
+func TestSaveObjectRefusesCycle(t *testing.T) {
+	s, mock := newTestServer(t)
+	expectObject(mock, "building", nil, "floor1")
+	mock.ExpectBegin()
+	expectLockParent(mock, "room1", "floor1")
+	expectLockParent(mock, "floor1", "building")
+	mock.ExpectRollback()
+
+	err := s.saveObject(sqlctx.Background(), ObjectNode{Obj_id: "building", Parent_id: "room1"}, true)
+	if !errors.Is(err, errObjectCycle) || !strings.Contains(err.Error(), "building -> room1 -> floor1 -> building") {
+		t.Fatalf("err = %v", err)
+	}
+
+	// an object is not its own parent either
+	expectObject(mock, "building", nil)
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	if err := s.saveObject(sqlctx.Background(), ObjectNode{Obj_id: "building", Parent_id: "building"}, true); !errors.Is(err, errObjectCycle) {
+		t.Errorf("self parent: %v, want errObjectCycle", err)
+	}
+}
+
+func TestSaveObjectUnderNewParent(t *testing.T) {
+	s, mock := newTestServer(t)
+	mock.ExpectQuery(regexp.QuoteMeta(FindObjectQuery)).WithArgs("room2").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(LockObjectParentQuery)).WithArgs("floor9").WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	if err := s.saveObject(sqlctx.Background(), ObjectNode{Obj_id: "room2", Parent_id: "floor9"}, false); !errors.Is(err, errInvalidObject) {
+		t.Fatalf("missing parent: %v, want errInvalidObject", err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(FindObjectQuery)).WithArgs("room2").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	expectLockParent(mock, "floor1", "building")
+	expectLockParent(mock, "building", nil)
+	mock.ExpectExec(regexp.QuoteMeta(InsertObjectQuery)).WithArgs("room2", "floor1", "room", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAudit(mock, "system", AuditInsert)
+	if err := s.saveObject(sqlctx.Background(), ObjectNode{Obj_id: "room2", Parent_id: "floor1", Kind: "room"}, false); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeleteObjectWithChildren(t *testing.T) {
+	s, mock := newTestServer(t)
+	expectObject(mock, "floor1", "building", "room1", "room2")
+	err := s.deleteObject(sqlctx.Background(), "floor1")
+	if !errors.Is(err, errObjectInUse) || !strings.Contains(err.Error(), "room1, room2") {
+		t.Errorf("err = %v", err)
+	}
+}
+
+// The chain runs from the root down, and a ref attached at several levels
+// appears once, where it is first inherited.
+func TestPolicyChain(t *testing.T) {
+	s, mock := newTestServer(t)
+	parents := [][2]interface{}{{"room1", "floor1"}, {"floor1", "building"}, {"building", nil}}
+	for _, p := range parents {
+		mock.ExpectQuery(regexp.QuoteMeta(FindObjectParentQuery)).WithArgs(p[0]).
+			WillReturnRows(sqlmock.NewRows([]string{"parent_id"}).AddRow(p[1]))
+	}
+	refs := map[string][]string{"building": {"base"}, "floor1": {}, "room1": {"lab", "base"}}
+	for _, obj := range []string{"building", "floor1", "room1"} {
+		rows := sqlmock.NewRows([]string{"policy_ref"})
+		for _, r := range refs[obj] {
+			rows.AddRow(r)
+		}
+		mock.ExpectQuery(regexp.QuoteMeta(ListObjectActionRefsQuery)).WithArgs(obj, "enter").WillReturnRows(rows)
+	}
+
+	chain, err := s.policyChain(sqlctx.Background(), "room1", "enter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(chain.Policy_refs, []string{"base", "lab"}) {
+		t.Errorf("refs = %v", chain.Policy_refs)
+	}
+	if len(chain.Chain) != 3 || chain.Chain[0].Obj_id != "building" || chain.Chain[2].Obj_id != "room1" || len(chain.Chain[1].Policy_refs) != 0 {
+		t.Errorf("chain = %+v", chain.Chain)
+	}
+}
+
+// A cycle already stored is reported rather than followed.
+func TestPolicyChainReportsStoredCycle(t *testing.T) {
+	s, mock := newTestServer(t)
+	for _, p := range [][2]string{{"a", "b"}, {"b", "a"}} {
+		mock.ExpectQuery(regexp.QuoteMeta(FindObjectParentQuery)).WithArgs(p[0]).
+			WillReturnRows(sqlmock.NewRows([]string{"parent_id"}).AddRow(p[1]))
+	}
+	_, err := s.policyChain(sqlctx.Background(), "a", "enter")
+	if !errors.Is(err, errObjectCycle) || !strings.Contains(err.Error(), "a -> b -> a") {
+		t.Errorf("err = %v", err)
+	}
+}
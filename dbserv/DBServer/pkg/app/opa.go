@@ -0,0 +1,272 @@
+package app
+
+import (
+	"bytes"
+	sqlctx "context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rego is evaluated by running the opa binary (Config.OPABinary) rather
+// than linking OPA in. Each run gets a scratch directory holding the
+// policies as numbered .rego files under opaModuleDir and, for eval, the
+// input document; the directory is removed afterwards. A policy allows
+// when its package's allow rule is true, and a set of policies allows when
+// every one of them does; no policies, or an undefined allow, is a deny.
+//
+// Policies and their tests are written by API callers, so eval and test run
+// with opa's own capabilities minus opaDeniedBuiltins: a policy that calls
+// one of them does not compile.
+
+const (
+	defaultOPABinary = "opa"
+	opaTimeout       = 10 * time.Second
+	opaModuleDir     = "policies"
+	// opaErrorLimit bounds how much of opa's output is kept in an error
+	opaErrorLimit = 2048
+)
+
+// opaDeniedBuiltins reach past the scratch directory: out to the network,
+// or into the server's environment through opa.runtime.
+var opaDeniedBuiltins = map[string]bool{
+	"http.send":          true,
+	"net.lookup_ip_addr": true,
+	"opa.runtime":        true,
+}
+
+var (
+	errOPAUnavailable = errors.New("opa binary is not available")
+	errNoPackage      = errors.New("policy has no package declaration")
+)
+
+var regoPackagePattern = regexp.MustCompile(`(?m)^\s*package\s+([A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*)\s*$`)
+
+// regoPackage returns the package a policy declares, as a data reference.
+func regoPackage(content string) (string, error) {
+	m := regoPackagePattern.FindStringSubmatch(content)
+	if m == nil {
+		return "", errNoPackage
+	}
+	return "data." + m[1], nil
+}
+
+// opaError is a run that opa itself failed, e.g. on a policy that does not
+// compile. Msg is what opa reported.
+type opaError struct {
+	msg string
+}
+
+func (e opaError) Error() string {
+	return "opa: " + e.msg
+}
+
+// opaWorkspace writes policies, and input when it is not nil, to a new
+// scratch directory. The caller removes it.
+func opaWorkspace(policies []Policy, input interface{}) (string, error) {
+	dir, err := os.MkdirTemp("", "dbserver-opa-")
+	if err != nil {
+		return "", err
+	}
+	if err := os.Mkdir(filepath.Join(dir, opaModuleDir), 0o700); err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	for i, p := range policies {
+		name := filepath.Join(dir, opaModuleDir, fmt.Sprintf("%03d.rego", i))
+		if err := os.WriteFile(name, []byte(p.Content), 0o600); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+	if input != nil {
+		data, err := json.Marshal(input)
+		if err == nil {
+			err = os.WriteFile(filepath.Join(dir, "input.json"), data, 0o600)
+		}
+		if err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+// opaCaps caches the restricted capabilities document.
+type opaCaps struct {
+	mu   sync.Mutex
+	data []byte
+}
+
+// restrictCapabilities drops opaDeniedBuiltins from the capabilities
+// document opa printed.
+func restrictCapabilities(current []byte) ([]byte, error) {
+	var caps map[string]json.RawMessage
+	if err := json.Unmarshal(current, &caps); err != nil {
+		return nil, fmt.Errorf("opa: unreadable capabilities: %w", err)
+	}
+	var builtins []json.RawMessage
+	if err := json.Unmarshal(caps["builtins"], &builtins); err != nil {
+		return nil, fmt.Errorf("opa: unreadable capabilities: %w", err)
+	}
+	kept := builtins[:0]
+	for _, b := range builtins {
+		var decl struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(b, &decl); err != nil {
+			return nil, fmt.Errorf("opa: unreadable capabilities: %w", err)
+		}
+		if !opaDeniedBuiltins[decl.Name] {
+			kept = append(kept, b)
+		}
+	}
+	raw, err := json.Marshal(kept)
+	if err != nil {
+		return nil, err
+	}
+	caps["builtins"] = raw
+	return json.Marshal(caps)
+}
+
+// capabilities returns the restricted capabilities of the configured opa,
+// asking it the first time they are needed.
+func (s *Server) capabilities(ctx sqlctx.Context) ([]byte, error) {
+	s.opaCaps.mu.Lock()
+	defer s.opaCaps.mu.Unlock()
+	if s.opaCaps.data != nil {
+		return s.opaCaps.data, nil
+	}
+	out, err := s.runOPA(ctx, "", "capabilities", "--current")
+	if err != nil {
+		return nil, err
+	}
+	if s.opaCaps.data, err = restrictCapabilities(out); err != nil {
+		return nil, err
+	}
+	return s.opaCaps.data, nil
+}
+
+// runOPA runs opa with args in dir and returns its standard output. A
+// non-zero exit is an opaError carrying opa's report. eval and test are run
+// with the restricted capabilities, written to dir.
+func (s *Server) runOPA(ctx sqlctx.Context, dir string, args ...string) ([]byte, error) {
+	bin, err := exec.LookPath(s.cfg.OPABinary)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errOPAUnavailable, err)
+	}
+	if len(args) > 0 && (args[0] == "eval" || args[0] == "test") {
+		caps, err := s.capabilities(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, "capabilities.json"), caps, 0o600); err != nil {
+			return nil, err
+		}
+		args = append([]string{args[0], "--capabilities", "capabilities.json"}, args[1:]...)
+	}
+	ctx, cancelfunc := sqlctx.WithTimeout(ctx, opaTimeout)
+	defer cancelfunc()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, bin, args...)
+	cmd.Dir = dir
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+	start := time.Now()
+	err = cmd.Run()
+	opaDuration.Observe(time.Since(start).Seconds())
+	if err == nil {
+		opaRuns.WithLabelValues("ok").Inc()
+		return stdout.Bytes(), nil
+	}
+	opaRuns.WithLabelValues("error").Inc()
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("opa: %w", ctx.Err())
+	}
+	var exit *exec.ExitError
+	if !errors.As(err, &exit) {
+		return nil, err
+	}
+	// eval reports policy errors as JSON on stdout, test and check as text
+	msg := strings.TrimSpace(stderr.String())
+	if msg == "" {
+		msg = strings.TrimSpace(stdout.String())
+	}
+	var report struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal(stdout.Bytes(), &report) == nil && len(report.Errors) > 0 {
+		var msgs []string
+		for _, e := range report.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		msg = strings.Join(msgs, "; ")
+	}
+	if len(msg) > opaErrorLimit {
+		msg = msg[:opaErrorLimit] + "..."
+	}
+	return stdout.Bytes(), opaError{msg: msg}
+}
+
+// evalPolicies decides input against policies.
+func (s *Server) evalPolicies(ctx sqlctx.Context, policies []Policy, input interface{}) (bool, error) {
+	if len(policies) == 0 {
+		return false, nil
+	}
+	var refs []string
+	seen := map[string]bool{}
+	for _, p := range policies {
+		pkg, err := regoPackage(p.Content)
+		if err != nil {
+			return false, fmt.Errorf("policy %s: %w", p.Ref, err)
+		}
+		if !seen[pkg] {
+			seen[pkg] = true
+			refs = append(refs, pkg+".allow")
+		}
+	}
+	dir, err := opaWorkspace(policies, input)
+	if err != nil {
+		return false, err
+	}
+	defer os.RemoveAll(dir)
+
+	query := "[" + strings.Join(refs, ", ") + "]"
+	out, err := s.runOPA(ctx, dir, "eval", "--format", "json", "--data", opaModuleDir, "--input", "input.json", query)
+	if err != nil {
+		return false, err
+	}
+	var res struct {
+		Result []struct {
+			Expressions []struct {
+				Value []interface{} `json:"value"`
+			} `json:"expressions"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(out, &res); err != nil {
+		return false, fmt.Errorf("opa: unreadable eval output: %w", err)
+	}
+	// an undefined allow leaves the whole array, and so the result, empty
+	if len(res.Result) == 0 || len(res.Result[0].Expressions) == 0 {
+		return false, nil
+	}
+	values := res.Result[0].Expressions[0].Value
+	if len(values) != len(refs) {
+		return false, nil
+	}
+	for _, v := range values {
+		if v != true {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	sqlctx "context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// fakeOPA installs a shell script as the opa binary of s. It answers
+// capabilities with capsJSON and any other command by running script in the
+// scratch directory.
+func fakeOPA(t *testing.T, s *Server, capsJSON string, script string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake opa is a shell script")
+	}
+	bin := filepath.Join(t.TempDir(), "opa")
+	sh := "#!/bin/sh\ncase \"$1\" in\ncapabilities) echo '" + capsJSON + "' ;;\n*) " + script + " ;;\nesac\n"
+	if err := os.WriteFile(bin, []byte(sh), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	s.cfg.OPABinary = bin
+}
+
+func TestRegoPackage(t *testing.T) {
+	got, err := regoPackage("# building access\npackage building.doors\n\nallow { true }\n")
+	if err != nil || got != "data.building.doors" {
+		t.Errorf("regoPackage = %q, %v", got, err)
+	}
+	for _, bad := range []string{"", "allow { true }", "# package p", "package 1p", "package p q"} {
+		if _, err := regoPackage(bad); !errors.Is(err, errNoPackage) {
+			t.Errorf("%q: %v, want errNoPackage", bad, err)
+		}
+	}
+}
+
+// Builtins that reach out of the scratch directory are dropped and the rest
+// of the document is kept as it was.
+func TestRestrictCapabilities(t *testing.T) {
+	current := `{"builtins": [{"name": "http.send", "decl": {}}, {"name": "plus"}, {"name": "opa.runtime"}, {"name": "net.lookup_ip_addr"}], "features": ["x"]}`
+	out, err := restrictCapabilities([]byte(current))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var caps struct {
+		Builtins []struct {
+			Name string `json:"name"`
+		} `json:"builtins"`
+		Features []string `json:"features"`
+	}
+	if err := json.Unmarshal(out, &caps); err != nil {
+		t.Fatal(err)
+	}
+	if len(caps.Builtins) != 1 || caps.Builtins[0].Name != "plus" || len(caps.Features) != 1 {
+		t.Errorf("capabilities = %s", out)
+	}
+	for _, bad := range []string{"", "[]", `{"builtins": {}}`, `{"builtins": [1]}`} {
+		if _, err := restrictCapabilities([]byte(bad)); err == nil {
+			t.Errorf("%q was accepted", bad)
+		}
+	}
+}
+
+// Every policy's allow must hold; a policy that does not compile is an
+// opaError, and a missing binary is errOPAUnavailable.
+func TestEvalPolicies(t *testing.T) {
+	s, _ := newTestServer(t)
+	ctx := sqlctx.Background()
+	policies := []Policy{{Ref: "a", Content: "package a"}, {Ref: "b", Content: "package b"}}
+	input := map[string]interface{}{"action": "enter"}
+
+	if ok, err := s.evalPolicies(ctx, nil, input); ok || err != nil {
+		t.Errorf("no policies = %v, %v", ok, err)
+	}
+	s.cfg.OPABinary = filepath.Join(t.TempDir(), "missing")
+	if _, err := s.evalPolicies(ctx, policies, input); !errors.Is(err, errOPAUnavailable) {
+		t.Errorf("missing opa: %v, want errOPAUnavailable", err)
+	}
+
+	// answers only when run with the capabilities and one allow per package
+	fakeOPA(t, s, `{"builtins": []}`, `for q; do :; done; [ "$2" = --capabilities ] && [ -f capabilities.json ] && [ "$q" = "[data.a.allow, data.b.allow]" ] && echo '{"result": [{"expressions": [{"value": [true, true]}]}]}'`)
+	if ok, err := s.evalPolicies(ctx, policies, input); !ok || err != nil {
+		t.Errorf("both allow = %v, %v", ok, err)
+	}
+
+	s.opaCaps.data = nil
+	fakeOPA(t, s, `{"builtins": []}`, `echo '{"result": [{"expressions": [{"value": [true, false]}]}]}'`)
+	if ok, err := s.evalPolicies(ctx, policies, input); ok || err != nil {
+		t.Errorf("one denies = %v, %v", ok, err)
+	}
+
+	fakeOPA(t, s, `{"builtins": []}`, `echo '{"errors": [{"message": "rego_type_error: undefined function http.send"}]}'; exit 2`)
+	var opaErr opaError
+	if _, err := s.evalPolicies(ctx, policies, input); !errors.As(err, &opaErr) || opaErr.msg != "rego_type_error: undefined function http.send" {
+		t.Errorf("compile error: %v", err)
+	}
+}
@@ -0,0 +1,22 @@
+package app
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// openAPISpec describes every route served by NewRouter. It is maintained by
+// hand next to the handlers; pkg/apiclient is generated from it, so regenerate
+// the client (go generate ./pkg/apiclient) whenever a route or type changes.
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// OpenAPI serves the OpenAPI 3 document for this server.
+func (s *Server) OpenAPI() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		context.Data(http.StatusOK, "application/json", openAPISpec)
+	}
+}
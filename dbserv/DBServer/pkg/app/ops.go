@@ -0,0 +1,342 @@
+package app
+
+import (
+	sqlctx "context"
+	"errors"
+	"fmt"
+	"strings"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// The operations in this file are shared by the HTTP handlers and the gRPC
+// service. They take the request context, time and log their queries and
+// write the audit log and change events; turning results and errors into a
+// response is left to the caller.
+
+// errNoAccess is returned by reads that checkAuthServerPerm refuses.
+var errNoAccess = errors.New("Don't have access to DB")
+
+// exec runs one write under queryTimeout and returns the rows it affected.
+func (s *Server) exec(ctx sqlctx.Context, query string, args ...interface{}) (int64, error) {
+	tctx, cancelfunc := sqlctx.WithTimeout(ctx, queryTimeout)
+	defer cancelfunc()
+	stmt, err := s.conn.PrepareContext(tctx, query)
+	if err != nil {
+		s.log.ErrorContext(ctx, "prepare statement failed", "err", err)
+		return 0, err
+	}
+	defer stmt.Close()
+
+	done := timeQuery(ctx, query)
+	res, err := stmt.ExecContext(tctx, args...)
+	done(err)
+	if err != nil {
+		s.log.ErrorContext(ctx, "exec statement failed", "err", err)
+		return 0, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		s.log.ErrorContext(ctx, "rows affected failed", "err", err)
+		return 0, err
+	}
+	s.log.InfoContext(ctx, "rows written", "rows", rows)
+	return rows, nil
+}
+
+// findPolicy reads one policy through the policy cache.
+func (s *Server) findPolicy(ctx sqlctx.Context, hints cacheHints, ref string) (Policy, error) {
+	if cached, ok := s.policyCache.lookup(hints, ref); ok {
+		return cached.(Policy), nil
+	}
+	var result Policy
+	done := timeQuery(ctx, FindPolicyQuery)
+	err := s.conn.QueryRowContext(ctx, FindPolicyQuery, ref).Scan(&result.Ref, &result.Content)
+	done(err)
+	s.log.DebugContext(ctx, "sql query", "query", FindPolicyQuery, "params", []interface{}{ref})
+	if err != nil {
+		return result, err
+	}
+	s.policyCache.put(ref, result)
+	return result, nil
+}
+
+func (s *Server) insertPolicy(ctx sqlctx.Context, req InsertPolicyRequest) (int64, error) {
+	rows, err := s.exec(ctx, InsertPolicyQuery, req.Ref, req.Content)
+	if err != nil {
+		return 0, err
+	}
+	s.policyCache.invalidate(req.Ref)
+	s.audit.Record(ctx, AuditRecord{Action: AuditInsert, Target_type: "policy", Target_id: req.Ref, After: toAuditJSON(req)})
+	s.publishChange(ctx, ChangePolicy, req.Ref)
+	return rows, nil
+}
+
+// updatePolicy replaces a policy's content once it passes the policy's
+// stored tests (see policytests.go).
+func (s *Server) updatePolicy(ctx sqlctx.Context, req UpdatePolicyRequest) (int64, error) {
+	if err := s.checkPolicyTests(ctx, Policy{Ref: req.Ref, Content: req.Content}); err != nil {
+		return 0, err
+	}
+	before := s.snapshot(ctx, FindPolicyQuery, req.Ref)
+	rows, err := s.exec(ctx, UpdatePolicyQuery, req.Content, req.Ref)
+	if err != nil {
+		return 0, err
+	}
+	s.policyCache.invalidate(req.Ref)
+	s.audit.Record(ctx, AuditRecord{Action: AuditUpdate, Target_type: "policy", Target_id: req.Ref, Before: before, After: toAuditJSON(req)})
+	s.publishChange(ctx, ChangePolicy, req.Ref)
+	return rows, nil
+}
+
+// findHierarchy reads one hierarchy through the hierarchy cache.
+func (s *Server) findHierarchy(ctx sqlctx.Context, hints cacheHints, obj_id string, action string) (Hierarchy, error) {
+	key := hierarchyKey(obj_id, action)
+	if cached, ok := s.hierarchyCache.lookup(hints, key); ok {
+		return cached.(Hierarchy), nil
+	}
+	var result Hierarchy
+	done := timeQuery(ctx, FindHierarchyQuery)
+	err := s.conn.QueryRowContext(ctx, FindHierarchyQuery, obj_id, action).Scan(&result.Obj_id, &result.Action, &result.Hierarchy)
+	done(err)
+	s.log.DebugContext(ctx, "sql query", "query", FindHierarchyQuery, "params", []interface{}{obj_id, action})
+	if err != nil {
+		return result, err
+	}
+	s.hierarchyCache.put(key, result)
+	return result, nil
+}
+
+func (s *Server) insertHierarchy(ctx sqlctx.Context, req InsertObjectHierarchyRequest) (int64, error) {
+	rows, err := s.exec(ctx, InsertObjectHierarchyQuery, req.Obj_id, req.Action, req.Hierarchy)
+	if err != nil {
+		return 0, err
+	}
+	s.hierarchyCache.invalidate(hierarchyKey(req.Obj_id, req.Action))
+	s.audit.Record(ctx, AuditRecord{Action: AuditInsert, Target_type: "hierarchy", Target_id: req.Obj_id + "/" + req.Action, After: toAuditJSON(req)})
+	return rows, nil
+}
+
+func (s *Server) updateHierarchy(ctx sqlctx.Context, req UpdateObjectHierarchyRequest) (int64, error) {
+	before := s.snapshot(ctx, FindHierarchyQuery, req.Obj_id, req.Action)
+	rows, err := s.exec(ctx, UpdateObjectHierarchyQuery, req.Hierarchy, req.Obj_id, req.Action)
+	if err != nil {
+		return 0, err
+	}
+	s.hierarchyCache.invalidate(hierarchyKey(req.Obj_id, req.Action))
+	s.audit.Record(ctx, AuditRecord{Action: AuditUpdate, Target_type: "hierarchy", Target_id: req.Obj_id + "/" + req.Action, Before: before, After: toAuditJSON(req)})
+	return rows, nil
+}
+
+// findUserAttrs reads one user's attributes through the user attribute
+// cache, with the defaults of the user's roles merged in (see roles.go).
+// The permission check is the caller's job.
+func (s *Server) findUserAttrs(ctx sqlctx.Context, hints cacheHints, id string) (UserAttrs, error) {
+	if cached, ok := s.userAttrsCache.lookup(hints, id); ok {
+		return cached.(UserAttrs), nil
+	}
+	var result UserAttrs
+	done := timeQuery(ctx, FindUserAttrsQuery)
+	err := s.conn.QueryRowContext(ctx, FindUserAttrsQuery, id).Scan(&result.User_id, &result.Attrs)
+	done(err)
+	s.log.DebugContext(ctx, "sql query", "query", FindUserAttrsQuery, "params", []interface{}{id})
+	if err != nil {
+		return result, err
+	}
+	if result.Attrs, err = s.withRoleDefaults(ctx, id, result.Attrs); err != nil {
+		return result, err
+	}
+	s.userAttrsCache.put(id, result)
+	return result, nil
+}
+
+// readUserAttrs is findUserAttrs behind the user_attrs permission check.
+func (s *Server) readUserAttrs(ctx sqlctx.Context, hints cacheHints, id string) (UserAttrs, error) {
+	if !s.checkAuthServerPerm(ctx, id, "user_attrs") {
+		return UserAttrs{}, errNoAccess
+	}
+	return s.findUserAttrs(ctx, hints, id)
+}
+
+// findUserCheckInfo reads the credentials the auth server checks a user
+// login against. It bypasses the attribute cache so passwords are never cached.
+func (s *Server) findUserCheckInfo(ctx sqlctx.Context, user_id string) (UserCheckInfo, error) {
+	var result UserCheckInfo
+	done := timeQuery(ctx, FindUserCheckInfoQuery)
+	err := s.conn.QueryRowContext(ctx, FindUserCheckInfoQuery, user_id).Scan(&result.User_id, &result.Password)
+	done(err)
+	s.log.DebugContext(ctx, "sql query", "query", FindUserCheckInfoQuery, "params", []interface{}{user_id})
+	return result, err
+}
+
+func (s *Server) insertUserAttrs(ctx sqlctx.Context, req InsertUserAttrsRequest) (int64, error) {
+	rows, err := s.exec(ctx, InsertUserAttrsQuery, req.User_id, req.Password, req.Attrs)
+	if err != nil {
+		return 0, err
+	}
+	s.userAttrsCache.invalidate(req.User_id)
+	s.audit.Record(ctx, AuditRecord{Action: AuditInsert, Target_type: "user", Target_id: req.User_id, After: toAuditJSON(req)})
+	s.publishChange(ctx, ChangeUser, req.User_id)
+	return rows, nil
+}
+
+func (s *Server) updateUserAttrs(ctx sqlctx.Context, req UpdateUserAttrsRequest) (int64, error) {
+	before := s.snapshot(ctx, FindUserAttrsQuery, req.User_id)
+	rows, err := s.exec(ctx, UpdateUserAttrsQuery, req.Attrs, req.User_id)
+	if err != nil {
+		return 0, err
+	}
+	s.userAttrsCache.invalidate(req.User_id)
+	s.audit.Record(ctx, AuditRecord{Action: AuditUpdate, Target_type: "user", Target_id: req.User_id, Before: before, After: toAuditJSON(req)})
+	s.publishChange(ctx, ChangeUser, req.User_id)
+	return rows, nil
+}
+
+func (s *Server) findDevAttrs(ctx sqlctx.Context, dev_id string) (DevAttrs, error) {
+	var result DevAttrs
+	done := timeQuery(ctx, FindDevAttrsQuery)
+	err := s.conn.QueryRowContext(ctx, FindDevAttrsQuery, dev_id).Scan(&result.Dev_id, &result.Attrs)
+	done(err)
+	s.log.DebugContext(ctx, "sql query", "query", FindDevAttrsQuery, "params", []interface{}{dev_id})
+	return result, err
+}
+
+func (s *Server) findDevCheckInfo(ctx sqlctx.Context, dev_id string) (DevCheckInfo, error) {
+	var result DevCheckInfo
+	done := timeQuery(ctx, FindDevCheckInfoQuery)
+	err := s.conn.QueryRowContext(ctx, FindDevCheckInfoQuery, dev_id).Scan(&result.Dev_id, &result.Dev_type, &result.Token)
+	done(err)
+	s.log.DebugContext(ctx, "sql query", "query", FindDevCheckInfoQuery, "params", []interface{}{dev_id})
+	return result, err
+}
+
+func (s *Server) findDevActions(ctx sqlctx.Context, dev_id string) (DevActions, error) {
+	var result DevActions
+	done := timeQuery(ctx, FindDevActionsQuery)
+	err := s.conn.QueryRowContext(ctx, FindDevActionsQuery, dev_id).Scan(&result.Dev_id, &result.Actions)
+	done(err)
+	s.log.DebugContext(ctx, "sql query", "query", FindDevActionsQuery, "params", []interface{}{dev_id})
+	return result, err
+}
+
+func (s *Server) insertDevInfo(ctx sqlctx.Context, req InsertDevInfoRequest) (int64, error) {
+	rows, err := s.exec(ctx, InsertDevInfoQuery, req.Dev_id, req.Dev_type, req.Token, req.Attrs)
+	if err != nil {
+		return 0, err
+	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditInsert, Target_type: "device", Target_id: req.Dev_id, After: toAuditJSON(req)})
+	s.publishChange(ctx, ChangeDevice, req.Dev_id)
+	return rows, nil
+}
+
+func (s *Server) insertDevInfoFull(ctx sqlctx.Context, req InsertDevInfoFullRequest) (int64, error) {
+	rows, err := s.exec(ctx, InsertDevInfoFullQuery, req.Dev_id, req.Dev_type, req.Action, req.Token, req.Attrs)
+	if err != nil {
+		return 0, err
+	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditInsert, Target_type: "device", Target_id: req.Dev_id, After: toAuditJSON(req)})
+	s.publishChange(ctx, ChangeDevice, req.Dev_id)
+	return rows, nil
+}
+
+func (s *Server) findDBAccess(ctx sqlctx.Context, user_id string, table_name string) (DBAccess, error) {
+	var result DBAccess
+	done := timeQuery(ctx, FindAccessDateQuery)
+	err := s.conn.QueryRowContext(ctx, FindAccessDateQuery, user_id, table_name).Scan(&result.User_id, &result.Table_name, &result.Db_access_date, &result.Db_deny_date)
+	done(err)
+	s.log.DebugContext(ctx, "sql query", "query", FindAccessDateQuery, "params", []interface{}{user_id, table_name})
+	return result, err
+}
+
+func (s *Server) insertPermInfo(ctx sqlctx.Context, req InsertPermInfoQueryRequest) (int64, error) {
+	rows, err := s.exec(ctx, InsertPermInfoQuery, req.User_id, req.Tbl_name, req.Db_access_date, req.Db_deny_date)
+	if err != nil {
+		return 0, err
+	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditInsert, Target_type: "grant", Target_id: req.User_id + "/" + req.Tbl_name, After: toAuditJSON(req)})
+	return rows, nil
+}
+
+func (s *Server) updateDBAllow(ctx sqlctx.Context, req UpdateSecureDBAllowRequest) (int64, error) {
+	before := s.snapshot(ctx, FindAccessDateQuery, req.User_id, req.Tbl_name)
+	rows, err := s.exec(ctx, UpdateSecureDBAllowQuery, req.Db_access_date, req.User_id, req.Tbl_name)
+	if err != nil {
+		return 0, err
+	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditUpdate, Target_type: "grant", Target_id: req.User_id + "/" + req.Tbl_name, Before: before, After: toAuditJSON(req)})
+	return rows, nil
+}
+
+func (s *Server) updateDBDeny(ctx sqlctx.Context, req UpdateSecureDBDenyRequest) (int64, error) {
+	before := s.snapshot(ctx, FindAccessDateQuery, req.User_id, req.Tbl_name)
+	rows, err := s.exec(ctx, UpdateSecureDBDenyQuery, req.Db_deny_date, req.User_id, req.Tbl_name)
+	if err != nil {
+		return 0, err
+	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditUpdate, Target_type: "grant", Target_id: req.User_id + "/" + req.Tbl_name, Before: before, After: toAuditJSON(req)})
+	return rows, nil
+}
+
+// submitGrant verifies a grant token and applies it through
+// accessDateUpdate. A token that fails verification or was already used is
+// audited as denied and wrapped in errGrantRefused; any other error means
+// the token was good but its grant could not be applied.
+func (s *Server) submitGrant(ctx sqlctx.Context, tokenString string) error {
+	_, span := tracer.Start(ctx, "jwt.verify")
+	parts := strings.Split(tokenString, ".")
+	if len(parts) == 3 {
+		method := jwt.GetSigningMethod("HS256")
+		if err := method.Verify(strings.Join(parts[0:2], "."), parts[2], s.jwtKey); err != nil {
+			s.log.WarnContext(ctx, "jwt signature check failed", "err", err)
+		} else {
+			s.log.DebugContext(ctx, "jwt signature ok")
+		}
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		// Don't forget to validate the alg is what you expect:
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		}
+		return s.jwtKey, nil
+	})
+	if err == nil && !token.Valid {
+		err = errors.New("token is not valid")
+	}
+	endSpan(span, err)
+
+	var claims jwt.MapClaims
+	var user, sub string
+	if err == nil {
+		var ok bool
+		claims, ok = token.Claims.(jwt.MapClaims)
+		user, _ = claims["user"].(string)
+		sub, _ = claims["sub"].(string)
+		if !ok || user == "" || sub == "" {
+			err = errors.New("token lacks user or sub claim")
+		}
+	}
+	if err == nil {
+		_, err = parseGrantSub(sub)
+	}
+	if err != nil {
+		return s.refuseGrant(ctx, err)
+	}
+
+	s.log.InfoContext(ctx, "jwt accepted", "claims", redact(claims))
+	if iss, ok := claims["iss"].(string); ok && iss != "" {
+		ctx = WithActor(ctx, "jwt:"+iss)
+	}
+	jti, _ := claims["jti"].(string)
+	err = s.accessDateUpdate(ctx, user, sub, jti)
+	if errors.Is(err, errGrantReplayed) || errors.Is(err, errGrantUnissued) {
+		return s.refuseGrant(ctx, err)
+	}
+	return err
+}
+
+func (s *Server) refuseGrant(ctx sqlctx.Context, err error) error {
+	s.log.WarnContext(ctx, "jwt rejected", "err", err)
+	s.audit.Record(ctx, AuditRecord{Action: AuditJWT, Target_type: "grant", Decision: DecisionDeny})
+	return fmt.Errorf("%w: %w", errGrantRefused, err)
+}
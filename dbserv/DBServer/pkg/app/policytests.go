@@ -0,0 +1,379 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Each policy ref can carry named Rego test modules. They run with opa test
+// (see opa.go) against the policy alone: on demand, and before any update
+// of the policy is stored, so an update that fails its policy's tests, or
+// that they cannot be run against, is refused. A policy without tests
+// updates as before, with or without opa.
+//
+// Coverage comes from a second run with --coverage and counts the policy's
+// lines only, not the tests'.
+
+const (
+	TestPass  = "pass"
+	TestFail  = "fail"
+	TestError = "error"
+	TestSkip  = "skip"
+)
+
+var errInvalidPolicyTest = errors.New("invalid policy test")
+
+// policyTestError refuses a policy update whose tests do not pass.
+type policyTestError struct {
+	report PolicyTestReport
+}
+
+func (e policyTestError) Error() string {
+	if e.report.Error != "" {
+		return fmt.Sprintf("tests for policy %s could not run: %s", e.report.Policy_ref, e.report.Error)
+	}
+	return fmt.Sprintf("%d of %d tests for policy %s failed", e.report.Failed, e.report.Total, e.report.Policy_ref)
+}
+
+// policyTests reads the tests stored with a policy. A policy that does not
+// exist is sql.ErrNoRows.
+func (s *Server) policyTests(ctx sqlctx.Context, ref string) ([]PolicyTest, error) {
+	var p Policy
+	done := timeQuery(ctx, FindPolicyQuery)
+	err := s.conn.QueryRowContext(ctx, FindPolicyQuery, ref).Scan(&p.Ref, &p.Content)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return s.storedTests(ctx, ref)
+}
+
+func (s *Server) storedTests(ctx sqlctx.Context, ref string) ([]PolicyTest, error) {
+	done := timeQuery(ctx, ListPolicyTestsQuery)
+	res, err := s.conn.QueryContext(ctx, ListPolicyTestsQuery, ref)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	tests := []PolicyTest{}
+	for res.Next() {
+		var t PolicyTest
+		if err := res.Scan(&t.Policy_ref, &t.Name, &t.Content, &t.Updated_at); err != nil {
+			return nil, err
+		}
+		tests = append(tests, t)
+	}
+	return tests, res.Err()
+}
+
+func (s *Server) findPolicyTest(ctx sqlctx.Context, ref string, name string) (PolicyTest, error) {
+	var t PolicyTest
+	done := timeQuery(ctx, FindPolicyTestQuery)
+	err := s.conn.QueryRowContext(ctx, FindPolicyTestQuery, ref, name).Scan(&t.Policy_ref, &t.Name, &t.Content, &t.Updated_at)
+	done(err)
+	return t, err
+}
+
+// savePolicyTest stores a test module for an existing policy, replacing
+// one of the same name. It reports whether the test is new.
+func (s *Server) savePolicyTest(ctx sqlctx.Context, t PolicyTest) (bool, error) {
+	if !attrKeyPattern.MatchString(t.Name) || len(t.Name) > 64 {
+		return false, fmt.Errorf("%w: name must match %s and be at most 64 characters", errInvalidPolicyTest, attrKeyPattern)
+	}
+	if _, err := regoPackage(t.Content); err != nil {
+		return false, fmt.Errorf("%w: %v", errInvalidPolicyTest, err)
+	}
+	var p Policy
+	done := timeQuery(ctx, FindPolicyQuery)
+	err := s.conn.QueryRowContext(ctx, FindPolicyQuery, t.Policy_ref).Scan(&p.Ref, &p.Content)
+	done(err)
+	if err != nil {
+		return false, err
+	}
+	before, err := s.findPolicyTest(ctx, t.Policy_ref, t.Name)
+	created := errors.Is(err, sql.ErrNoRows)
+	if err != nil && !created {
+		return false, err
+	}
+
+	now := time.Now().UTC().Format(auditTimeLayout)
+	rec := AuditRecord{Action: AuditUpdate, Target_type: "policy_test", Target_id: t.Policy_ref + "/" + t.Name, Before: toAuditJSON(before), After: toAuditJSON(t)}
+	if created {
+		_, err = s.exec(ctx, InsertPolicyTestQuery, t.Policy_ref, t.Name, t.Content, now)
+		rec.Action, rec.Before = AuditInsert, ""
+	} else {
+		_, err = s.exec(ctx, UpdatePolicyTestQuery, t.Content, now, t.Policy_ref, t.Name)
+	}
+	if err != nil {
+		return false, err
+	}
+	s.audit.Record(ctx, rec)
+	return created, nil
+}
+
+func (s *Server) deletePolicyTest(ctx sqlctx.Context, ref string, name string) error {
+	before, err := s.findPolicyTest(ctx, ref, name)
+	if err != nil {
+		return err
+	}
+	if _, err := s.exec(ctx, DeletePolicyTestQuery, ref, name); err != nil {
+		return err
+	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditDelete, Target_type: "policy_test", Target_id: ref + "/" + name, Before: toAuditJSON(before)})
+	return nil
+}
+
+// runPolicyTests runs tests against the policy content. A module that does
+// not compile, or a failing test, is reported rather than returned; the
+// error is for opa or the workspace failing.
+func (s *Server) runPolicyTests(ctx sqlctx.Context, policy Policy, tests []PolicyTest) (PolicyTestReport, error) {
+	report := PolicyTestReport{Policy_ref: policy.Ref, Results: []PolicyTestResult{}}
+	if len(tests) == 0 {
+		report.Passed = true
+		return report, nil
+	}
+	// the policy is module 0 and test i is module i+1
+	modules := []Policy{policy}
+	byFile := map[string]string{}
+	for i, t := range tests {
+		modules = append(modules, Policy{Ref: t.Name, Content: t.Content})
+		byFile[fmt.Sprintf("%03d.rego", i+1)] = t.Name
+	}
+	dir, err := opaWorkspace(modules, nil)
+	if err != nil {
+		return report, err
+	}
+	defer os.RemoveAll(dir)
+
+	out, err := s.runOPA(ctx, dir, "test", "--format", "json", opaModuleDir)
+	var oerr opaError
+	if err != nil && !errors.As(err, &oerr) {
+		return report, err
+	}
+	var results []struct {
+		Location struct {
+			File string `json:"file"`
+		} `json:"location"`
+		Package  string          `json:"package"`
+		Name     string          `json:"name"`
+		Fail     bool            `json:"fail"`
+		Skip     bool            `json:"skip"`
+		Error    json.RawMessage `json:"error"`
+		Duration int64           `json:"duration"`
+	}
+	if json.Unmarshal(out, &results) != nil {
+		// opa test does not get as far as running when a module fails to
+		// compile, and reports that instead of results
+		report.Error = "unreadable opa test output"
+		if err != nil {
+			report.Error = oerr.msg
+		}
+		policyTestRuns.WithLabelValues(TestError).Inc()
+		return report, nil
+	}
+	for _, r := range results {
+		res := PolicyTestResult{Test: byFile[filepath.Base(r.Location.File)], Package: r.Package, Name: r.Name,
+			Result: TestPass, Duration_ms: float64(r.Duration) / float64(time.Millisecond)}
+		switch {
+		case len(r.Error) > 0 && string(r.Error) != "null":
+			res.Result, res.Error = TestError, testErrorMessage(r.Error)
+		case r.Fail:
+			res.Result = TestFail
+		case r.Skip:
+			res.Result = TestSkip
+		}
+		report.Total++
+		if res.Result == TestFail || res.Result == TestError {
+			report.Failed++
+		}
+		report.Results = append(report.Results, res)
+	}
+	report.Passed = report.Failed == 0
+
+	out, err = s.runOPA(ctx, dir, "test", "--coverage", "--format", "json", opaModuleDir)
+	if err != nil && !errors.As(err, &oerr) {
+		return report, err
+	}
+	var coverage struct {
+		Files map[string]struct {
+			NotCovered []struct {
+				Start struct {
+					Row int `json:"row"`
+				} `json:"start"`
+				End struct {
+					Row int `json:"row"`
+				} `json:"end"`
+			} `json:"not_covered"`
+			CoveredLines    int     `json:"covered_lines"`
+			NotCoveredLines int     `json:"not_covered_lines"`
+			Coverage        float64 `json:"coverage"`
+		} `json:"files"`
+	}
+	if json.Unmarshal(out, &coverage) == nil {
+		for file, c := range coverage.Files {
+			if filepath.Base(file) != "000.rego" {
+				continue
+			}
+			report.Coverage, report.Covered_lines, report.Not_covered_lines = c.Coverage, c.CoveredLines, c.NotCoveredLines
+			for _, r := range c.NotCovered {
+				report.Not_covered = append(report.Not_covered, LineRange{Start: r.Start.Row, End: r.End.Row})
+			}
+		}
+	}
+
+	if report.Passed {
+		policyTestRuns.WithLabelValues(TestPass).Inc()
+	} else {
+		policyTestRuns.WithLabelValues(TestFail).Inc()
+	}
+	return report, nil
+}
+
+// testErrorMessage reads the error of one test, which opa gives as an
+// object with a message or as a plain string.
+func testErrorMessage(raw json.RawMessage) string {
+	var e struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(raw, &e) == nil && e.Message != "" {
+		return e.Message
+	}
+	var msg string
+	if json.Unmarshal(raw, &msg) == nil {
+		return msg
+	}
+	return string(raw)
+}
+
+// checkPolicyTests runs the tests stored with a policy against proposed
+// content before it replaces the stored one. No tests is a pass.
+func (s *Server) checkPolicyTests(ctx sqlctx.Context, policy Policy) error {
+	tests, err := s.storedTests(ctx, policy.Ref)
+	if err != nil || len(tests) == 0 {
+		return err
+	}
+	report, err := s.runPolicyTests(ctx, policy, tests)
+	if err != nil {
+		return err
+	}
+	if !report.Passed {
+		return policyTestError{report: report}
+	}
+	return nil
+}
+
+// writePolicyTestError answers for the errors of the policy test
+// functions and of policy updates refused by their tests.
+func (s *Server) writePolicyTestError(context *gin.Context, err error) {
+	var terr policyTestError
+	switch {
+	case errors.As(err, &terr):
+		context.JSON(http.StatusUnprocessableEntity, gin.H{"error": terr.Error(), "report": terr.report})
+	case errors.Is(err, errInvalidPolicyTest):
+		v1Error(context, http.StatusBadRequest, err.Error())
+	case errors.Is(err, errOPAUnavailable):
+		s.log.ErrorContext(context.Request.Context(), "cannot run policy tests", "err", err)
+		v1Error(context, http.StatusServiceUnavailable, "policy tests cannot run: "+errOPAUnavailable.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		v1Error(context, http.StatusNotFound, "not found")
+	default:
+		s.log.ErrorContext(context.Request.Context(), "unable to handle policy tests", "err", err)
+		v1Error(context, http.StatusInternalServerError, "database error")
+	}
+}
+
+func (s *Server) ListPolicyTestsV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		ref := context.Param("ref")
+		tests, err := s.policyTests(context.Request.Context(), ref)
+		s.writeV1Found(context, tests, err, AuditRecord{Action: AuditRead, Target_type: "policy", Target_id: ref})
+	}
+}
+
+// PutPolicyTestV1 stores a test module with a policy. It is not run; see
+// RunPolicyTestsV1.
+func (s *Server) PutPolicyTestV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var t PolicyTest
+		if !s.bindV1(context, &t) {
+			return
+		}
+		t.Policy_ref, t.Name = context.Param("ref"), context.Param("name")
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		created, err := s.savePolicyTest(ctx, t)
+		if err != nil {
+			s.writePolicyTestError(context, err)
+			return
+		}
+		saved, err := s.findPolicyTest(ctx, t.Policy_ref, t.Name)
+		if err != nil {
+			s.writePolicyTestError(context, err)
+			return
+		}
+		code := http.StatusOK
+		if created {
+			code = http.StatusCreated
+		}
+		context.JSON(code, saved)
+	}
+}
+
+func (s *Server) DeletePolicyTestV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		if err := s.deletePolicyTest(ctx, context.Param("ref"), context.Param("name")); err != nil {
+			s.writePolicyTestError(context, err)
+			return
+		}
+		context.Status(http.StatusNoContent)
+	}
+}
+
+// RunPolicyTestsV1 runs a policy's tests and reports on them; failing
+// tests are still a 200. A body with content runs them against that
+// instead of the stored policy, without storing it.
+func (s *Server) RunPolicyTestsV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req UpdatePolicyRequest
+		if context.Request.ContentLength != 0 && !s.bindV1(context, &req) {
+			return
+		}
+		ref := context.Param("ref")
+		ctx := context.Request.Context()
+		var stored Policy
+		done := timeQuery(ctx, FindPolicyQuery)
+		err := s.conn.QueryRowContext(ctx, FindPolicyQuery, ref).Scan(&stored.Ref, &stored.Content)
+		done(err)
+		var tests []PolicyTest
+		if err == nil {
+			tests, err = s.storedTests(ctx, ref)
+		}
+		if err != nil {
+			s.writePolicyTestError(context, err)
+			return
+		}
+		if req.Content != "" {
+			stored.Content = req.Content
+		}
+		report, err := s.runPolicyTests(ctx, stored, tests)
+		if err != nil {
+			s.writePolicyTestError(context, err)
+			return
+		}
+		s.audit.Record(ctx, AuditRecord{Action: AuditTestRun, Target_type: "policy", Target_id: ref, After: toAuditJSON(map[string]interface{}{
+			"proposed": req.Content != "", "total": report.Total, "failed": report.Failed, "passed": report.Passed, "coverage": report.Coverage})})
+		context.JSON(http.StatusOK, report)
+	}
+}
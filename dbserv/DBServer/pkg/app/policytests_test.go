@@ -0,0 +1,178 @@
+package app
+
+import (
+	sqlctx "context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+var policyTestColumns = []string{"policy_ref", "name", "content", "updated_at"}
+
+// fakeOPATest installs an opa whose test command prints results, or
+// coverage when run with --coverage.
+func fakeOPATest(t *testing.T, s *Server, results string, coverage string) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, out := range map[string]string{"results.json": results, "coverage.json": coverage} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(out), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fakeOPA(t, s, `{"builtins": []}`, `if [ "$4" = --coverage ]; then cat `+dir+`/coverage.json; else cat `+dir+`/results.json; fi`)
+}
+
+// Results are attributed to the stored module they came from, and coverage
+// is that of the policy alone.
+func TestRunPolicyTests(t *testing.T) {
+	s, _ := newTestServer(t)
+	fakeOPATest(t, s, `[
+		{"location": {"file": "policies/001.rego"}, "package": "data.door_test", "name": "test_allow", "duration": 2000000},
+		{"location": {"file": "policies/001.rego"}, "package": "data.door_test", "name": "test_deny", "fail": true, "error": null},
+		{"location": {"file": "policies/002.rego"}, "package": "data.more_test", "name": "test_conflict", "error": {"message": "eval_conflict_error"}},
+		{"location": {"file": "policies/002.rego"}, "package": "data.more_test", "name": "todo_test_later", "skip": true}
+	]`, `{"files": {
+		"policies/000.rego": {"not_covered": [{"start": {"row": 4}, "end": {"row": 5}}], "covered_lines": 3, "not_covered_lines": 2, "coverage": 60},
+		"policies/001.rego": {"covered_lines": 9, "coverage": 100}
+	}}`)
+	tests := []PolicyTest{{Name: "cases", Content: "package door_test"}, {Name: "more", Content: "package more_test"}}
+
+	report, err := s.runPolicyTests(sqlctx.Background(), Policy{Ref: "door", Content: "package door"}, tests)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Passed || report.Total != 4 || report.Failed != 2 || report.Error != "" {
+		t.Fatalf("report = %+v", report)
+	}
+	want := []PolicyTestResult{
+		{Test: "cases", Package: "data.door_test", Name: "test_allow", Result: TestPass, Duration_ms: 2},
+		{Test: "cases", Package: "data.door_test", Name: "test_deny", Result: TestFail},
+		{Test: "more", Package: "data.more_test", Name: "test_conflict", Result: TestError, Error: "eval_conflict_error"},
+		{Test: "more", Package: "data.more_test", Name: "todo_test_later", Result: TestSkip},
+	}
+	if !reflect.DeepEqual(report.Results, want) {
+		t.Errorf("results = %+v", report.Results)
+	}
+	if report.Coverage != 60 || report.Covered_lines != 3 || report.Not_covered_lines != 2 ||
+		!reflect.DeepEqual(report.Not_covered, []LineRange{{Start: 4, End: 5}}) {
+		t.Errorf("coverage = %v%% %d/%d %v", report.Coverage, report.Covered_lines, report.Not_covered_lines, report.Not_covered)
+	}
+}
+
+// A module that does not compile is reported with opa's message, and no
+// tests at all is a pass without running opa.
+func TestRunPolicyTestsReportsCompileErrors(t *testing.T) {
+	s, _ := newTestServer(t)
+	fakeOPA(t, s, `{"builtins": []}`, `echo "1 error occurred: policies/001.rego:3: rego_parse_error: unexpected eof" >&2; exit 1`)
+	report, err := s.runPolicyTests(sqlctx.Background(), Policy{Ref: "door", Content: "package door"}, []PolicyTest{{Name: "cases", Content: "package door_test"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Passed || !strings.Contains(report.Error, "rego_parse_error") {
+		t.Errorf("report = %+v", report)
+	}
+
+	s.cfg.OPABinary = filepath.Join(t.TempDir(), "missing")
+	if report, err := s.runPolicyTests(sqlctx.Background(), Policy{Ref: "door"}, nil); err != nil || !report.Passed {
+		t.Errorf("no tests = %+v, %v", report, err)
+	}
+}
+
+func TestTestErrorMessage(t *testing.T) {
+	for raw, want := range map[string]string{
+		`{"message": "eval_conflict_error"}`: "eval_conflict_error",
+		`"timed out"`:                        "timed out",
+		`{"code": 3}`:                        `{"code": 3}`,
+	} {
+		if got := testErrorMessage(json.RawMessage(raw)); got != want {
+			t.Errorf("testErrorMessage(%s) = %q, want %q", raw, got, want)
+		}
+	}
+}
+
+// An update that fails the policy's stored tests is refused before
+// anything is written.
+func TestUpdatePolicyRunsStoredTests(t *testing.T) {
+	s, mock := newTestServer(t)
+	fakeOPATest(t, s, `[{"location": {"file": "policies/001.rego"}, "package": "data.door_test", "name": "test_allow", "fail": true}]`, `{}`)
+	mock.ExpectQuery(regexp.QuoteMeta(ListPolicyTestsQuery)).WithArgs("door").
+		WillReturnRows(sqlmock.NewRows(policyTestColumns).AddRow("door", "cases", "package door_test", "2024-01-01 00:00:00.000000"))
+
+	_, err := s.updatePolicy(sqlctx.Background(), UpdatePolicyRequest{Ref: "door", Content: "package door"})
+	var terr policyTestError
+	if !errors.As(err, &terr) || err.Error() != "1 of 1 tests for policy door failed" {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestSavePolicyTestValidation(t *testing.T) {
+	s, _ := newTestServer(t)
+	for name, bad := range map[string]PolicyTest{
+		"bad name":   {Policy_ref: "door", Name: "my test", Content: "package door_test"},
+		"long name":  {Policy_ref: "door", Name: strings.Repeat("x", 65), Content: "package door_test"},
+		"no package": {Policy_ref: "door", Name: "cases", Content: "test_allow { true }"},
+	} {
+		if _, err := s.savePolicyTest(sqlctx.Background(), bad); !errors.Is(err, errInvalidPolicyTest) {
+			t.Errorf("%s: %v, want errInvalidPolicyTest", name, err)
+		}
+	}
+}
+
+// The policy test routes are admin only.
+func TestPolicyTestRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, mock := newTestServer(t)
+	withAdmin(s, "ops", "s3cret")
+	r := gin.New()
+	r.GET("/v1/policies/:ref/tests", s.AdminOnly(), s.ListPolicyTestsV1())
+	r.POST("/v1/policies/:ref/tests/run", s.AdminOnly(), s.RunPolicyTestsV1())
+	r.PUT("/v1/policies/:ref/tests/:name", s.AdminOnly(), s.PutPolicyTestV1())
+	r.DELETE("/v1/policies/:ref/tests/:name", s.AdminOnly(), s.DeletePolicyTestV1())
+	serve := func(method string, path string, token string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	for _, route := range [][2]string{
+		{http.MethodGet, "/v1/policies/door/tests"},
+		{http.MethodPost, "/v1/policies/door/tests/run"},
+		{http.MethodPut, "/v1/policies/door/tests/cases"},
+		{http.MethodDelete, "/v1/policies/door/tests/cases"},
+	} {
+		if w := serve(route[0], route[1], "", `{"content": "package door_test"}`); w.Code != http.StatusUnauthorized {
+			t.Errorf("%s %s without a token: status %d, want 401", route[0], route[1], w.Code)
+		}
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WithArgs("door").
+		WillReturnRows(sqlmock.NewRows([]string{"ref", "content"}).AddRow("door", "package door"))
+	mock.ExpectQuery(regexp.QuoteMeta(ListPolicyTestsQuery)).WithArgs("door").
+		WillReturnRows(sqlmock.NewRows(policyTestColumns).AddRow("door", "cases", "package door_test", "2024-01-01 00:00:00.000000"))
+	expectAudit(mock, "admin:ops", AuditRead)
+	if w := serve(http.MethodGet, "/v1/policies/door/tests", "s3cret", ""); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"name":"cases"`) {
+		t.Fatalf("list: status %d %s", w.Code, w.Body)
+	}
+
+	if w := serve(http.MethodPut, "/v1/policies/door/tests/my%20test", "s3cret", `{"content": "package door_test"}`); w.Code != http.StatusBadRequest {
+		t.Errorf("put with a bad name: status %d, want 400", w.Code)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WithArgs("gate").WillReturnRows(sqlmock.NewRows([]string{"ref", "content"}))
+	if w := serve(http.MethodPost, "/v1/policies/gate/tests/run", "s3cret", ""); w.Code != http.StatusNotFound {
+		t.Errorf("run for a missing policy: status %d, want 404", w.Code)
+	}
+}
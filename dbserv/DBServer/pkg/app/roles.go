@@ -0,0 +1,540 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Roles sit on top of the per-user grants in db_access. A role has a set of
+// table grants, each valid until its own date, and a JSON object of
+// attribute defaults. Roles are assigned to users directly or to groups,
+// which are plain named sets of users.
+//
+// A permission check passes when the user's own grant is valid or, failing
+// that, when any role they hold grants the table until a later date. The
+// attributes served for a user are the role defaults with the user's own
+// attributes laid over them; where two roles set the same key, the role
+// whose name sorts first wins. Role and group changes purge the attribute
+// cache, since they can change what any user resolves to.
+
+const (
+	RoleSubjectUser  = "user"
+	RoleSubjectGroup = "group"
+
+	// roleGrantForever is the access date of a role grant given without one
+	roleGrantForever = "9999-12-31"
+)
+
+var (
+	errInvalidRole = errors.New("invalid role")
+	errRoleExists  = errors.New("role already exists")
+)
+
+var roleColumns = []string{"name", "description", "attrs", "updated_at"}
+
+func roleFromRow(row map[string]string) Role {
+	return Role{Name: row["name"], Description: row["description"], Attrs: row["attrs"], Updated_at: row["updated_at"]}
+}
+
+// roleList lists roles without their grants and members. Filters: prefix
+// (name).
+var roleList = listSpec{
+	entity:   "role",
+	query:    ListRolesQuery,
+	columns:  roleColumns,
+	keys:     []string{"name"},
+	sortable: []string{"name", "updated_at"},
+	build:    func(row map[string]string) interface{} { return roleFromRow(row) },
+	filter: func(q listQuery, f *listFilter) error {
+		if p := q.get("prefix"); p != "" {
+			f.add("name LIKE ?", likePrefix(p))
+		}
+		return nil
+	},
+}
+
+// validate checks a role as given to create or update and fills in the
+// open-ended access date.
+func (r *Role) validate() error {
+	if !attrKeyPattern.MatchString(r.Name) {
+		return fmt.Errorf("%w: name must match %s", errInvalidRole, attrKeyPattern)
+	}
+	if r.Attrs != "" {
+		var defaults map[string]interface{}
+		if err := json.Unmarshal([]byte(r.Attrs), &defaults); err != nil {
+			return fmt.Errorf("%w: attrs must be a JSON object", errInvalidRole)
+		}
+	}
+	seen := make(map[string]bool, len(r.Grants))
+	for i := range r.Grants {
+		g := &r.Grants[i]
+		if g.Table_name == "" {
+			return fmt.Errorf("%w: grant %d has no table_name", errInvalidRole, i)
+		}
+		if seen[g.Table_name] {
+			return fmt.Errorf("%w: table %s is granted twice", errInvalidRole, g.Table_name)
+		}
+		seen[g.Table_name] = true
+		if g.Db_access_date == "" {
+			g.Db_access_date = roleGrantForever
+		}
+		if _, err := time.Parse("2006-01-02", g.Db_access_date); err != nil {
+			return fmt.Errorf("%w: grant for %s: db_access_date must be YYYY-MM-DD", errInvalidRole, g.Table_name)
+		}
+	}
+	return nil
+}
+
+// findRole reads one role with its grants and members.
+func (s *Server) findRole(ctx sqlctx.Context, name string) (Role, error) {
+	var r Role
+	var description, attrs sql.NullString
+	done := timeQuery(ctx, FindRoleQuery)
+	err := s.conn.QueryRowContext(ctx, FindRoleQuery, name).Scan(&r.Name, &description, &attrs, &r.Updated_at)
+	done(err)
+	if err != nil {
+		return Role{}, err
+	}
+	r.Description, r.Attrs = description.String, attrs.String
+
+	done = timeQuery(ctx, ListRoleGrantsQuery)
+	res, err := s.conn.QueryContext(ctx, ListRoleGrantsQuery, name)
+	done(err)
+	if err != nil {
+		return Role{}, err
+	}
+	defer res.Close()
+	for res.Next() {
+		var g RoleGrant
+		if err := res.Scan(&g.Table_name, &g.Db_access_date); err != nil {
+			return Role{}, err
+		}
+		r.Grants = append(r.Grants, g)
+	}
+	if err := res.Err(); err != nil {
+		return Role{}, err
+	}
+
+	done = timeQuery(ctx, ListRoleAssignmentsQuery)
+	members, err := s.conn.QueryContext(ctx, ListRoleAssignmentsQuery, name)
+	done(err)
+	if err != nil {
+		return Role{}, err
+	}
+	defer members.Close()
+	for members.Next() {
+		var m RoleMember
+		if err := members.Scan(&m.Kind, &m.Id); err != nil {
+			return Role{}, err
+		}
+		r.Members = append(r.Members, m)
+	}
+	return r, members.Err()
+}
+
+// saveRole creates a role, or with replace overwrites an existing one's
+// description, attributes and grants. Members are left alone.
+func (s *Server) saveRole(ctx sqlctx.Context, r Role, replace bool) error {
+	if err := r.validate(); err != nil {
+		return err
+	}
+	before, err := s.findRole(ctx, r.Name)
+	switch {
+	case errors.Is(err, sql.ErrNoRows) && replace:
+		return err
+	case err == nil && !replace:
+		return errRoleExists
+	case err != nil && !errors.Is(err, sql.ErrNoRows):
+		return err
+	}
+
+	now := time.Now().UTC().Format(auditTimeLayout)
+	description := sql.NullString{String: r.Description, Valid: r.Description != ""}
+	attrs := sql.NullString{String: r.Attrs, Valid: r.Attrs != ""}
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if replace {
+		done := timeQuery(ctx, UpdateRoleQuery)
+		_, err = tx.ExecContext(ctx, UpdateRoleQuery, description, attrs, now, r.Name)
+		done(err)
+		if err == nil {
+			done = timeQuery(ctx, DeleteRoleGrantsQuery)
+			_, err = tx.ExecContext(ctx, DeleteRoleGrantsQuery, r.Name)
+			done(err)
+		}
+	} else {
+		done := timeQuery(ctx, InsertRoleQuery)
+		_, err = tx.ExecContext(ctx, InsertRoleQuery, r.Name, description, attrs, now)
+		done(err)
+	}
+	if err != nil {
+		return err
+	}
+	for _, g := range r.Grants {
+		done := timeQuery(ctx, InsertRoleGrantQuery)
+		_, err := tx.ExecContext(ctx, InsertRoleGrantQuery, r.Name, g.Table_name, g.Db_access_date)
+		done(err)
+		if err != nil {
+			return fmt.Errorf("granting %s to role %s: %w", g.Table_name, r.Name, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	rec := AuditRecord{Action: AuditInsert, Target_type: "role", Target_id: r.Name, After: toAuditJSON(r)}
+	if replace {
+		before.Members = nil
+		rec.Action, rec.Before = AuditUpdate, toAuditJSON(before)
+	}
+	s.audit.Record(ctx, rec)
+	s.userAttrsCache.purge()
+	return nil
+}
+
+// deleteRole removes a role with its grants and assignments.
+func (s *Server) deleteRole(ctx sqlctx.Context, name string) error {
+	before, err := s.findRole(ctx, name)
+	if err != nil {
+		return err
+	}
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, q := range []string{DeleteRoleAssignmentsQuery, DeleteRoleGrantsQuery, DeleteRoleQuery} {
+		done := timeQuery(ctx, q)
+		_, err := tx.ExecContext(ctx, q, name)
+		done(err)
+		if err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.audit.Record(ctx, AuditRecord{Action: AuditDelete, Target_type: "role", Target_id: name, Before: toAuditJSON(before)})
+	s.userAttrsCache.purge()
+	return nil
+}
+
+// assignRole gives or, with assign false, takes a role from a user or a
+// group. It reports whether anything changed.
+func (s *Server) assignRole(ctx sqlctx.Context, role string, m RoleMember, assign bool) (int64, error) {
+	if m.Kind != RoleSubjectUser && m.Kind != RoleSubjectGroup {
+		return 0, fmt.Errorf("%w: members are a user or a group", errInvalidRole)
+	}
+	if m.Id == "" {
+		return 0, fmt.Errorf("%w: member id is required", errInvalidRole)
+	}
+	var exists string
+	done := timeQuery(ctx, FindRoleQuery)
+	err := s.conn.QueryRowContext(ctx, FindRoleQuery, role).Scan(&exists, new(sql.NullString), new(sql.NullString), new(string))
+	done(err)
+	if err != nil {
+		return 0, err
+	}
+	query, action := InsertRoleAssignmentQuery, AuditAssign
+	if !assign {
+		query, action = DeleteRoleAssignmentQuery, AuditUnassign
+	}
+	rows, err := s.exec(ctx, query, role, m.Kind, m.Id)
+	if err != nil {
+		return 0, err
+	}
+	if rows > 0 {
+		s.audit.Record(ctx, AuditRecord{Action: action, Target_type: "role", Target_id: role, After: toAuditJSON(m)})
+		s.userAttrsCache.purge()
+	}
+	return rows, nil
+}
+
+// setGroupMember adds a user to or removes them from a group. Groups exist
+// as long as they have members.
+func (s *Server) setGroupMember(ctx sqlctx.Context, group string, user_id string, member bool) (int64, error) {
+	if !attrKeyPattern.MatchString(group) {
+		return 0, fmt.Errorf("%w: group must match %s", errInvalidRole, attrKeyPattern)
+	}
+	query, action := InsertGroupMemberQuery, AuditAssign
+	if !member {
+		query, action = DeleteGroupMemberQuery, AuditUnassign
+	}
+	rows, err := s.exec(ctx, query, group, user_id)
+	if err != nil {
+		return 0, err
+	}
+	if rows > 0 {
+		s.audit.Record(ctx, AuditRecord{Action: action, Target_type: "group", Target_id: group,
+			After: toAuditJSON(RoleMember{Kind: RoleSubjectUser, Id: user_id})})
+		s.userAttrsCache.purge()
+	}
+	return rows, nil
+}
+
+func (s *Server) findGroupMembers(ctx sqlctx.Context, group string) (GroupMembers, error) {
+	g := GroupMembers{Group: group, Members: []string{}}
+	done := timeQuery(ctx, ListGroupMembersQuery)
+	res, err := s.conn.QueryContext(ctx, ListGroupMembersQuery, group)
+	done(err)
+	if err != nil {
+		return g, err
+	}
+	defer res.Close()
+	for res.Next() {
+		var u string
+		if err := res.Scan(&u); err != nil {
+			return g, err
+		}
+		g.Members = append(g.Members, u)
+	}
+	if err := res.Err(); err != nil {
+		return g, err
+	}
+	if len(g.Members) == 0 {
+		return g, sql.ErrNoRows
+	}
+	return g, nil
+}
+
+type heldRole struct {
+	UserRole
+	attrs string
+}
+
+// userRoles lists the roles a user holds, sorted by name, with one entry per
+// way of holding each.
+func (s *Server) userRoles(ctx sqlctx.Context, user_id string) ([]heldRole, error) {
+	done := timeQuery(ctx, ListUserRolesQuery)
+	res, err := s.conn.QueryContext(ctx, ListUserRolesQuery, user_id, user_id)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var roles []heldRole
+	for res.Next() {
+		var r heldRole
+		var attrs sql.NullString
+		var kind, id string
+		if err := res.Scan(&r.Role, &attrs, &kind, &id); err != nil {
+			return nil, err
+		}
+		r.attrs, r.Via = attrs.String, RoleSubjectUser
+		if kind == RoleSubjectGroup {
+			r.Via = RoleSubjectGroup + ":" + id
+		}
+		roles = append(roles, r)
+	}
+	return roles, res.Err()
+}
+
+// roleGrantAllows reports whether a role the user holds grants the table
+// until after today, and which role does.
+func (s *Server) roleGrantAllows(ctx sqlctx.Context, user_id string, tbl_name string) (string, bool) {
+	var role, date string
+	done := timeQuery(ctx, FindRoleGrantForUserQuery)
+	err := s.conn.QueryRowContext(ctx, FindRoleGrantForUserQuery, tbl_name, user_id, user_id).Scan(&role, &date)
+	done(err)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false
+	}
+	if err != nil {
+		s.log.ErrorContext(ctx, "unable to execute sql query", "query", FindRoleGrantForUserQuery, "params", []interface{}{tbl_name, user_id}, "err", err)
+		return "", false
+	}
+	allowdate, _ := time.Parse("2006-01-02", date)
+	return role, time.Now().Before(allowdate)
+}
+
+// withRoleDefaults lays attrs over the attribute defaults of the user's
+// roles. Attributes that are not a JSON object are returned unchanged.
+func (s *Server) withRoleDefaults(ctx sqlctx.Context, user_id string, attrs string) (string, error) {
+	roles, err := s.userRoles(ctx, user_id)
+	if err != nil || len(roles) == 0 {
+		return attrs, err
+	}
+	own := map[string]interface{}{}
+	if attrs != "" {
+		if err := json.Unmarshal([]byte(attrs), &own); err != nil {
+			return attrs, nil
+		}
+	}
+	merged := map[string]interface{}{}
+	// reverse name order, so that the first role wins a conflict
+	sort.SliceStable(roles, func(i, j int) bool { return roles[i].Role > roles[j].Role })
+	for _, r := range roles {
+		var defaults map[string]interface{}
+		if json.Unmarshal([]byte(r.attrs), &defaults) != nil {
+			continue
+		}
+		for k, v := range defaults {
+			merged[k] = v
+		}
+	}
+	if len(merged) == 0 {
+		return attrs, nil
+	}
+	for k, v := range own {
+		merged[k] = v
+	}
+	out, err := json.Marshal(merged)
+	return string(out), err
+}
+
+// writeRoleError answers a failed role or group operation.
+func (s *Server) writeRoleError(context *gin.Context, what string, err error) {
+	switch {
+	case errors.Is(err, errInvalidRole):
+		v1Error(context, http.StatusBadRequest, err.Error())
+	case errors.Is(err, errRoleExists):
+		v1Error(context, http.StatusConflict, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		v1Error(context, http.StatusNotFound, what+" not found")
+	default:
+		s.log.ErrorContext(context.Request.Context(), "unable to update "+what, "err", err)
+		v1Error(context, http.StatusInternalServerError, "database error")
+	}
+}
+
+// CreateRoleV1 creates a role with its grants.
+func (s *Server) CreateRoleV1() gin.HandlerFunc {
+	return s.saveRoleV1(false)
+}
+
+// UpdateRoleV1 replaces a role's description, attributes and grants.
+func (s *Server) UpdateRoleV1() gin.HandlerFunc {
+	return s.saveRoleV1(true)
+}
+
+func (s *Server) saveRoleV1(replace bool) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var r Role
+		if !s.bindV1(context, &r) {
+			return
+		}
+		code := http.StatusCreated
+		if replace {
+			r.Name, code = context.Param("name"), http.StatusOK
+		}
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		if err := s.saveRole(ctx, r, replace); err != nil {
+			s.writeRoleError(context, "role", err)
+			return
+		}
+		saved, err := s.findRole(ctx, r.Name)
+		if err != nil {
+			s.writeRoleError(context, "role", err)
+			return
+		}
+		context.JSON(code, saved)
+	}
+}
+
+func (s *Server) GetRoleV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		name := context.Param("name")
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		result, err := s.findRole(ctx, name)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditRead, Target_type: "role", Target_id: name})
+	}
+}
+
+// DeleteRoleV1 deletes a role; its users lose what it granted at once.
+func (s *Server) DeleteRoleV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		if err := s.deleteRole(ctx, context.Param("name")); err != nil {
+			s.writeRoleError(context, "role", err)
+			return
+		}
+		context.Status(http.StatusNoContent)
+	}
+}
+
+// AssignRoleV1 gives the role to /:kind/:id; UnassignRoleV1 takes it away.
+// Both answer with the number of assignments changed.
+func (s *Server) AssignRoleV1() gin.HandlerFunc {
+	return s.assignRoleV1(true)
+}
+
+func (s *Server) UnassignRoleV1() gin.HandlerFunc {
+	return s.assignRoleV1(false)
+}
+
+func (s *Server) assignRoleV1(assign bool) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		m := RoleMember{Kind: context.Param("kind"), Id: context.Param("id")}
+		rows, err := s.assignRole(context.Request.Context(), context.Param("name"), m, assign)
+		if err != nil {
+			s.writeRoleError(context, "role", err)
+			return
+		}
+		context.JSON(http.StatusOK, gin.H{"rows": rows})
+	}
+}
+
+func (s *Server) GetGroupV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		group := context.Param("group")
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		result, err := s.findGroupMembers(ctx, group)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditRead, Target_type: "group", Target_id: group})
+	}
+}
+
+// AddGroupMemberV1 and RemoveGroupMemberV1 answer with the number of
+// memberships changed.
+func (s *Server) AddGroupMemberV1() gin.HandlerFunc {
+	return s.groupMemberV1(true)
+}
+
+func (s *Server) RemoveGroupMemberV1() gin.HandlerFunc {
+	return s.groupMemberV1(false)
+}
+
+func (s *Server) groupMemberV1(member bool) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		rows, err := s.setGroupMember(context.Request.Context(), context.Param("group"), context.Param("user_id"), member)
+		if err != nil {
+			s.writeRoleError(context, "group", err)
+			return
+		}
+		context.JSON(http.StatusOK, gin.H{"rows": rows})
+	}
+}
+
+// GetUserRolesV1 lists the roles a user holds and how.
+func (s *Server) GetUserRolesV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		user_id := context.Param("user_id")
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		held, err := s.userRoles(ctx, user_id)
+		if err != nil {
+			s.writeRoleError(context, "user roles", err)
+			return
+		}
+		result := make([]UserRole, 0, len(held))
+		for _, r := range held {
+			result = append(result, r.UserRole)
+		}
+		s.audit.Record(ctx, AuditRecord{Action: AuditRead, Target_type: "user_roles", Target_id: user_id})
+		context.JSON(http.StatusOK, result)
+	}
+}
@@ -0,0 +1,182 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+var userRoleColumns = []string{"name", "attrs", "subject_type", "subject_id"}
+
+func TestRoleValidate(t *testing.T) {
+	r := Role{Name: "analyst", Attrs: `{"region": "eu"}`, Grants: []RoleGrant{{Table_name: "orders"}, {Table_name: "billing", Db_access_date: "2030-01-01"}}}
+	if err := r.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if r.Grants[0].Db_access_date != roleGrantForever || r.Grants[1].Db_access_date != "2030-01-01" {
+		t.Errorf("grants = %+v", r.Grants)
+	}
+	for name, bad := range map[string]Role{
+		"name":       {Name: "data analyst"},
+		"attrs":      {Name: "analyst", Attrs: `["eu"]`},
+		"no table":   {Name: "analyst", Grants: []RoleGrant{{}}},
+		"duplicate":  {Name: "analyst", Grants: []RoleGrant{{Table_name: "orders"}, {Table_name: "orders"}}},
+		"bad date":   {Name: "analyst", Grants: []RoleGrant{{Table_name: "orders", Db_access_date: "2030-1-1"}}},
+		"empty name": {},
+	} {
+		if err := bad.validate(); !errors.Is(err, errInvalidRole) {
+			t.Errorf("%s: %v, want errInvalidRole", name, err)
+		}
+	}
+}
+
+func TestUserRolesVia(t *testing.T) {
+	s, mock := newTestServer(t)
+	mock.ExpectQuery(regexp.QuoteMeta(ListUserRolesQuery)).WithArgs("alice", "alice").
+		WillReturnRows(sqlmock.NewRows(userRoleColumns).
+			AddRow("analyst", nil, RoleSubjectUser, "alice").
+			AddRow("analyst", `{"region": "eu"}`, RoleSubjectGroup, "finance"))
+
+	roles, err := s.userRoles(sqlctx.Background(), "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 2 || roles[0].Via != "user" || roles[1].Via != "group:finance" || roles[1].attrs != `{"region": "eu"}` {
+		t.Errorf("roles = %+v", roles)
+	}
+}
+
+// The user's own attributes win over role defaults, and the role whose
+// name sorts first wins over the others.
+func TestWithRoleDefaults(t *testing.T) {
+	s, mock := newTestServer(t)
+	ctx := sqlctx.Background()
+	mock.ExpectQuery(regexp.QuoteMeta(ListUserRolesQuery)).WithArgs("alice", "alice").
+		WillReturnRows(sqlmock.NewRows(userRoleColumns).
+			AddRow("analyst", `{"region": "eu", "level": 1}`, RoleSubjectUser, "alice").
+			AddRow("billing", `{"region": "us", "team": "b"}`, RoleSubjectGroup, "finance").
+			AddRow("broken", `not json`, RoleSubjectUser, "alice"))
+
+	got, err := s.withRoleDefaults(ctx, "alice", `{"level": 3}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"level":3,"region":"eu","team":"b"}`; got != want {
+		t.Errorf("attrs = %s, want %s", got, want)
+	}
+
+	// attributes that are not an object, or a user without roles, pass through
+	mock.ExpectQuery(regexp.QuoteMeta(ListUserRolesQuery)).
+		WillReturnRows(sqlmock.NewRows(userRoleColumns).AddRow("analyst", `{"region": "eu"}`, RoleSubjectUser, "alice"))
+	if got, err := s.withRoleDefaults(ctx, "alice", `["x"]`); err != nil || got != `["x"]` {
+		t.Errorf("non-object attrs = %s, %v", got, err)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(ListUserRolesQuery)).WillReturnRows(sqlmock.NewRows(userRoleColumns))
+	if got, err := s.withRoleDefaults(ctx, "bob", `{"level": 3}`); err != nil || got != `{"level": 3}` {
+		t.Errorf("without roles = %s, %v", got, err)
+	}
+}
+
+func TestRoleGrantAllows(t *testing.T) {
+	s, mock := newTestServer(t)
+	ctx := sqlctx.Background()
+	grant := func(date string) {
+		mock.ExpectQuery(regexp.QuoteMeta(FindRoleGrantForUserQuery)).WithArgs("orders", "alice", "alice").
+			WillReturnRows(sqlmock.NewRows([]string{"role", "db_access_date"}).AddRow("analyst", date))
+	}
+
+	grant(time.Now().AddDate(0, 0, 2).Format("2006-01-02"))
+	if role, ok := s.roleGrantAllows(ctx, "alice", "orders"); !ok || role != "analyst" {
+		t.Errorf("future grant = %q, %v", role, ok)
+	}
+	grant("2020-01-01")
+	if role, ok := s.roleGrantAllows(ctx, "alice", "orders"); ok || role != "analyst" {
+		t.Errorf("lapsed grant = %q, %v", role, ok)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(FindRoleGrantForUserQuery)).WillReturnError(sql.ErrNoRows)
+	if _, ok := s.roleGrantAllows(ctx, "alice", "orders"); ok {
+		t.Error("no role grant allowed the table")
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(FindRoleGrantForUserQuery)).WillReturnError(sqlmock.ErrCancelled)
+	if _, ok := s.roleGrantAllows(ctx, "alice", "orders"); ok {
+		t.Error("a failed query allowed the table")
+	}
+}
+
+// A deny date still ahead on the user's own row holds against the grants
+// of their roles; once it has passed, the role grant applies again.
+func TestDenyDateOverridesRoleGrant(t *testing.T) {
+	s, mock := newTestServer(t)
+	ctx := sqlctx.Background()
+	row := func(deny interface{}) {
+		mock.ExpectQuery(regexp.QuoteMeta(FindAccessDateQuery)).WithArgs("alice", "orders").
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "tbl_name", "db_access_date", "db_deny_date"}).
+				AddRow("alice", "orders", "2020-01-01", deny))
+	}
+	grant := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(FindRoleGrantForUserQuery)).WithArgs("orders", "alice", "alice").
+			WillReturnRows(sqlmock.NewRows([]string{"role", "db_access_date"}).AddRow("analyst", roleGrantForever))
+	}
+
+	row(time.Now().AddDate(0, 0, 3).Format("2006-01-02"))
+	if s.decideAuthServerPerm(ctx, "alice", "orders") {
+		t.Error("a role grant overrode the user's deny date")
+	}
+	row("2020-01-01")
+	grant()
+	if !s.decideAuthServerPerm(ctx, "alice", "orders") {
+		t.Error("a lapsed deny date still held against the role grant")
+	}
+	row(nil)
+	grant()
+	if !s.decideAuthServerPerm(ctx, "alice", "orders") {
+		t.Error("a row without a deny date held against the role grant")
+	}
+}
+
+// Roles and group membership decide what users may read, so only admins
+// change them.
+func TestRoleWritesNeedAnAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, _ := newTestServer(t)
+	withAdmin(s, "ops", "s3cret")
+	s.router = gin.New()
+	r := s.Routes()
+
+	for _, route := range [][2]string{
+		{http.MethodPost, "/v1/roles"},
+		{http.MethodPut, "/v1/roles/analyst"},
+		{http.MethodDelete, "/v1/roles/analyst"},
+		{http.MethodPut, "/v1/roles/analyst/members/user/alice"},
+		{http.MethodDelete, "/v1/roles/analyst/members/user/alice"},
+		{http.MethodPut, "/v1/groups/finance/members/alice"},
+		{http.MethodDelete, "/v1/groups/finance/members/alice"},
+	} {
+		if w := serveAdmin(r, route[0], route[1], "", `{}`); w.Code != http.StatusUnauthorized {
+			t.Errorf("%s %s without a token: status %d, want 401", route[0], route[1], w.Code)
+		}
+	}
+	if w := serveAdmin(r, http.MethodPost, "/v1/roles", "s3cret", `{"name": "data analyst"}`); w.Code != http.StatusBadRequest {
+		t.Errorf("as an admin: status %d %s, want the role checked", w.Code, w.Body)
+	}
+}
+
+// A role that already exists is not overwritten by a create.
+func TestSaveRoleRefusesDuplicate(t *testing.T) {
+	s, mock := newTestServer(t)
+	mock.ExpectQuery(regexp.QuoteMeta(FindRoleQuery)).WithArgs("analyst").
+		WillReturnRows(sqlmock.NewRows(roleColumns).AddRow("analyst", nil, nil, "2024-01-01 00:00:00.000000"))
+	mock.ExpectQuery(regexp.QuoteMeta(ListRoleGrantsQuery)).WillReturnRows(sqlmock.NewRows([]string{"tbl_name", "db_access_date"}))
+	mock.ExpectQuery(regexp.QuoteMeta(ListRoleAssignmentsQuery)).WillReturnRows(sqlmock.NewRows([]string{"subject_type", "subject_id"}))
+
+	if err := s.saveRole(sqlctx.Background(), Role{Name: "analyst"}, false); !errors.Is(err, errRoleExists) {
+		t.Errorf("err = %v, want errRoleExists", err)
+	}
+}
@@ -5,64 +5,55 @@ import "github.com/gin-gonic/gin"
 func (s *Server) Routes() *gin.Engine {
 	router := s.router
 
-	// group all routes under /v1/api
-
-	// v1 := router.Group("/test")
-	// {
-	// 	v1.GET("/:id", s.DBTest())
-	// }
-
-	v2 := router.Group("/find_db_access")
-	{
-		v2.GET("/:user_id/:table_name", s.FindDBAccess())
-	}
-
-	// router.POST("/test", s.DBTest())
-
-	v5 := router.Group("/find_uesr_attrs")
-	{
-		v5.GET("/:id", s.FindUserAttrs())
-	}
-
-	v6 := router.Group("/find_policy")
-	{
-		v6.GET("/:ref", s.FindPolicy())
-	}
-
-	v7 := router.Group("/find_hierarchy")
-	{
-		v7.GET("/:obj_id/:action", s.FindHierarchy())
-	}
-
-	v8 := router.Group("/find_user_check_info")
-	{
-		v8.GET("/:user_id", s.FindUserCheckInfo())
-	}
-
-	v9 := router.Group("/find_dev_check_info")
-	{
-		v9.GET("/:dev_id", s.FindDevCheckInfo())
-	}
-
-	v10 := router.Group("/find_dev_actions")
-	{
-		v10.GET("/:dev_id", s.FindDevActions())
-	}
-
-	v11 := router.Group("/find_dev_attrs")
-	{
-		v11.GET("/:dev_id", s.FindDevAttrs())
-	}
-
-	router.POST("/insert_user_attrs", s.InsertPolicy())
-
-	router.POST("/insert_perm_info", s.InsertPermInfo())
-
-	router.POST("/update_db_allow", s.UpdateSecureDBAllow())
-
-	router.POST("/update_db_deny", s.UpdateSecureDBDeny())
-
-	router.POST("/jwt", s.SendJWT())
+	router.Use(s.Tracing(), s.RequestContext(), s.AccessLog(), s.Metrics())
+	if s.faults.Len() > 0 {
+		router.Use(s.faults.Middleware())
+	}
+
+	s.v1Routes(router)
+
+	// Legacy verb-style routes, kept as deprecated aliases of /v1 until
+	// their callers have moved.
+	router.GET("/find_db_access/:user_id/:table_name", deprecated("/v1/grants/{user_id}/{table_name}"), s.FindDBAccess())
+	router.GET("/find_uesr_attrs/:id", deprecated("/v1/users/{id}"), s.FindUserAttrs())
+	router.GET("/find_policy/:ref", deprecated("/v1/policies/{ref}"), s.FindPolicy())
+	router.GET("/find_hierarchy/:obj_id/:action", deprecated("/v1/hierarchies/{obj_id}/{action}"), s.FindHierarchy())
+	router.GET("/find_user_check_info/:user_id", deprecated("/v1/users/{user_id}/check_info"), s.FindUserCheckInfo())
+	router.GET("/find_dev_check_info/:dev_id", deprecated("/v1/devices/{dev_id}/check_info"), s.FindDevCheckInfo())
+	router.GET("/find_dev_actions/:dev_id", deprecated("/v1/devices/{dev_id}/actions"), s.FindDevActions())
+	router.GET("/find_dev_attrs/:dev_id", deprecated("/v1/devices/{dev_id}"), s.FindDevAttrs())
+
+	router.GET("/list_users", deprecated("/v1/users"), s.ListUserAttrs())
+	router.GET("/list_devices", deprecated("/v1/devices"), s.ListDevInfo())
+	router.GET("/list_policies", deprecated("/v1/policies"), s.ListPolicy())
+	router.GET("/list_hierarchies", deprecated("/v1/hierarchies"), s.ListHierarchy())
+	router.GET("/list_db_access", deprecated("/v1/grants"), s.ListDBAccess())
+
+	router.POST("/insert_user_attrs", deprecated("/v1/policies"), s.InsertPolicy())
+	router.POST("/insert_perm_info", deprecated("/v1/grants"), s.AdminOnly(), s.InsertPermInfo())
+	router.POST("/update_db_allow", deprecated("/v1/grants/{user_id}/{table_name}"), s.AdminOnly(), s.UpdateSecureDBAllow())
+	router.POST("/update_db_deny", deprecated("/v1/grants/{user_id}/{table_name}"), s.AdminOnly(), s.UpdateSecureDBDeny())
+	router.POST("/jwt", deprecated("/v1/grants/tokens"), s.SendJWT())
+
+	router.POST("/import/:entity", s.AdminOnly(), s.ImportData())
+
+	router.GET("/export/:entity", s.AdminOnly(), s.ExportData())
+
+	router.GET("/audit", s.AdminOnly(), s.ListAuditLog())
+
+	router.GET("/changes", s.StreamChanges())
+	router.GET("/changes/ws", s.StreamChangesWS())
+
+	router.GET("/webhooks", s.AdminOnly(), s.ListWebhooks())
+	router.GET("/webhooks/deliveries", s.AdminOnly(), s.ListWebhookDeliveries())
+	router.GET("/webhooks/deliveries/:id", s.AdminOnly(), s.FindWebhookDelivery())
+	router.POST("/webhooks/deliveries/:id/retry", s.AdminOnly(), s.RetryWebhookDelivery())
+
+	router.GET("/metrics", s.MetricsHandler())
+	router.GET("/healthz", s.Healthz())
+	router.GET("/readyz", s.Readyz())
+	router.GET("/debug/status", s.DebugStatus())
+	router.GET("/openapi.json", s.OpenAPI())
 
 	return router
 }
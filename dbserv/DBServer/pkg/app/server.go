@@ -1,10 +1,27 @@
 package app
 
 import (
+	sqlctx "context"
 	"database/sql"
-	"log"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
 
+	"faultinject"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+)
+
+const (
+	listenAddr = ":3333"
+	// queryTimeout bounds the database work of one request; a client that
+	// disconnects cancels it sooner through the request context.
+	queryTimeout           = 5 * time.Second
+	defaultShutdownTimeout = 15 * time.Second
+	defaultCacheTTL        = 30 * time.Second
 )
 
 type Mapkey struct {
@@ -15,21 +32,229 @@ type Server struct {
 	router     *gin.Engine
 	conn       *sql.DB
 	allow_once map[Mapkey]bool
+	audit      *Auditor
+	log        *slog.Logger
+	cfg        Config
+	started    time.Time
+	warmed     atomic.Bool
+	policies   atomic.Int64
+	// base is the parent of every request context, cancelled when a
+	// shutdown runs out of drain time.
+	base       sqlctx.Context
+	cancelBase sqlctx.CancelFunc
+	faults     *faultinject.Injector
+
+	policyCache    *ttlCache
+	hierarchyCache *ttlCache
+	userAttrsCache *ttlCache
+
+	changes  *changeHub
+	webhooks *webhookDispatcher
+
+	jwtKey  []byte
+	issuers []GrantIssuer
+	admins  []AdminToken
+	opaCaps opaCaps
 }
 
-func NewServer(router *gin.Engine, conn *sql.DB) *Server {
-	return &Server{router: router, conn: conn}
+func NewServer(router *gin.Engine, conn *sql.DB, cfg Config) (*Server, error) {
+	if err := registerDBStats(conn); err != nil {
+		return nil, err
+	}
+	var faults []faultinject.Rule
+	if cfg.FaultFile != "" {
+		rules, err := faultinject.Load(cfg.FaultFile)
+		if err != nil {
+			return nil, err
+		}
+		faults = rules
+	}
+	var endpoints []WebhookEndpoint
+	if cfg.WebhookFile != "" {
+		e, err := LoadWebhookEndpoints(cfg.WebhookFile)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = e
+	}
+	var issuers []GrantIssuer
+	if cfg.GrantIssuerFile != "" {
+		g, err := LoadGrantIssuers(cfg.GrantIssuerFile)
+		if err != nil {
+			return nil, err
+		}
+		issuers = g
+	}
+	var admins []AdminToken
+	if cfg.AdminTokenFile != "" {
+		a, err := LoadAdminTokens(cfg.AdminTokenFile)
+		if err != nil {
+			return nil, err
+		}
+		admins = a
+	}
+	if cfg.GRPCAddr != "" && len(admins) == 0 {
+		return nil, errors.New("the gRPC API needs admin tokens; set -admin-tokens or leave -grpc-addr empty")
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	jwtKey := []byte(legacyJWTKey)
+	if cfg.JWTKeyFile != "" {
+		k, err := LoadJWTKey(cfg.JWTKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		jwtKey = k
+	} else if len(issuers) > 0 {
+		return nil, errors.New("grant issuers need their own JWT key; set -jwt-key")
+	}
+	audit, err := NewAuditor(conn, cfg.AuditFile)
+	if err != nil {
+		return nil, err
+	}
+	audit.log = logger
+	if cfg.AuditKeyFile != "" {
+		key, err := LoadAuditKey(cfg.AuditKeyFile)
+		if err != nil {
+			audit.Close()
+			return nil, err
+		}
+		interval := cfg.AuditCheckpointInterval
+		if interval <= 0 {
+			interval = time.Hour
+		}
+		audit.EnableCheckpoints(key, interval)
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = defaultCacheTTL
+	}
+	if cfg.ExpiryWindowDays <= 0 {
+		cfg.ExpiryWindowDays = defaultExpiryWindowDays
+	}
+	if cfg.ArchiveAfterDays <= 0 {
+		cfg.ArchiveAfterDays = defaultArchiveAfterDays
+	}
+	if cfg.OPABinary == "" {
+		cfg.OPABinary = defaultOPABinary
+	}
+	ttl := cfg.CacheTTL
+	base, cancelBase := sqlctx.WithCancel(sqlctx.Background())
+	s := &Server{router: router, conn: conn, allow_once: make(map[Mapkey]bool), audit: audit, log: logger,
+		cfg: cfg, started: time.Now(), base: base, cancelBase: cancelBase, faults: faultinject.New(faults),
+		policyCache:    newTTLCache("policy", ttl, cfg.CacheSize),
+		hierarchyCache: newTTLCache("hierarchy", ttl, cfg.CacheSize),
+		userAttrsCache: newTTLCache("user_attrs", ttl, cfg.CacheSize),
+		changes:        newChangeHub(),
+		webhooks:       newWebhookDispatcher(endpoints),
+		jwtKey:         jwtKey,
+		issuers:        issuers,
+		admins:         admins}
+	s.faults.Observe = s.observeFault
+	return s, nil
 }
 
-func (s *Server) Run() error {
+// Run serves HTTP, and gRPC when Config.GRPCAddr is set, until ctx is
+// cancelled, then stops accepting connections and gives in-flight requests
+// up to Config.ShutdownTimeout to finish. Requests still running after that
+// have their contexts cancelled.
+func (s *Server) Run(ctx sqlctx.Context) error {
 	r := s.Routes()
 
-	err := r.Run(":3333")
+	srv := &http.Server{
+		Addr:              listenAddr,
+		Handler:           r,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) sqlctx.Context { return s.base },
+	}
 
-	if err != nil {
-		log.Printf("server - there was an error calling Run on router: %v\n", err)
+	go s.warmUntilReady(ctx)
+	go s.runWebhooks(ctx)
+	go s.runSweeper(ctx)
+
+	errc := make(chan error, 1)
+	go func() {
+		s.log.Info("listening", "addr", listenAddr)
+		errc <- srv.ListenAndServe()
+	}()
+
+	var gsrv *grpc.Server
+	grpcErrc := make(chan error, 1)
+	if s.cfg.GRPCAddr != "" {
+		lis, err := net.Listen("tcp", s.cfg.GRPCAddr)
+		if err != nil {
+			srv.Close()
+			return err
+		}
+		gsrv = s.newGRPCServer()
+		go func() {
+			s.log.Info("grpc listening", "addr", s.cfg.GRPCAddr)
+			grpcErrc <- gsrv.Serve(lis)
+		}()
+	}
+
+	select {
+	case err := <-errc:
+		s.log.Error("server - there was an error calling ListenAndServe", "err", err)
+		if gsrv != nil {
+			gsrv.Stop()
+		}
+		return err
+	case err := <-grpcErrc:
+		s.log.Error("grpc server stopped", "err", err)
+		srv.Close()
 		return err
+	case <-ctx.Done():
 	}
 
-	return nil
+	timeout := s.cfg.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+	s.log.Info("shutting down, draining requests", "timeout", timeout)
+	// change streams never finish on their own
+	s.changes.close()
+	drainctx, cancelfunc := sqlctx.WithTimeout(sqlctx.Background(), timeout)
+	defer cancelfunc()
+	grpcDrained := make(chan struct{})
+	if gsrv != nil {
+		go func() {
+			gsrv.GracefulStop()
+			close(grpcDrained)
+		}()
+	} else {
+		close(grpcDrained)
+	}
+	err := srv.Shutdown(drainctx)
+	if err == nil {
+		select {
+		case <-grpcDrained:
+		case <-drainctx.Done():
+			err = drainctx.Err()
+		}
+	}
+	if err != nil {
+		// out of time: cancel what is still running so its queries stop too
+		s.log.Warn("drain timeout exceeded, cancelling remaining requests", "err", err)
+		s.cancelBase()
+		srv.Close()
+		if gsrv != nil {
+			gsrv.Stop()
+		}
+	}
+	if serr := <-errc; !errors.Is(serr, http.ErrServerClosed) {
+		return serr
+	}
+	// a delivery in flight is bounded by webhookSendTimeout
+	s.stopWebhooks(webhookSendTimeout + time.Second)
+	s.log.Info("server stopped")
+	return err
+}
+
+// Close releases what NewServer opened. The database handle belongs to the
+// caller and is left open.
+func (s *Server) Close() error {
+	s.cancelBase()
+	return s.audit.Close()
 }
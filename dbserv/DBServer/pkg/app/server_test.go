@@ -0,0 +1,65 @@
+package app
+
+import (
+	sqlctx "context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// A client that goes away stops its query instead of leaving it running.
+func TestDisconnectCancelsQuery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, mock := newTestServer(t)
+	r := gin.New()
+	r.GET("/find_policy/:ref", s.FindPolicy())
+
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WithArgs("p1").WillDelayFor(5 * time.Second).
+		WillReturnRows(sqlmock.NewRows([]string{"ref", "content"}).AddRow("p1", "package p1"))
+	ctx, cancel := sqlctx.WithTimeout(sqlctx.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/find_policy/p1", nil).WithContext(ctx))
+	if took := time.Since(start); took > time.Second {
+		t.Fatalf("request took %v after its client left", took)
+	}
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status %d, want 400", w.Code)
+	}
+}
+
+// Work that finished is audited even when the request was cancelled since.
+func TestAuditOutlivesCancelledRequest(t *testing.T) {
+	s, mock := newTestServer(t)
+	ctx, cancel := sqlctx.WithCancel(WithActor(sqlctx.Background(), "cli:bob"))
+	cancel()
+	expectAudit(mock, "cli:bob", AuditUpdate)
+	s.audit.Record(ctx, AuditRecord{Action: AuditUpdate, Target_type: "policy", Target_id: "p1"})
+}
+
+func TestCloseCancelsRequestContexts(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	s, err := NewServer(gin.New(), db, Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.base.Err() != nil {
+		t.Fatal("base context done before Close")
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if s.base.Err() == nil {
+		t.Fatal("Close left request contexts running")
+	}
+}
@@ -0,0 +1,260 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Simulation answers "what would change" before a policy or a user's
+// attributes are edited. Each tuple is decided twice with opa (see opa.go):
+// once against what is stored and once with the proposed policies and
+// attributes swapped in. Nothing is written; only the simulation itself is
+// audited.
+//
+// A tuple (subject, object, action) is decided by the policies in the
+// object's effective chain (see objects.go), with input
+//
+//	{"subject": {"id": ..., "attrs": {...}}, "object": {"id": ...}, "action": ...}
+//
+// where attrs are the subject's attributes over their role defaults. An
+// object outside the tree has no policies and is denied. Logged access
+// checks are not replayed: they decide tables by grant date, and a table is
+// not an object in the tree, so no proposal could flip one.
+
+const maxSimulateTuples = 100
+
+var errInvalidSimulation = errors.New("invalid simulation")
+
+// simState is one side of a simulation: the stored data, or the stored data
+// with the proposed changes. Reads are memoised for the length of the
+// simulation.
+type simState struct {
+	s        *Server
+	policies map[string]Policy
+	attrs    map[string]interface{}
+	// proposed marks refs and user ids the proposal changes
+	proposed map[string]bool
+}
+
+func (s *Server) newSimState() *simState {
+	return &simState{s: s, policies: map[string]Policy{}, attrs: map[string]interface{}{}, proposed: map[string]bool{}}
+}
+
+func (st *simState) policy(ctx sqlctx.Context, ref string) (Policy, error) {
+	if p, ok := st.policies[ref]; ok {
+		return p, nil
+	}
+	var p Policy
+	done := timeQuery(ctx, FindPolicyQuery)
+	err := st.s.conn.QueryRowContext(ctx, FindPolicyQuery, ref).Scan(&p.Ref, &p.Content)
+	done(err)
+	if err != nil {
+		return p, fmt.Errorf("policy %s: %w", ref, err)
+	}
+	st.policies[ref] = p
+	return p, nil
+}
+
+// subjectAttrs returns a user's attributes over their role defaults, parsed
+// when they are JSON. A user that does not exist has none.
+func (st *simState) subjectAttrs(ctx sqlctx.Context, user_id string) (interface{}, error) {
+	if a, ok := st.attrs[user_id]; ok {
+		return a, nil
+	}
+	var u UserAttrs
+	done := timeQuery(ctx, FindUserAttrsQuery)
+	err := st.s.conn.QueryRowContext(ctx, FindUserAttrsQuery, user_id).Scan(&u.User_id, &u.Attrs)
+	done(err)
+	if errors.Is(err, sql.ErrNoRows) {
+		st.attrs[user_id] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return st.setAttrs(ctx, user_id, u.Attrs)
+}
+
+func (st *simState) setAttrs(ctx sqlctx.Context, user_id string, raw string) (interface{}, error) {
+	merged, err := st.s.withRoleDefaults(ctx, user_id, raw)
+	if err != nil {
+		return nil, err
+	}
+	var attrs interface{} = merged
+	var parsed interface{}
+	if json.Unmarshal([]byte(merged), &parsed) == nil {
+		attrs = parsed
+	}
+	st.attrs[user_id] = attrs
+	return attrs, nil
+}
+
+// decide evaluates t against the policies in chain.
+func (st *simState) decide(ctx sqlctx.Context, t SimTuple, chain []string) (string, error) {
+	policies := make([]Policy, 0, len(chain))
+	for _, ref := range chain {
+		p, err := st.policy(ctx, ref)
+		if err != nil {
+			return "", err
+		}
+		policies = append(policies, p)
+	}
+	attrs, err := st.subjectAttrs(ctx, t.Subject)
+	if err != nil {
+		return "", err
+	}
+	input := map[string]interface{}{
+		"subject": map[string]interface{}{"id": t.Subject, "attrs": attrs},
+		"object":  map[string]interface{}{"id": t.Object},
+		"action":  t.Action,
+	}
+	allowed, err := st.s.evalPolicies(ctx, policies, input)
+	if err != nil {
+		return "", err
+	}
+	if allowed {
+		return DecisionAllow, nil
+	}
+	return DecisionDeny, nil
+}
+
+// touches reports whether the proposal changes anything t depends on.
+func (st *simState) touches(t SimTuple, chain []string) bool {
+	if st.proposed["user:"+t.Subject] {
+		return true
+	}
+	for _, ref := range chain {
+		if st.proposed["policy:"+ref] {
+			return true
+		}
+	}
+	return false
+}
+
+// simulate decides every tuple in req before and after its proposal.
+func (s *Server) simulate(ctx sqlctx.Context, req SimulateRequest) (SimulateReport, error) {
+	report := SimulateReport{Results: []SimResult{}}
+	if len(req.Tuples) > maxSimulateTuples {
+		return report, fmt.Errorf("%w: at most %d tuples", errInvalidSimulation, maxSimulateTuples)
+	}
+	if len(req.Tuples) == 0 {
+		return report, fmt.Errorf("%w: give tuples to decide", errInvalidSimulation)
+	}
+	var results []SimResult
+	for i, t := range req.Tuples {
+		if t.Subject == "" || t.Object == "" || t.Action == "" {
+			return report, fmt.Errorf("%w: tuple %d needs subject, object and action", errInvalidSimulation, i)
+		}
+		results = append(results, SimResult{SimTuple: t})
+	}
+
+	before, after := s.newSimState(), s.newSimState()
+	for _, p := range req.Policies {
+		if p.Ref == "" {
+			return report, fmt.Errorf("%w: proposed policies need a ref", errInvalidSimulation)
+		}
+		if _, err := regoPackage(p.Content); err != nil {
+			return report, fmt.Errorf("%w: policy %s: %v", errInvalidSimulation, p.Ref, err)
+		}
+		after.policies[p.Ref] = p
+		after.proposed["policy:"+p.Ref] = true
+	}
+	for _, u := range req.Users {
+		if u.User_id == "" {
+			return report, fmt.Errorf("%w: proposed users need a user_id", errInvalidSimulation)
+		}
+		if _, err := after.setAttrs(ctx, u.User_id, u.Attrs); err != nil {
+			return report, err
+		}
+		after.proposed["user:"+u.User_id] = true
+	}
+
+	for _, r := range results {
+		r.Before, r.After = "", ""
+		var chain []string
+		c, err := s.policyChain(ctx, r.Object, r.Action)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			r.Error = err.Error()
+		default:
+			chain = c.Policy_refs
+		}
+		if r.Error == "" {
+			r.Before, err = before.decide(ctx, r.SimTuple, chain)
+			if err == nil {
+				r.After = r.Before
+				if after.touches(r.SimTuple, chain) {
+					r.After, err = after.decide(ctx, r.SimTuple, chain)
+				}
+			}
+			if errors.Is(err, errOPAUnavailable) {
+				return report, err
+			}
+			if err != nil {
+				r.Error = err.Error()
+			}
+		}
+
+		report.Evaluated++
+		switch {
+		case r.Error != "":
+			report.Errors++
+			simulations.WithLabelValues("error").Inc()
+		case r.Before != r.After:
+			r.Flipped = true
+			report.Flipped++
+			simulations.WithLabelValues("flipped").Inc()
+		default:
+			simulations.WithLabelValues("unchanged").Inc()
+		}
+		report.Results = append(report.Results, r)
+	}
+
+	s.audit.Record(ctx, AuditRecord{Action: AuditSimulate, Target_type: "simulation", After: toAuditJSON(map[string]interface{}{
+		"policies": len(req.Policies), "users": len(req.Users), "evaluated": report.Evaluated, "flipped": report.Flipped, "errors": report.Errors})})
+	return report, nil
+}
+
+// SimulateV1 reports which decisions a proposed policy or attribute change
+// would flip. With ?flipped=true only flipped and failed decisions are
+// listed; the counts always cover all of them.
+func (s *Server) SimulateV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req SimulateRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		ctx := context.Request.Context()
+		report, err := s.simulate(ctx, req)
+		switch {
+		case errors.Is(err, errInvalidSimulation):
+			v1Error(context, http.StatusBadRequest, err.Error())
+			return
+		case errors.Is(err, errOPAUnavailable):
+			s.log.ErrorContext(ctx, "cannot simulate", "err", err)
+			v1Error(context, http.StatusServiceUnavailable, "policy evaluation is unavailable: "+errOPAUnavailable.Error())
+			return
+		case err != nil:
+			s.log.ErrorContext(ctx, "unable to simulate", "err", err)
+			v1Error(context, http.StatusInternalServerError, "database error")
+			return
+		}
+		if context.Query("flipped") == "true" {
+			kept := []SimResult{}
+			for _, r := range report.Results {
+				if r.Flipped || r.Error != "" {
+					kept = append(kept, r)
+				}
+			}
+			report.Results = kept
+		}
+		context.JSON(http.StatusOK, report)
+	}
+}
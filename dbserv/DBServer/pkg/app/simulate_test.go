@@ -0,0 +1,77 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestSimulateValidation(t *testing.T) {
+	s, _ := newTestServer(t)
+	tuple := SimTuple{Subject: "alice", Object: "room1", Action: "enter"}
+	for name, req := range map[string]SimulateRequest{
+		"nothing to decide":      {},
+		"too many":               {Tuples: make([]SimTuple, maxSimulateTuples+1)},
+		"partial tuple":          {Tuples: []SimTuple{{Subject: "alice", Object: "room1"}}},
+		"policy without ref":     {Tuples: []SimTuple{tuple}, Policies: []Policy{{Content: "package p"}}},
+		"policy without package": {Tuples: []SimTuple{tuple}, Policies: []Policy{{Ref: "door", Content: "allow { true }"}}},
+		"user without id":        {Tuples: []SimTuple{tuple}, Users: []UserAttrs{{Attrs: "{}"}}},
+	} {
+		if _, err := s.simulate(sqlctx.Background(), req); !errors.Is(err, errInvalidSimulation) {
+			t.Errorf("%s: %v, want errInvalidSimulation", name, err)
+		}
+	}
+}
+
+// A proposed attribute change flips the decision of a tuple it touches,
+// while a tuple on an object outside the tree is denied on both sides
+// without running opa.
+func TestSimulateFlips(t *testing.T) {
+	s, mock := newTestServer(t)
+	fakeOPA(t, s, `{"builtins": []}`, `if grep -q '"region":"eu"' input.json; then v=true; else v=false; fi; echo "{\"result\": [{\"expressions\": [{\"value\": [$v]}]}]}"`)
+	noRoles := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(ListUserRolesQuery)).WillReturnRows(sqlmock.NewRows(userRoleColumns))
+	}
+	door := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WithArgs("door").
+			WillReturnRows(sqlmock.NewRows([]string{"ref", "content"}).AddRow("door", "package door"))
+	}
+
+	// the proposed attributes are resolved up front
+	noRoles()
+
+	mock.ExpectQuery(regexp.QuoteMeta(FindObjectParentQuery)).WithArgs("room1").
+		WillReturnRows(sqlmock.NewRows([]string{"parent_id"}).AddRow(nil))
+	mock.ExpectQuery(regexp.QuoteMeta(ListObjectActionRefsQuery)).WithArgs("room1", "enter").
+		WillReturnRows(sqlmock.NewRows([]string{"policy_ref"}).AddRow("door"))
+	door()
+	mock.ExpectQuery(regexp.QuoteMeta(FindUserAttrsQuery)).WithArgs("alice").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "attrs"}).AddRow("alice", `{"region": "us"}`))
+	noRoles()
+	door()
+
+	mock.ExpectQuery(regexp.QuoteMeta(FindObjectParentQuery)).WithArgs("orders").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta(FindUserAttrsQuery)).WithArgs("bob").WillReturnError(sql.ErrNoRows)
+	expectAudit(mock, "system", AuditSimulate)
+
+	report, err := s.simulate(sqlctx.Background(), SimulateRequest{
+		Users:  []UserAttrs{{User_id: "alice", Attrs: `{"region": "eu"}`}},
+		Tuples: []SimTuple{{Subject: "alice", Object: "room1", Action: "enter"}, {Subject: "bob", Object: "orders", Action: "read"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Evaluated != 2 || report.Flipped != 1 || report.Errors != 0 {
+		t.Fatalf("report = %+v", report)
+	}
+	if r := report.Results[0]; !r.Flipped || r.Before != DecisionDeny || r.After != DecisionAllow {
+		t.Errorf("alice = %+v", r)
+	}
+	if r := report.Results[1]; r.Flipped || r.Before != DecisionDeny || r.After != DecisionDeny {
+		t.Errorf("bob = %+v", r)
+	}
+}
@@ -0,0 +1,277 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// The expiry sweeper looks after db_access rows whose access date passes.
+// Every Config.SweepInterval it
+//
+//   - sends a grant.expiring webhook for each grant lapsing within
+//     ExpiryWindowDays, once per access date: grant_expiry_notices remembers
+//     what was announced, so a renewed grant is announced again when its new
+//     date comes near;
+//   - copies grants that expired more than ArchiveAfterDays ago into
+//     db_access_history, once per access date, sending grant.expired and
+//     auditing each one.
+//
+// Archiving leaves the db_access row in place, since every grant path,
+// tokens and access requests alike, renews an existing row: an archived
+// grant can still be renewed, and is archived again once its new date has
+// long passed. Several servers may sweep the same database: notices are
+// claimed with INSERT IGNORE and archiving locks the rows it copies.
+
+const (
+	sweepBatch              = 500
+	defaultExpiryWindowDays = 7
+	defaultArchiveAfterDays = 30
+	sweeperActor            = "sweeper"
+)
+
+type sweepResult struct {
+	notified int
+	archived int
+}
+
+// runSweeper sweeps right away and then every Config.SweepInterval until
+// ctx is done.
+func (s *Server) runSweeper(ctx sqlctx.Context) {
+	if s.cfg.SweepInterval <= 0 {
+		return
+	}
+	ctx = WithActor(ctx, sweeperActor)
+	ticker := time.NewTicker(s.cfg.SweepInterval)
+	defer ticker.Stop()
+	for {
+		start := time.Now()
+		res, err := s.sweep(ctx, start)
+		sweeperDuration.Observe(time.Since(start).Seconds())
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return
+		case err != nil:
+			sweeperRuns.WithLabelValues("error").Inc()
+			s.log.WarnContext(ctx, "grant sweep failed", "notified", res.notified, "archived", res.archived, "err", err)
+		default:
+			sweeperRuns.WithLabelValues("ok").Inc()
+			sweeperLastSuccess.SetToCurrentTime()
+			s.log.InfoContext(ctx, "grant sweep done", "notified", res.notified, "archived", res.archived)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweep runs one pass as of now. The counts cover the work done before an
+// error, too.
+func (s *Server) sweep(ctx sqlctx.Context, now time.Time) (sweepResult, error) {
+	var res sweepResult
+	today := now.UTC().Truncate(24 * time.Hour)
+	n, err := s.notifyExpiring(ctx, today, today.AddDate(0, 0, s.cfg.ExpiryWindowDays))
+	res.notified = n
+	if err != nil {
+		return res, err
+	}
+	n, err = s.archiveExpired(ctx, today.AddDate(0, 0, -s.cfg.ArchiveAfterDays))
+	res.archived = n
+	return res, err
+}
+
+// notifyExpiring announces grants that are still valid today but lapse by
+// until and have not been announced for their current date.
+func (s *Server) notifyExpiring(ctx sqlctx.Context, today time.Time, until time.Time) (int, error) {
+	notified := 0
+	for {
+		rows, err := s.expiringBatch(ctx, today, until)
+		if err != nil {
+			return notified, err
+		}
+		for _, g := range rows {
+			claimed, err := s.announceExpiring(ctx, g, today)
+			if err != nil {
+				return notified, err
+			}
+			if !claimed {
+				// another server announced it between our read and insert
+				continue
+			}
+			sweeperGrants.WithLabelValues("notified").Inc()
+			notified++
+		}
+		if notified > 0 {
+			s.webhooks.notify()
+		}
+		if len(rows) < sweepBatch {
+			return notified, nil
+		}
+	}
+}
+
+func (s *Server) expiringBatch(ctx sqlctx.Context, today time.Time, until time.Time) ([]DBAccess, error) {
+	ctx, cancelfunc := sqlctx.WithTimeout(ctx, queryTimeout)
+	defer cancelfunc()
+	done := timeQuery(ctx, ListUnnotifiedExpiringQuery)
+	res, err := s.conn.QueryContext(ctx, ListUnnotifiedExpiringQuery, today.Format("2006-01-02"), until.Format("2006-01-02"), sweepBatch)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return scanGrants(res)
+}
+
+// announceExpiring claims the notice for g's access date and queues its
+// grant.expiring events in one transaction.
+func (s *Server) announceExpiring(ctx sqlctx.Context, g DBAccess, today time.Time) (bool, error) {
+	ctx, cancelfunc := sqlctx.WithTimeout(ctx, queryTimeout)
+	defer cancelfunc()
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+	done := timeQuery(ctx, InsertExpiryNoticeQuery)
+	res, err := tx.ExecContext(ctx, InsertExpiryNoticeQuery, g.User_id, g.Table_name, g.Db_access_date, time.Now().UTC().Format(auditTimeLayout))
+	done(err)
+	if err != nil {
+		return false, err
+	}
+	if n, err := res.RowsAffected(); err != nil || n != 1 {
+		return false, err
+	}
+	days := 0
+	if d, err := time.Parse("2006-01-02", g.Db_access_date); err == nil {
+		days = int(d.Sub(today).Hours() / 24)
+	}
+	err = s.queueWebhook(ctx, tx, WebhookEvent{Type: WebhookGrantExpiring, User_id: g.User_id, Table_name: g.Table_name, Days: days,
+		Db_access_date: g.Db_access_date, Db_deny_date: g.Db_deny_date})
+	if err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// archiveExpired copies grants whose access date is on or before cutoff,
+// and not yet archived with that date, to db_access_history, one locked
+// batch per transaction.
+func (s *Server) archiveExpired(ctx sqlctx.Context, cutoff time.Time) (int, error) {
+	archived := 0
+	day := cutoff.Format("2006-01-02")
+	for {
+		moved, err := s.archiveBatch(ctx, day)
+		if err != nil {
+			return archived, err
+		}
+		for _, g := range moved {
+			s.audit.Record(ctx, AuditRecord{Action: AuditArchive, Target_type: "grant", Target_id: g.User_id + "/" + g.Table_name,
+				Before: toAuditJSON(g), Decision: DecisionDeny})
+			sweeperGrants.WithLabelValues("archived").Inc()
+		}
+		if len(moved) > 0 {
+			s.webhooks.notify()
+		}
+		archived += len(moved)
+		if len(moved) < sweepBatch {
+			break
+		}
+	}
+
+	// archived dates are long past and will not be announced again
+	ctx, cancelfunc := sqlctx.WithTimeout(ctx, queryTimeout)
+	defer cancelfunc()
+	done := timeQuery(ctx, DeleteExpiryNoticesQuery)
+	_, err := s.conn.ExecContext(ctx, DeleteExpiryNoticesQuery, day)
+	done(err)
+	return archived, err
+}
+
+func (s *Server) archiveBatch(ctx sqlctx.Context, cutoff string) ([]DBAccess, error) {
+	ctx, cancelfunc := sqlctx.WithTimeout(ctx, queryTimeout)
+	defer cancelfunc()
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	done := timeQuery(ctx, LockExpiredGrantsQuery)
+	res, err := tx.QueryContext(ctx, LockExpiredGrantsQuery, cutoff, sweepBatch)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := scanGrants(res)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().Format(auditTimeLayout)
+	for _, g := range grants {
+		deny := sql.NullString{String: g.Db_deny_date, Valid: g.Db_deny_date != ""}
+		done := timeQuery(ctx, InsertGrantHistoryQuery)
+		_, err := tx.ExecContext(ctx, InsertGrantHistoryQuery, g.User_id, g.Table_name, g.Db_access_date, deny, now)
+		done(err)
+		if err != nil {
+			return nil, fmt.Errorf("archiving %s/%s: %w", g.User_id, g.Table_name, err)
+		}
+		err = s.queueWebhook(ctx, tx, WebhookEvent{Type: WebhookGrantExpired, User_id: g.User_id, Table_name: g.Table_name,
+			Db_access_date: g.Db_access_date, Db_deny_date: g.Db_deny_date})
+		if err != nil {
+			return nil, fmt.Errorf("archiving %s/%s: %w", g.User_id, g.Table_name, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+// scanGrants reads and closes rows of user_id, tbl_name, db_access_date and
+// db_deny_date.
+func scanGrants(res *sql.Rows) ([]DBAccess, error) {
+	defer res.Close()
+	var grants []DBAccess
+	for res.Next() {
+		var g DBAccess
+		var deny sql.NullString
+		if err := res.Scan(&g.User_id, &g.Table_name, &g.Db_access_date, &deny); err != nil {
+			return nil, err
+		}
+		g.Db_deny_date = deny.String
+		grants = append(grants, g)
+	}
+	return grants, res.Err()
+}
+
+var grantHistoryColumns = []string{"id", "user_id", "tbl_name", "db_access_date", "db_deny_date", "archived_at"}
+
+// grantHistoryList lists archived grants, newest first. Filters: user_id
+// and table_name.
+var grantHistoryList = listSpec{
+	entity:   "grant_history",
+	query:    ListGrantHistoryQuery,
+	columns:  grantHistoryColumns,
+	keys:     []string{"id"},
+	sortable: []string{"id"},
+	order:    "desc",
+	build: func(row map[string]string) interface{} {
+		g := ArchivedGrant{User_id: row["user_id"], Table_name: row["tbl_name"], Db_access_date: row["db_access_date"],
+			Db_deny_date: row["db_deny_date"], Archived_at: row["archived_at"]}
+		fmt.Sscan(row["id"], &g.Id)
+		return g
+	},
+	filter: func(q listQuery, f *listFilter) error {
+		if u := q.get("user_id"); u != "" {
+			f.add("user_id = ?", u)
+		}
+		if t := q.get("table_name"); t != "" {
+			f.add("tbl_name = ?", t)
+		}
+		return nil
+	},
+}
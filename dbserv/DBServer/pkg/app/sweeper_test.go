@@ -0,0 +1,105 @@
+package app
+
+import (
+	sqlctx "context"
+	"database/sql/driver"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// payloadHas matches a webhook payload containing each of its fragments.
+type payloadHas []string
+
+func (p payloadHas) Match(v driver.Value) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+	for _, frag := range p {
+		if !strings.Contains(s, frag) {
+			return false
+		}
+	}
+	return true
+}
+
+var grantColumns = []string{"user_id", "tbl_name", "db_access_date", "db_deny_date"}
+
+// One sweep announces each grant lapsing within the window once, and
+// archives long expired grants to the history without deleting them.
+func TestSweep(t *testing.T) {
+	s, mock := newTestServer(t)
+	s.cfg = Config{ExpiryWindowDays: 7, ArchiveAfterDays: 30}
+	s.webhooks = newWebhookDispatcher([]WebhookEndpoint{{Name: "billing", Url: "http://b", Secret: "s", Max_attempts: 1}})
+	ctx := WithActor(sqlctx.Background(), sweeperActor)
+	now := time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(ListUnnotifiedExpiringQuery)).WithArgs("2024-03-10", "2024-03-17", sweepBatch).
+		WillReturnRows(sqlmock.NewRows(grantColumns).
+			AddRow("alice", "orders", "2024-03-13", "2024-01-01").
+			AddRow("bob", "orders", "2024-03-14", nil))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(InsertExpiryNoticeQuery)).WithArgs("alice", "orders", "2024-03-13", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(InsertWebhookDeliveryQuery)).
+		WithArgs(sqlmock.AnyArg(), "billing", WebhookGrantExpiring, payloadHas{`"user_id":"alice"`, `"days":3`}, DeliveryPending, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	// another server announced bob's grant first
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(InsertExpiryNoticeQuery)).WithArgs("bob", "orders", "2024-03-14", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(LockExpiredGrantsQuery)).WithArgs("2024-02-09", sweepBatch).
+		WillReturnRows(sqlmock.NewRows(grantColumns).AddRow("carol", "orders", "2024-01-01", nil))
+	mock.ExpectExec(regexp.QuoteMeta(InsertGrantHistoryQuery)).WithArgs("carol", "orders", "2024-01-01", nil, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(InsertWebhookDeliveryQuery)).
+		WithArgs(sqlmock.AnyArg(), "billing", WebhookGrantExpired, payloadHas{`"user_id":"carol"`}, DeliveryPending, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+	expectAudit(mock, sweeperActor, AuditArchive)
+	mock.ExpectExec(regexp.QuoteMeta(DeleteExpiryNoticesQuery)).WithArgs("2024-02-09").WillReturnResult(sqlmock.NewResult(0, 4))
+
+	res, err := s.sweep(ctx, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.notified != 1 || res.archived != 1 {
+		t.Errorf("result = %+v, want 1 notified and 1 archived", res)
+	}
+	select {
+	case <-s.webhooks.wake:
+	default:
+		t.Error("the sweep did not wake the webhook dispatcher")
+	}
+}
+
+// A failure archiving one grant rolls back its batch and stops the sweep,
+// keeping the count of what was done before.
+func TestSweepStopsOnArchiveFailure(t *testing.T) {
+	s, mock := newTestServer(t)
+	s.cfg = Config{ExpiryWindowDays: 7, ArchiveAfterDays: 30}
+	s.webhooks = newWebhookDispatcher(nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta(ListUnnotifiedExpiringQuery)).WillReturnRows(sqlmock.NewRows(grantColumns))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(LockExpiredGrantsQuery)).
+		WillReturnRows(sqlmock.NewRows(grantColumns).AddRow("carol", "orders", "2024-01-01", nil))
+	mock.ExpectExec(regexp.QuoteMeta(InsertGrantHistoryQuery)).WillReturnError(sqlmock.ErrCancelled)
+	mock.ExpectRollback()
+
+	res, err := s.sweep(sqlctx.Background(), time.Now())
+	if err == nil || !strings.Contains(err.Error(), "archiving carol/orders") {
+		t.Fatalf("err = %v", err)
+	}
+	if res.notified != 0 || res.archived != 0 {
+		t.Errorf("result = %+v", res)
+	}
+}
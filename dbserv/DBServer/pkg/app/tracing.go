@@ -0,0 +1,145 @@
+package app
+
+import (
+	sqlctx "context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	TraceNone = "none"
+	TraceOTLP = "otlp"
+	TraceFile = "file"
+
+	serviceName = "abac-dbserver"
+)
+
+// tracer goes through the global provider, so spans are dropped until
+// SetupTracing installs a real one.
+var tracer = otel.Tracer("RemoteTestServer/pkg/app")
+
+// SetupTracing installs the global tracer provider. exporter is one of
+// TraceNone, TraceOTLP or TraceFile. For TraceOTLP, target is the collector's
+// host:port (empty means the OTEL_EXPORTER_OTLP_* environment variables);
+// for TraceFile it is the path spans are appended to as JSON. The returned
+// function flushes pending spans and must be called before exit.
+func SetupTracing(ctx sqlctx.Context, exporter string, target string) (func(sqlctx.Context) error, error) {
+	var exp sdktrace.SpanExporter
+	var closeFile func() error
+	switch exporter {
+	case TraceNone, "":
+		return func(sqlctx.Context) error { return nil }, nil
+	case TraceOTLP:
+		var opts []otlptracehttp.Option
+		if target != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(target), otlptracehttp.WithInsecure())
+		}
+		e, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		exp = e
+	case TraceFile:
+		if target == "" {
+			return nil, fmt.Errorf("trace exporter %q needs a file", exporter)
+		}
+		f, err := os.OpenFile(target, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		e, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		exp, closeFile = e, f.Close
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q, expected none, otlp or file", exporter)
+	}
+
+	res, err := resource.Merge(resource.Default(),
+		resource.NewSchemaless(attribute.String("service.name", serviceName)))
+	if err != nil {
+		return nil, err
+	}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exp), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return func(ctx sqlctx.Context) error {
+		err := tp.Shutdown(ctx)
+		if closeFile != nil {
+			if cerr := closeFile(); err == nil {
+				err = cerr
+			}
+		}
+		return err
+	}, nil
+}
+
+// Tracing starts a server span for every request, continuing a trace passed
+// in W3C traceparent headers. Handlers see the span through
+// context.Request.Context().
+func (s *Server) Tracing() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		ctx := otel.GetTextMapPropagator().Extract(context.Request.Context(), propagation.HeaderCarrier(context.Request.Header))
+		route := context.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		ctx, span := tracer.Start(ctx, context.Request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", context.Request.Method),
+				attribute.String("http.route", route),
+				attribute.String("url.path", context.Request.URL.Path),
+				attribute.String("client.address", context.ClientIP()),
+			))
+		defer span.End()
+		context.Request = context.Request.WithContext(ctx)
+
+		context.Next()
+
+		status := context.Writer.Status()
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if id := metaFrom(context.Request.Context()).requestID; id != "" {
+			span.SetAttributes(attribute.String("request_id", id))
+		}
+		if status >= 500 {
+			span.SetStatus(codes.Error, strconv.Itoa(status))
+		}
+	}
+}
+
+// startQuerySpan opens a client span for one SQL statement. Only the query
+// constant's name is recorded, never the parameters.
+func startQuerySpan(ctx sqlctx.Context, name string) trace.Span {
+	_, span := tracer.Start(ctx, "sql "+name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system.name", "mysql"),
+			attribute.String("db.query.name", name),
+		))
+	return span
+}
+
+// endSpan records err, if any, and ends span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,85 @@
+package app
+
+import (
+	sqlctx "context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var (
+	spanRecorder    = tracetest.NewSpanRecorder()
+	installRecorder sync.Once
+)
+
+// recordSpans installs spanRecorder as the global tracer provider. The
+// package tracer binds to the first provider set, so it is installed once
+// per process and tests look only at the spans ended after they start.
+func recordSpans() {
+	installRecorder.Do(func() {
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+	})
+}
+
+func TestTracingSpans(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	recordSpans()
+	before := len(spanRecorder.Ended())
+
+	s, _ := newTestServer(t)
+	r := gin.New()
+	r.Use(s.Tracing())
+	r.GET("/policies/:ref", func(context *gin.Context) {
+		timeQuery(context.Request.Context(), FindPolicyQuery)(nil)
+		context.Status(http.StatusInternalServerError)
+	})
+	req := httptest.NewRequest(http.MethodGet, "/policies/p1", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := spanRecorder.Ended()[before:]
+	if len(spans) != 2 {
+		t.Fatalf("%d spans ended, want 2", len(spans))
+	}
+	query, server := spans[0], spans[1]
+	if server.Name() != "GET /policies/:ref" || server.Status().Code != codes.Error {
+		t.Errorf("server span %q, status %v", server.Name(), server.Status())
+	}
+	if got := server.SpanContext().TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("trace id %s, want the caller's", got)
+	}
+	if server.Parent().SpanID().String() != "00f067aa0ba902b7" {
+		t.Errorf("server span parent %s, want the caller's span", server.Parent().SpanID())
+	}
+	if query.Name() != "sql FindPolicyQuery" || query.Parent().SpanID() != server.SpanContext().SpanID() {
+		t.Errorf("query span %q is not a child of the request", query.Name())
+	}
+	for _, kv := range query.Attributes() {
+		if kv.Key == "db.query.name" && kv.Value != attribute.StringValue("FindPolicyQuery") {
+			t.Errorf("db.query.name = %v", kv.Value.Emit())
+		}
+	}
+}
+
+func TestSetupTracingExporters(t *testing.T) {
+	shutdown, err := SetupTracing(sqlctx.Background(), TraceNone, "")
+	if err != nil || shutdown(sqlctx.Background()) != nil {
+		t.Fatalf("none exporter: %v", err)
+	}
+	if _, err := SetupTracing(sqlctx.Background(), TraceFile, ""); err == nil {
+		t.Error("the file exporter was set up without a file")
+	}
+	if _, err := SetupTracing(sqlctx.Background(), "zipkin", ""); err == nil {
+		t.Error("an unknown exporter was accepted")
+	}
+}
@@ -44,6 +44,13 @@ type DevCheckInfo struct {
 	Attrs    string `json:"attrs"`
 }
 
+type DevInfo struct {
+	Dev_id   string `json:"dev_id"`
+	Dev_type string `json:"dev_type"`
+	Actions  string `json:"actions"`
+	Attrs    string `json:"attrs"`
+}
+
 type DevActions struct {
 	Dev_id  string `json:"dev_id"`
 	Actions string `json:"actions"`
@@ -117,6 +124,372 @@ type UpdateUserAttrsRequest struct {
 	Attrs    string `json:"attrs"`
 }
 
+type ListResult struct {
+	Items       interface{} `json:"items"`
+	Next_cursor string      `json:"next_cursor,omitempty"`
+}
+
+type ImportRowError struct {
+	Row   int    `json:"row"`
+	Key   string `json:"key,omitempty"`
+	Error string `json:"error"`
+}
+
+type ImportReport struct {
+	Entity    string           `json:"entity"`
+	Format    string           `json:"format"`
+	Dry_run   bool             `json:"dry_run"`
+	Total     int              `json:"total"`
+	Valid     int              `json:"valid"`
+	Failed    int              `json:"failed"`
+	Committed bool             `json:"committed"`
+	Errors    []ImportRowError `json:"errors"`
+}
+
+type AuditRecord struct {
+	Id          int64  `json:"id"`
+	Ts          string `json:"ts"`
+	Request_id  string `json:"request_id"`
+	Actor       string `json:"actor"`
+	Action      string `json:"action"`
+	Target_type string `json:"target_type"`
+	Target_id   string `json:"target_id"`
+	Before      string `json:"before,omitempty"`
+	After       string `json:"after,omitempty"`
+	Decision    string `json:"decision,omitempty"`
+	Prev_hash   string `json:"prev_hash"`
+	Hash        string `json:"hash"`
+}
+
+type AuditCheckpoint struct {
+	Id         int64  `json:"id"`
+	Record_id  int64  `json:"record_id"`
+	Hash       string `json:"hash"`
+	Key_id     string `json:"key_id"`
+	Signature  string `json:"signature"`
+	Created_at string `json:"created_at"`
+}
+
+type AuditVerifyReport struct {
+	Records     int    `json:"records"`
+	Legacy      int    `json:"legacy"`
+	Checkpoints int    `json:"checkpoints"`
+	Ok          bool   `json:"ok"`
+	Broken_at   int64  `json:"broken_at,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+type ChangeEvent struct {
+	Seq         int64  `json:"seq"`
+	Ts          string `json:"ts"`
+	Entity_type string `json:"entity_type"`
+	Entity_id   string `json:"entity_id"`
+	Version     int64  `json:"version"`
+}
+
+type ReadyReport struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+type PoolStats struct {
+	Max_open            int     `json:"max_open"`
+	Open                int     `json:"open"`
+	In_use              int     `json:"in_use"`
+	Idle                int     `json:"idle"`
+	Wait_count          int64   `json:"wait_count"`
+	Wait_seconds        float64 `json:"wait_seconds"`
+	Max_idle_closed     int64   `json:"max_idle_closed"`
+	Max_lifetime_closed int64   `json:"max_lifetime_closed"`
+}
+
+type StatusReport struct {
+	Version        string            `json:"version"`
+	Revision       string            `json:"revision,omitempty"`
+	Go_version     string            `json:"go_version"`
+	Started_at     string            `json:"started_at"`
+	Uptime_seconds int64             `json:"uptime_seconds"`
+	Schema_version int               `json:"schema_version"`
+	Policies       int               `json:"policies"`
+	Config         map[string]string `json:"config"`
+	Pool           PoolStats         `json:"pool"`
+}
+
+// WebhookEndpoint is one entry of the webhooks file (see webhooks.go).
+// Events limits it to those event types; empty means all.
+type WebhookEndpoint struct {
+	Name         string   `json:"name"`
+	Url          string   `json:"url"`
+	Secret       string   `json:"secret,omitempty"`
+	Events       []string `json:"events,omitempty"`
+	Max_attempts int      `json:"max_attempts,omitempty"`
+}
+
+// WebhookEvent is the body POSTed to webhook endpoints when a grant extends
+// (grant.allow) or denies (grant.deny) a user's access to a table, is about
+// to lapse (grant.expiring, Days left) or is archived (grant.expired).
+type WebhookEvent struct {
+	Id             string `json:"id"`
+	Type           string `json:"type"`
+	Ts             string `json:"ts"`
+	Request_id     string `json:"request_id,omitempty"`
+	User_id        string `json:"user_id"`
+	Table_name     string `json:"table_name"`
+	Days           int    `json:"days"`
+	Db_access_date string `json:"db_access_date"`
+	Db_deny_date   string `json:"db_deny_date"`
+}
+
+// GrantIssuer is one entry of the grant issuers file (see grantissue.go):
+// who may mint grant tokens, for which users and tables, and for how long.
+type GrantIssuer struct {
+	Name            string   `json:"name"`
+	Token_sha256    string   `json:"token_sha256"`
+	Users           []string `json:"users,omitempty"`
+	Tables          []string `json:"tables"`
+	Max_days        int      `json:"max_days"`
+	Allow_always    bool     `json:"allow_always,omitempty"`
+	Allow_once      bool     `json:"allow_once,omitempty"`
+	Max_ttl_seconds int      `json:"max_ttl_seconds,omitempty"`
+}
+
+// AdminToken is one entry of the admin tokens file (see adminauth.go).
+type AdminToken struct {
+	Name         string `json:"name"`
+	Token_sha256 string `json:"token_sha256"`
+}
+
+// GrantSpec is one table of a grant token request. Exactly one of Days,
+// Always and Once says how long an allow lasts; a deny takes Days.
+type GrantSpec struct {
+	Table_name string `json:"table_name"`
+	Decision   string `json:"decision"`
+	Days       int    `json:"days,omitempty"`
+	Always     bool   `json:"always,omitempty"`
+	Once       bool   `json:"once,omitempty"`
+}
+
+type IssueGrantRequest struct {
+	User_id     string      `json:"user_id"`
+	Grants      []GrantSpec `json:"grants"`
+	Ttl_seconds int         `json:"ttl_seconds,omitempty"`
+}
+
+// IssuedGrant is a signed grant token and the claims it carries.
+type IssuedGrant struct {
+	Token      string `json:"token"`
+	Jti        string `json:"jti"`
+	Issuer     string `json:"issuer"`
+	User_id    string `json:"user_id"`
+	Sub        string `json:"sub"`
+	Issued_at  string `json:"issued_at"`
+	Expires_at string `json:"expires_at"`
+}
+
+// ArchivedGrant is a db_access row the sweeper moved to db_access_history
+// (see sweeper.go).
+type ArchivedGrant struct {
+	Id             int64  `json:"id"`
+	User_id        string `json:"user_id"`
+	Table_name     string `json:"table_name"`
+	Db_access_date string `json:"db_access_date"`
+	Db_deny_date   string `json:"db_deny_date"`
+	Archived_at    string `json:"archived_at"`
+}
+
+// Role bundles table grants and attribute defaults for the users assigned
+// to it, directly or through a group (see roles.go). Grants and Members are
+// filled in when one role is read.
+type Role struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Attrs       string       `json:"attrs,omitempty"`
+	Updated_at  string       `json:"updated_at,omitempty"`
+	Grants      []RoleGrant  `json:"grants,omitempty"`
+	Members     []RoleMember `json:"members,omitempty"`
+}
+
+type RoleGrant struct {
+	Table_name     string `json:"table_name"`
+	Db_access_date string `json:"db_access_date,omitempty"`
+}
+
+type RoleMember struct {
+	Kind string `json:"kind"`
+	Id   string `json:"id"`
+}
+
+// UserRole is one role a user holds; Via is "user" for a direct assignment
+// or "group:<name>".
+type UserRole struct {
+	Role string `json:"role"`
+	Via  string `json:"via"`
+}
+
+type GroupMembers struct {
+	Group   string   `json:"group"`
+	Members []string `json:"members"`
+}
+
+// ObjectNode is one object in the object tree (see objects.go). Policies
+// and Children are filled in when one object is read.
+type ObjectNode struct {
+	Obj_id     string         `json:"obj_id"`
+	Parent_id  string         `json:"parent_id,omitempty"`
+	Kind       string         `json:"kind,omitempty"`
+	Updated_at string         `json:"updated_at,omitempty"`
+	Policies   []ObjectPolicy `json:"policies,omitempty"`
+	Children   []string       `json:"children,omitempty"`
+}
+
+// ObjectPolicy is the policy refs attached to an object for one action, in
+// evaluation order.
+type ObjectPolicy struct {
+	Action      string   `json:"action"`
+	Policy_refs []string `json:"policy_refs"`
+}
+
+// PolicyChain is what an object inherits for an action: Chain runs from the
+// root down to the object, and Policy_refs flattens it in the same order.
+type PolicyChain struct {
+	Obj_id      string            `json:"obj_id"`
+	Action      string            `json:"action"`
+	Chain       []PolicyChainLink `json:"chain"`
+	Policy_refs []string          `json:"policy_refs"`
+}
+
+type PolicyChainLink struct {
+	Obj_id      string   `json:"obj_id"`
+	Policy_refs []string `json:"policy_refs"`
+}
+
+// SimulateRequest proposes policy contents (by ref) and user attributes,
+// and names the decisions to try them on. Nothing is stored.
+type SimulateRequest struct {
+	Policies []Policy    `json:"policies,omitempty"`
+	Users    []UserAttrs `json:"users,omitempty"`
+	Tuples   []SimTuple  `json:"tuples,omitempty"`
+}
+
+type SimTuple struct {
+	Subject string `json:"subject"`
+	Object  string `json:"object"`
+	Action  string `json:"action"`
+}
+
+// accessCheck is the after value of an AuditCheck record. It names the
+// user and table apart, since either may contain the "/" that joins them in
+// target_id.
+type accessCheck struct {
+	User_id    string `json:"user_id"`
+	Table_name string `json:"table_name"`
+}
+
+// SimResult is one decision before and after the proposed change.
+type SimResult struct {
+	SimTuple
+	Before  string `json:"before,omitempty"`
+	After   string `json:"after,omitempty"`
+	Flipped bool   `json:"flipped"`
+	Error   string `json:"error,omitempty"`
+}
+
+type SimulateReport struct {
+	Evaluated int         `json:"evaluated"`
+	Flipped   int         `json:"flipped"`
+	Errors    int         `json:"errors"`
+	Results   []SimResult `json:"results"`
+}
+
+// PolicyTest is a Rego test module stored with a policy (see
+// policytests.go).
+type PolicyTest struct {
+	Policy_ref string `json:"policy_ref"`
+	Name       string `json:"name"`
+	Content    string `json:"content"`
+	Updated_at string `json:"updated_at,omitempty"`
+}
+
+// PolicyTestReport is one run of a policy's tests. Error is set when they
+// could not run, e.g. because a module does not compile; Passed is then
+// false. Coverage is of the policy alone, in percent.
+type PolicyTestReport struct {
+	Policy_ref        string             `json:"policy_ref"`
+	Passed            bool               `json:"passed"`
+	Total             int                `json:"total"`
+	Failed            int                `json:"failed"`
+	Error             string             `json:"error,omitempty"`
+	Results           []PolicyTestResult `json:"results"`
+	Coverage          float64            `json:"coverage"`
+	Covered_lines     int                `json:"covered_lines"`
+	Not_covered_lines int                `json:"not_covered_lines"`
+	Not_covered       []LineRange        `json:"not_covered,omitempty"`
+}
+
+// PolicyTestResult is one test rule. Test names the stored module it is in.
+type PolicyTestResult struct {
+	Test        string  `json:"test"`
+	Package     string  `json:"package"`
+	Name        string  `json:"name"`
+	Result      string  `json:"result"`
+	Error       string  `json:"error,omitempty"`
+	Duration_ms float64 `json:"duration_ms"`
+}
+
+// LineRange is a span of policy lines, both ends included.
+type LineRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// AccessRequest asks for Days more days of access to a table (see
+// accessrequests.go). History is filled in when one request is read.
+type AccessRequest struct {
+	Id         int64                `json:"id"`
+	User_id    string               `json:"user_id"`
+	Table_name string               `json:"table_name"`
+	Days       int                  `json:"days"`
+	Reason     string               `json:"reason,omitempty"`
+	Status     string               `json:"status"`
+	Decided_by string               `json:"decided_by,omitempty"`
+	Decided_at string               `json:"decided_at,omitempty"`
+	Created_at string               `json:"created_at"`
+	History    []AccessRequestEvent `json:"history,omitempty"`
+}
+
+type AccessRequestEvent struct {
+	Ts      string `json:"ts"`
+	Actor   string `json:"actor"`
+	Action  string `json:"action"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type NewAccessRequest struct {
+	User_id    string `json:"user_id"`
+	Table_name string `json:"table_name"`
+	Days       int    `json:"days"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+type AccessRequestDecision struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+type WebhookDelivery struct {
+	Id              int64  `json:"id"`
+	Event_id        string `json:"event_id"`
+	Webhook         string `json:"webhook"`
+	Event_type      string `json:"event_type"`
+	Payload         string `json:"payload"`
+	Status          string `json:"status"`
+	Attempts        int    `json:"attempts"`
+	Next_attempt_at string `json:"next_attempt_at"`
+	Last_status     int    `json:"last_status,omitempty"`
+	Last_error      string `json:"last_error,omitempty"`
+	Created_at      string `json:"created_at"`
+	Delivered_at    string `json:"delivered_at,omitempty"`
+}
+
 const (
 	FindPolicyQuery            = "SELECT ref, content FROM rego_policy_repository WHERE ref=? LIMIT 1"
 	InsertPolicyQuery          = "INSERT INTO rego_policy_repository(ref, content) VALUES(?, ?)" //use generated keys?
@@ -137,4 +510,104 @@ const (
 	FindAccessDateQuery        = "SELECT user_id, tbl_name, db_access_date, db_deny_date FROM db_access WHERE user_id=? AND tbl_name=? LIMIT 1"
 	UpdateSecureDBAllowQuery   = "UPDATE db_access SET db_access_date=? WHERE user_id=? AND tbl_name=?"
 	UpdateSecureDBDenyQuery    = "UPDATE db_access SET db_deny_date=? WHERE user_id=? AND tbl_name=?"
+
+	ListPolicyQuery     = "SELECT ref, content FROM rego_policy_repository"
+	ListHierarchyQuery  = "SELECT obj_id, action, hierarchy FROM object_action_policy_hierarchy"
+	ListUserAttrsQuery  = "SELECT user_id, attrs FROM user_attrs"
+	ListDevInfoQuery    = "SELECT dev_id, dev_type, actions, attrs FROM dev_info"
+	ListAccessDateQuery = "SELECT user_id, tbl_name, db_access_date, db_deny_date FROM db_access"
+
+	ExportPolicyQuery     = "SELECT ref, content FROM rego_policy_repository ORDER BY ref"
+	ExportHierarchyQuery  = "SELECT obj_id, action, hierarchy FROM object_action_policy_hierarchy ORDER BY obj_id, action"
+	ExportUserAttrsQuery  = "SELECT user_id, pwd, attrs FROM user_attrs ORDER BY user_id"
+	ExportDevInfoQuery    = "SELECT dev_id, dev_type, actions, token, attrs FROM dev_info ORDER BY dev_id"
+	ExportAccessDateQuery = "SELECT user_id, tbl_name, db_access_date, db_deny_date FROM db_access ORDER BY user_id, tbl_name"
+
+	CreateSchemaMigrationsQuery = "CREATE TABLE IF NOT EXISTS schema_migrations(version INT NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL, applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP)"
+	FindSchemaVersionQuery      = "SELECT MAX(version) FROM schema_migrations"
+	InsertSchemaVersionQuery    = "INSERT INTO schema_migrations(version, name) VALUES(?, ?)"
+	CountColumnQuery            = "SELECT COUNT(*) FROM information_schema.columns WHERE table_schema=DATABASE() AND table_name=? AND column_name=?"
+
+	InsertAuditLogQuery = "INSERT INTO audit_log(ts, request_id, actor, action, target_type, target_id, before_val, after_val, decision, prev_hash, hash) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	ListAuditLogQuery   = "SELECT id, ts, request_id, actor, action, target_type, target_id, before_val, after_val, decision, prev_hash, hash FROM audit_log"
+	LockAuditHeadQuery  = "SELECT hash FROM audit_head WHERE id=1 FOR UPDATE"
+	MoveAuditHeadQuery  = "UPDATE audit_head SET hash=? WHERE id=1"
+	FindAuditHeadQuery  = "SELECT id, hash FROM audit_log ORDER BY id DESC LIMIT 1"
+	FindAuditHashQuery  = "SELECT hash FROM audit_log WHERE id=?"
+	ScanAuditLogQuery   = ListAuditLogQuery + " ORDER BY id"
+
+	InsertAuditCheckpointQuery = "INSERT INTO audit_checkpoint(record_id, hash, key_id, signature, created_at) VALUES(?, ?, ?, ?, ?)"
+	FindLastCheckpointQuery    = "SELECT record_id FROM audit_checkpoint ORDER BY id DESC LIMIT 1"
+	ScanAuditCheckpointQuery   = "SELECT id, record_id, hash, key_id, signature, created_at FROM audit_checkpoint ORDER BY id"
+
+	InsertChangeEventQuery = "INSERT INTO change_events(ts, entity_type, entity_id, version) SELECT ?, ?, ?, COALESCE(MAX(version), 0) + 1 FROM change_events WHERE entity_type=? AND entity_id=?"
+	FindLastChangeQuery    = "SELECT COALESCE(MAX(seq), 0) FROM change_events"
+	ListChangesSinceQuery  = "SELECT seq, ts, entity_type, entity_id, version FROM change_events WHERE seq>? ORDER BY seq LIMIT ?"
+
+	InsertWebhookDeliveryQuery    = "INSERT INTO webhook_outbox(event_id, webhook, event_type, payload, status, attempts, next_attempt_at, created_at) VALUES(?, ?, ?, ?, ?, 0, ?, ?)"
+	ListDueWebhookDeliveriesQuery = "SELECT id, webhook, event_type, payload, attempts, next_attempt_at FROM webhook_outbox WHERE status=? AND next_attempt_at<=? ORDER BY next_attempt_at, id LIMIT ?"
+	ClaimWebhookDeliveryQuery     = "UPDATE webhook_outbox SET next_attempt_at=? WHERE id=? AND status=? AND next_attempt_at=?"
+	UpdateWebhookDeliveryQuery    = "UPDATE webhook_outbox SET status=?, attempts=?, next_attempt_at=?, last_status=?, last_error=?, delivered_at=? WHERE id=?"
+	RetryWebhookDeliveryQuery     = "UPDATE webhook_outbox SET status=?, attempts=0, next_attempt_at=? WHERE id=? AND status=?"
+	ListWebhookDeliveriesQuery    = "SELECT id, event_id, webhook, event_type, payload, status, attempts, next_attempt_at, last_status, last_error, created_at, delivered_at FROM webhook_outbox"
+	FindWebhookDeliveryQuery      = ListWebhookDeliveriesQuery + " WHERE id=?"
+
+	InsertIssuedGrantQuery = "INSERT INTO issued_grants(jti, issuer, user_id, sub, issued_at, expires_at) VALUES(?, ?, ?, ?, ?, ?)"
+	UseIssuedGrantQuery    = "UPDATE issued_grants SET used_at=? WHERE jti=? AND used_at IS NULL"
+	FindIssuedGrantQuery   = "SELECT used_at FROM issued_grants WHERE jti=?"
+
+	InsertAccessRequestQuery      = "INSERT INTO access_requests(user_id, table_name, days, reason, status, created_at) VALUES(?, ?, ?, ?, ?, ?)"
+	DecideAccessRequestQuery      = "UPDATE access_requests SET status=?, decided_by=?, decided_at=? WHERE id=? AND status=?"
+	LockAccessDateQuery           = "SELECT user_id, tbl_name, db_access_date, db_deny_date FROM db_access WHERE user_id=? AND tbl_name=? FOR UPDATE"
+	ListAccessRequestsQuery       = "SELECT id, user_id, table_name, days, reason, status, decided_by, decided_at, created_at FROM access_requests"
+	FindAccessRequestQuery        = ListAccessRequestsQuery + " WHERE id=?"
+	InsertAccessRequestEventQuery = "INSERT INTO access_request_events(request_id, ts, actor, action, comment) VALUES(?, ?, ?, ?, ?)"
+	ListAccessRequestEventsQuery  = "SELECT ts, actor, action, comment FROM access_request_events WHERE request_id=? ORDER BY id"
+
+	ListUnnotifiedExpiringQuery = "SELECT a.user_id, a.tbl_name, a.db_access_date, a.db_deny_date FROM db_access a LEFT JOIN grant_expiry_notices n ON n.user_id=a.user_id AND n.tbl_name=a.tbl_name AND n.db_access_date=a.db_access_date WHERE n.user_id IS NULL AND a.db_access_date>? AND a.db_access_date<=? ORDER BY a.db_access_date, a.user_id, a.tbl_name LIMIT ?"
+	InsertExpiryNoticeQuery     = "INSERT IGNORE INTO grant_expiry_notices(user_id, tbl_name, db_access_date, notified_at) VALUES(?, ?, ?, ?)"
+	DeleteExpiryNoticesQuery    = "DELETE FROM grant_expiry_notices WHERE db_access_date<=?"
+	LockExpiredGrantsQuery      = "SELECT a.user_id, a.tbl_name, a.db_access_date, a.db_deny_date FROM db_access a WHERE a.db_access_date<=? AND NOT EXISTS (SELECT 1 FROM db_access_history h WHERE h.user_id=a.user_id AND h.tbl_name=a.tbl_name AND h.db_access_date=a.db_access_date) ORDER BY a.db_access_date, a.user_id, a.tbl_name LIMIT ? FOR UPDATE"
+	InsertGrantHistoryQuery     = "INSERT INTO db_access_history(user_id, tbl_name, db_access_date, db_deny_date, archived_at) VALUES(?, ?, ?, ?, ?)"
+	ListGrantHistoryQuery       = "SELECT id, user_id, tbl_name, db_access_date, db_deny_date, archived_at FROM db_access_history"
+
+	ListRolesQuery             = "SELECT name, description, attrs, updated_at FROM roles"
+	FindRoleQuery              = ListRolesQuery + " WHERE name=?"
+	InsertRoleQuery            = "INSERT INTO roles(name, description, attrs, updated_at) VALUES(?, ?, ?, ?)"
+	UpdateRoleQuery            = "UPDATE roles SET description=?, attrs=?, updated_at=? WHERE name=?"
+	DeleteRoleQuery            = "DELETE FROM roles WHERE name=?"
+	ListRoleGrantsQuery        = "SELECT tbl_name, db_access_date FROM role_grants WHERE role=? ORDER BY tbl_name"
+	InsertRoleGrantQuery       = "INSERT INTO role_grants(role, tbl_name, db_access_date) VALUES(?, ?, ?)"
+	DeleteRoleGrantsQuery      = "DELETE FROM role_grants WHERE role=?"
+	ListRoleAssignmentsQuery   = "SELECT subject_type, subject_id FROM role_assignments WHERE role=? ORDER BY subject_type, subject_id"
+	InsertRoleAssignmentQuery  = "INSERT IGNORE INTO role_assignments(role, subject_type, subject_id) VALUES(?, ?, ?)"
+	DeleteRoleAssignmentQuery  = "DELETE FROM role_assignments WHERE role=? AND subject_type=? AND subject_id=?"
+	DeleteRoleAssignmentsQuery = "DELETE FROM role_assignments WHERE role=?"
+	ListGroupMembersQuery      = "SELECT user_id FROM group_members WHERE grp=? ORDER BY user_id"
+	InsertGroupMemberQuery     = "INSERT IGNORE INTO group_members(grp, user_id) VALUES(?, ?)"
+	DeleteGroupMemberQuery     = "DELETE FROM group_members WHERE grp=? AND user_id=?"
+	// a user holds a role directly or through any group they are in
+	userRoleSubjects          = "((ra.subject_type='user' AND ra.subject_id=?) OR (ra.subject_type='group' AND ra.subject_id IN (SELECT grp FROM group_members WHERE user_id=?)))"
+	ListUserRolesQuery        = "SELECT r.name, r.attrs, ra.subject_type, ra.subject_id FROM roles r JOIN role_assignments ra ON ra.role=r.name WHERE " + userRoleSubjects + " ORDER BY r.name, ra.subject_type DESC, ra.subject_id"
+	FindRoleGrantForUserQuery = "SELECT rg.role, rg.db_access_date FROM role_grants rg JOIN role_assignments ra ON ra.role=rg.role WHERE rg.tbl_name=? AND " + userRoleSubjects + " ORDER BY rg.db_access_date DESC, rg.role LIMIT 1"
+
+	ListObjectsQuery            = "SELECT obj_id, parent_id, kind, updated_at FROM object_tree"
+	FindObjectQuery             = ListObjectsQuery + " WHERE obj_id=?"
+	FindObjectParentQuery       = "SELECT parent_id FROM object_tree WHERE obj_id=?"
+	LockObjectParentQuery       = FindObjectParentQuery + " FOR UPDATE"
+	InsertObjectQuery           = "INSERT INTO object_tree(obj_id, parent_id, kind, updated_at) VALUES(?, ?, ?, ?)"
+	UpdateObjectQuery           = "UPDATE object_tree SET parent_id=?, kind=?, updated_at=? WHERE obj_id=?"
+	DeleteObjectQuery           = "DELETE FROM object_tree WHERE obj_id=?"
+	ListObjectChildrenQuery     = "SELECT obj_id FROM object_tree WHERE parent_id=? ORDER BY obj_id"
+	ListObjectPolicyRefsQuery   = "SELECT action, policy_ref FROM object_policy_refs WHERE obj_id=? ORDER BY action, position"
+	ListObjectActionRefsQuery   = "SELECT policy_ref FROM object_policy_refs WHERE obj_id=? AND action=? ORDER BY position"
+	InsertObjectPolicyRefQuery  = "INSERT INTO object_policy_refs(obj_id, action, policy_ref, position) VALUES(?, ?, ?, ?)"
+	DeleteObjectActionRefsQuery = "DELETE FROM object_policy_refs WHERE obj_id=? AND action=?"
+	DeleteObjectPolicyRefsQuery = "DELETE FROM object_policy_refs WHERE obj_id=?"
+
+	ListPolicyTestsQuery  = "SELECT policy_ref, name, content, updated_at FROM policy_tests WHERE policy_ref=? ORDER BY name"
+	FindPolicyTestQuery   = "SELECT policy_ref, name, content, updated_at FROM policy_tests WHERE policy_ref=? AND name=?"
+	InsertPolicyTestQuery = "INSERT INTO policy_tests(policy_ref, name, content, updated_at) VALUES(?, ?, ?, ?)"
+	UpdatePolicyTestQuery = "UPDATE policy_tests SET content=?, updated_at=? WHERE policy_ref=? AND name=?"
+	DeletePolicyTestQuery = "DELETE FROM policy_tests WHERE policy_ref=? AND name=?"
 )
@@ -0,0 +1,439 @@
+package app
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// The /v1 routes expose the same operations as the verb-style routes as
+// resources: collections answer GET (list) and POST (create), members GET and
+// PUT or PATCH. Unlike the legacy routes they answer with status codes that
+// mean something (404 for a missing row, 403 for a refused read, 500 for a
+// database failure) and always with a JSON body; errors are {"error": msg}
+// and writes {"rows": n}.
+
+// deprecated marks a legacy route. Responses carry a Deprecation header and a
+// Link to the /v1 route replacing it, so clients can find out before the
+// route is removed. {name} in successor is filled in from the legacy route's
+// path parameter of that name. Legacy traffic shows up per route in
+// http_requests_total.
+func deprecated(successor string) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		link := successor
+		for _, p := range context.Params {
+			link = strings.ReplaceAll(link, "{"+p.Key+"}", url.PathEscape(p.Value))
+		}
+		context.Header("Deprecation", "true")
+		context.Header("Link", "<"+link+`>; rel="successor-version"`)
+		context.Next()
+	}
+}
+
+func v1Error(context *gin.Context, code int, msg string) {
+	context.JSON(code, gin.H{"error": msg})
+}
+
+// bindV1 decodes a JSON request body, answering 400 when it is malformed.
+func (s *Server) bindV1(context *gin.Context, v interface{}) bool {
+	if err := json.NewDecoder(context.Request.Body).Decode(v); err != nil {
+		s.log.DebugContext(context.Request.Context(), "malformed request body", "err", err)
+		v1Error(context, http.StatusBadRequest, "request body must be a JSON object")
+		return false
+	}
+	return true
+}
+
+// writeV1Found answers a single-row read and audits it.
+func (s *Server) writeV1Found(context *gin.Context, result interface{}, err error, rec AuditRecord) {
+	ctx := context.Request.Context()
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		v1Error(context, http.StatusNotFound, rec.Target_type+" not found")
+		return
+	case errors.Is(err, errNoAccess):
+		v1Error(context, http.StatusForbidden, err.Error())
+		return
+	case err != nil:
+		s.log.ErrorContext(ctx, "unable to execute sql query", "target", rec.Target_type, "id", rec.Target_id, "err", err)
+		v1Error(context, http.StatusInternalServerError, "database error")
+		return
+	}
+	s.log.DebugContext(ctx, "query result", "result", redact(result))
+	s.audit.Record(ctx, rec)
+	context.JSON(http.StatusOK, result)
+}
+
+// writeV1Rows answers a write. Failures have been logged by exec.
+func writeV1Rows(context *gin.Context, code int, rows int64, err error) {
+	if err != nil {
+		v1Error(context, http.StatusInternalServerError, "database error")
+		return
+	}
+	context.JSON(code, gin.H{"rows": rows})
+}
+
+// listV1 serves spec as a /v1 collection.
+func (s *Server) listV1(spec listSpec) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		p, err := queryListParams(context)
+		var result ListResult
+		if err == nil {
+			result, err = s.runList(context.Request.Context(), spec, p, context.GetQuery)
+		}
+		var lerr listError
+		if errors.As(err, &lerr) {
+			v1Error(context, http.StatusBadRequest, lerr.msg)
+			return
+		}
+		if err != nil {
+			v1Error(context, http.StatusInternalServerError, "database error")
+			return
+		}
+		s.audit.Record(context.Request.Context(), AuditRecord{Action: AuditList, Target_type: spec.entity, Target_id: context.Request.URL.RawQuery})
+		context.JSON(http.StatusOK, result)
+	}
+}
+
+func (s *Server) v1Routes(router *gin.Engine) {
+	v1 := router.Group("/v1")
+
+	users := v1.Group("/users")
+	{
+		users.GET("", s.listV1(userList))
+		users.POST("", s.CreateUserV1())
+		users.GET("/:user_id", s.GetUserV1())
+		users.PUT("/:user_id", s.UpdateUserV1())
+		users.GET("/:user_id/check_info", s.GetUserCheckInfoV1())
+		users.GET("/:user_id/roles", s.GetUserRolesV1())
+	}
+
+	devices := v1.Group("/devices")
+	{
+		devices.GET("", s.listV1(deviceList))
+		devices.POST("", s.CreateDeviceV1())
+		devices.GET("/:dev_id", s.GetDeviceV1())
+		devices.GET("/:dev_id/actions", s.GetDeviceActionsV1())
+		devices.GET("/:dev_id/check_info", s.GetDeviceCheckInfoV1())
+	}
+
+	policies := v1.Group("/policies")
+	{
+		policies.GET("", s.listV1(policyList))
+		policies.POST("", s.CreatePolicyV1())
+		policies.GET("/:ref", s.GetPolicyV1())
+		policies.PUT("/:ref", s.UpdatePolicyV1())
+		policies.GET("/:ref/tests", s.AdminOnly(), s.ListPolicyTestsV1())
+		policies.POST("/:ref/tests/run", s.AdminOnly(), s.RunPolicyTestsV1())
+		policies.PUT("/:ref/tests/:name", s.AdminOnly(), s.PutPolicyTestV1())
+		policies.DELETE("/:ref/tests/:name", s.AdminOnly(), s.DeletePolicyTestV1())
+	}
+
+	hierarchies := v1.Group("/hierarchies")
+	{
+		hierarchies.GET("", s.listV1(hierarchyList))
+		hierarchies.POST("", s.CreateHierarchyV1())
+		hierarchies.GET("/:obj_id/:action", s.GetHierarchyV1())
+		hierarchies.PUT("/:obj_id/:action", s.UpdateHierarchyV1())
+	}
+
+	grants := v1.Group("/grants")
+	{
+		grants.GET("", s.listV1(accessList))
+		grants.POST("", s.AdminOnly(), s.CreateGrantV1())
+		grants.POST("/tokens", s.SubmitGrantV1())
+		grants.POST("/tokens/issue", s.IssueGrantV1())
+		grants.GET("/history", s.listV1(grantHistoryList))
+		grants.GET("/:user_id/:table_name", s.GetGrantV1())
+		grants.PATCH("/:user_id/:table_name", s.AdminOnly(), s.UpdateGrantV1())
+	}
+
+	requests := v1.Group("/access_requests")
+	{
+		requests.GET("", s.listV1(accessRequestList))
+		requests.POST("", s.SubmitAccessRequestV1())
+		requests.GET("/:id", s.GetAccessRequestV1())
+		requests.POST("/:id/approve", s.ApproveAccessRequestV1())
+		requests.POST("/:id/reject", s.RejectAccessRequestV1())
+	}
+
+	roles := v1.Group("/roles")
+	{
+		roles.GET("", s.listV1(roleList))
+		roles.POST("", s.AdminOnly(), s.CreateRoleV1())
+		roles.GET("/:name", s.GetRoleV1())
+		roles.PUT("/:name", s.AdminOnly(), s.UpdateRoleV1())
+		roles.DELETE("/:name", s.AdminOnly(), s.DeleteRoleV1())
+		roles.PUT("/:name/members/:kind/:id", s.AdminOnly(), s.AssignRoleV1())
+		roles.DELETE("/:name/members/:kind/:id", s.AdminOnly(), s.UnassignRoleV1())
+	}
+
+	objects := v1.Group("/objects")
+	{
+		objects.GET("", s.listV1(objectList))
+		objects.POST("", s.CreateObjectV1())
+		objects.GET("/:obj_id", s.GetObjectV1())
+		objects.PUT("/:obj_id", s.UpdateObjectV1())
+		objects.DELETE("/:obj_id", s.DeleteObjectV1())
+		objects.PUT("/:obj_id/policies/:action", s.SetObjectPoliciesV1())
+		objects.GET("/:obj_id/effective/:action", s.GetPolicyChainV1())
+	}
+
+	groups := v1.Group("/groups")
+	{
+		groups.GET("/:group", s.GetGroupV1())
+		groups.PUT("/:group/members/:user_id", s.AdminOnly(), s.AddGroupMemberV1())
+		groups.DELETE("/:group/members/:user_id", s.AdminOnly(), s.RemoveGroupMemberV1())
+	}
+
+	v1.POST("/simulate", s.AdminOnly(), s.SimulateV1())
+}
+
+func (s *Server) CreateUserV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req InsertUserAttrsRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		if req.User_id == "" {
+			v1Error(context, http.StatusBadRequest, "user_id is required")
+			return
+		}
+		rows, err := s.insertUserAttrs(context.Request.Context(), req)
+		writeV1Rows(context, http.StatusCreated, rows, err)
+	}
+}
+
+func (s *Server) GetUserV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		user_id := context.Param("user_id")
+		result, err := s.readUserAttrs(context.Request.Context(), context, user_id)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditRead, Target_type: "user", Target_id: user_id})
+	}
+}
+
+// UpdateUserV1 replaces a user's attributes; the password is not changed.
+func (s *Server) UpdateUserV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req UpdateUserAttrsRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		req.User_id = context.Param("user_id")
+		rows, err := s.updateUserAttrs(context.Request.Context(), req)
+		writeV1Rows(context, http.StatusOK, rows, err)
+	}
+}
+
+func (s *Server) GetUserCheckInfoV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		user_id := context.Param("user_id")
+		result, err := s.findUserCheckInfo(context.Request.Context(), user_id)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditReadCheckInfo, Target_type: "user", Target_id: user_id})
+	}
+}
+
+func (s *Server) CreateDeviceV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req InsertDevInfoFullRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		if req.Dev_id == "" {
+			v1Error(context, http.StatusBadRequest, "dev_id is required")
+			return
+		}
+		rows, err := s.insertDevInfoFull(context.Request.Context(), req)
+		writeV1Rows(context, http.StatusCreated, rows, err)
+	}
+}
+
+func (s *Server) GetDeviceV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		dev_id := context.Param("dev_id")
+		result, err := s.findDevAttrs(context.Request.Context(), dev_id)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditRead, Target_type: "device", Target_id: dev_id})
+	}
+}
+
+func (s *Server) GetDeviceActionsV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		dev_id := context.Param("dev_id")
+		result, err := s.findDevActions(context.Request.Context(), dev_id)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditRead, Target_type: "device", Target_id: dev_id})
+	}
+}
+
+func (s *Server) GetDeviceCheckInfoV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		dev_id := context.Param("dev_id")
+		result, err := s.findDevCheckInfo(context.Request.Context(), dev_id)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditReadCheckInfo, Target_type: "device", Target_id: dev_id})
+	}
+}
+
+func (s *Server) CreatePolicyV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req InsertPolicyRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		if req.Ref == "" {
+			v1Error(context, http.StatusBadRequest, "ref is required")
+			return
+		}
+		rows, err := s.insertPolicy(context.Request.Context(), req)
+		writeV1Rows(context, http.StatusCreated, rows, err)
+	}
+}
+
+func (s *Server) GetPolicyV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		ref := context.Param("ref")
+		result, err := s.findPolicy(context.Request.Context(), context, ref)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditRead, Target_type: "policy", Target_id: ref})
+	}
+}
+
+func (s *Server) UpdatePolicyV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req UpdatePolicyRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		req.Ref = context.Param("ref")
+		rows, err := s.updatePolicy(context.Request.Context(), req)
+		var terr policyTestError
+		if errors.As(err, &terr) || errors.Is(err, errOPAUnavailable) {
+			s.writePolicyTestError(context, err)
+			return
+		}
+		writeV1Rows(context, http.StatusOK, rows, err)
+	}
+}
+
+func (s *Server) CreateHierarchyV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req InsertObjectHierarchyRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		if req.Obj_id == "" || req.Action == "" {
+			v1Error(context, http.StatusBadRequest, "obj_id and action are required")
+			return
+		}
+		rows, err := s.insertHierarchy(context.Request.Context(), req)
+		writeV1Rows(context, http.StatusCreated, rows, err)
+	}
+}
+
+func (s *Server) GetHierarchyV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		obj_id := context.Param("obj_id")
+		action := context.Param("action")
+		result, err := s.findHierarchy(context.Request.Context(), context, obj_id, action)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditRead, Target_type: "hierarchy", Target_id: obj_id + "/" + action})
+	}
+}
+
+func (s *Server) UpdateHierarchyV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req UpdateObjectHierarchyRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		req.Obj_id = context.Param("obj_id")
+		req.Action = context.Param("action")
+		rows, err := s.updateHierarchy(context.Request.Context(), req)
+		writeV1Rows(context, http.StatusOK, rows, err)
+	}
+}
+
+func (s *Server) CreateGrantV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req DBAccess
+		if !s.bindV1(context, &req) {
+			return
+		}
+		if req.User_id == "" || req.Table_name == "" {
+			v1Error(context, http.StatusBadRequest, "user_id and table_name are required")
+			return
+		}
+		rows, err := s.insertPermInfo(context.Request.Context(), InsertPermInfoQueryRequest{User_id: req.User_id, Tbl_name: req.Table_name,
+			Db_access_date: req.Db_access_date, Db_deny_date: req.Db_deny_date})
+		writeV1Rows(context, http.StatusCreated, rows, err)
+	}
+}
+
+func (s *Server) GetGrantV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		user_id := context.Param("user_id")
+		table_name := context.Param("table_name")
+		result, err := s.findDBAccess(context.Request.Context(), user_id, table_name)
+		s.writeV1Found(context, result, err, AuditRecord{Action: AuditRead, Target_type: "grant", Target_id: user_id + "/" + table_name})
+	}
+}
+
+// UpdateGrantV1 sets the access date, the deny date or both; fields left out
+// of the body are not changed.
+func (s *Server) UpdateGrantV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req DBAccess
+		if !s.bindV1(context, &req) {
+			return
+		}
+		if req.Db_access_date == "" && req.Db_deny_date == "" {
+			v1Error(context, http.StatusBadRequest, "db_access_date or db_deny_date is required")
+			return
+		}
+		user_id := context.Param("user_id")
+		table_name := context.Param("table_name")
+		var total int64
+		if req.Db_access_date != "" {
+			rows, err := s.updateDBAllow(context.Request.Context(), UpdateSecureDBAllowRequest{User_id: user_id, Tbl_name: table_name, Db_access_date: req.Db_access_date})
+			if err != nil {
+				writeV1Rows(context, http.StatusOK, 0, err)
+				return
+			}
+			total += rows
+		}
+		if req.Db_deny_date != "" {
+			rows, err := s.updateDBDeny(context.Request.Context(), UpdateSecureDBDenyRequest{User_id: user_id, Tbl_name: table_name, Db_deny_date: req.Db_deny_date})
+			if err != nil {
+				writeV1Rows(context, http.StatusOK, 0, err)
+				return
+			}
+			total += rows
+		}
+		writeV1Rows(context, http.StatusOK, total, nil)
+	}
+}
+
+// SubmitGrantV1 applies a signed grant token, as POST /jwt does.
+func (s *Server) SubmitGrantV1() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		var req JWTRequest
+		if !s.bindV1(context, &req) {
+			return
+		}
+		err := s.submitGrant(context.Request.Context(), req.ClientMessage)
+		switch {
+		case errors.Is(err, errGrantRefused):
+			v1Error(context, http.StatusUnauthorized, "wrong JWT signature!")
+			return
+		case errors.Is(err, errNoGrantRow):
+			v1Error(context, http.StatusConflict, err.Error())
+			return
+		case err != nil:
+			s.log.ErrorContext(context.Request.Context(), "unable to apply grant", "err", err)
+			v1Error(context, http.StatusInternalServerError, "database error")
+			return
+		}
+		context.JSON(http.StatusOK, gin.H{"server_message": "JWT received!"})
+	}
+}
@@ -0,0 +1,133 @@
+package app
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+func serveV1(r *gin.Engine, method string, path string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestDeprecatedLinksSuccessor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/find_db_access/:user_id/:table_name", deprecated("/v1/grants/{user_id}/{table_name}"), func(context *gin.Context) {
+		context.Status(http.StatusOK)
+	})
+	w := serveV1(r, http.MethodGet, "/find_db_access/al%20ice/orders", "")
+	if w.Header().Get("Deprecation") != "true" {
+		t.Errorf("Deprecation = %q", w.Header().Get("Deprecation"))
+	}
+	if got, want := w.Header().Get("Link"), `</v1/grants/al%20ice/orders>; rel="successor-version"`; got != want {
+		t.Errorf("Link = %q, want %q", got, want)
+	}
+}
+
+// Reads answer 404 for a missing row and 500 without the database error
+// for a failure, always with a JSON error body.
+func TestV1ReadStatuses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, mock := newTestServer(t)
+	r := gin.New()
+	r.GET("/v1/policies/:ref", s.GetPolicyV1())
+
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WithArgs("gone").WillReturnError(sql.ErrNoRows)
+	if w := serveV1(r, http.MethodGet, "/v1/policies/gone", ""); w.Code != http.StatusNotFound || w.Body.String() != `{"error":"policy not found"}` {
+		t.Errorf("missing policy: %d %s", w.Code, w.Body)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WithArgs("p1").WillReturnError(sql.ErrConnDone)
+	if w := serveV1(r, http.MethodGet, "/v1/policies/p1", ""); w.Code != http.StatusInternalServerError || w.Body.String() != `{"error":"database error"}` {
+		t.Errorf("database failure: %d %s", w.Code, w.Body)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(FindPolicyQuery)).WithArgs("p1").
+		WillReturnRows(sqlmock.NewRows([]string{"ref", "content"}).AddRow("p1", "package p1"))
+	expectAudit(mock, "system", AuditRead)
+	if w := serveV1(r, http.MethodGet, "/v1/policies/p1", ""); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"package p1"`) {
+		t.Errorf("policy: %d %s", w.Code, w.Body)
+	}
+}
+
+// Malformed or incomplete bodies are refused before anything is written.
+func TestV1RejectsBadBodies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, _ := newTestServer(t)
+	r := gin.New()
+	r.POST("/v1/policies", s.CreatePolicyV1())
+	r.POST("/v1/hierarchies", s.CreateHierarchyV1())
+	r.PATCH("/v1/grants/:user_id/:table_name", s.UpdateGrantV1())
+
+	for _, tc := range []struct {
+		method, path, body, msg string
+	}{
+		{http.MethodPost, "/v1/policies", `{"ref": `, "request body must be a JSON object"},
+		{http.MethodPost, "/v1/policies", `[]`, "request body must be a JSON object"},
+		{http.MethodPost, "/v1/policies", `{"content": "package p"}`, "ref is required"},
+		{http.MethodPost, "/v1/hierarchies", `{"obj_id": "o1"}`, "obj_id and action are required"},
+		{http.MethodPatch, "/v1/grants/alice/orders", `{}`, "db_access_date or db_deny_date is required"},
+	} {
+		w := serveV1(r, tc.method, tc.path, tc.body)
+		if w.Code != http.StatusBadRequest || w.Body.String() != `{"error":"`+tc.msg+`"}` {
+			t.Errorf("%s %s %s: %d %s", tc.method, tc.path, tc.body, w.Code, w.Body)
+		}
+	}
+}
+
+func TestV1ListErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, _ := newTestServer(t)
+	r := gin.New()
+	r.GET("/v1/policies", s.listV1(policyList))
+	for _, q := range []string{"limit=0", "sort=colour", "order=up", "cursor=junk"} {
+		if w := serveV1(r, http.MethodGet, "/v1/policies?"+q, ""); w.Code != http.StatusBadRequest || !strings.HasPrefix(w.Body.String(), `{"error":`) {
+			t.Errorf("?%s: %d %s", q, w.Code, w.Body)
+		}
+	}
+}
+
+// serveAdmin is serveV1 with token, when set, as the bearer token.
+func serveAdmin(r *gin.Engine, method string, path string, token string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+// Grants are written directly by admins only; everyone else goes through
+// grant tokens or access requests.
+func TestGrantWritesNeedAnAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, _ := newTestServer(t)
+	withAdmin(s, "ops", "s3cret")
+	s.router = gin.New()
+	r := s.Routes()
+
+	for _, route := range [][2]string{
+		{http.MethodPost, "/v1/grants"},
+		{http.MethodPatch, "/v1/grants/alice/orders"},
+		{http.MethodPost, "/insert_perm_info"},
+		{http.MethodPost, "/update_db_allow"},
+		{http.MethodPost, "/update_db_deny"},
+	} {
+		if w := serveAdmin(r, route[0], route[1], "", `{}`); w.Code != http.StatusUnauthorized {
+			t.Errorf("%s %s without a token: status %d, want 401", route[0], route[1], w.Code)
+		}
+	}
+	w := serveAdmin(r, http.MethodPatch, "/v1/grants/alice/orders", "s3cret", `{}`)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("as an admin: status %d %s, want the body checked", w.Code, w.Body)
+	}
+}
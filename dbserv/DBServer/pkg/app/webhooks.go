@@ -0,0 +1,545 @@
+package app
+
+import (
+	"bytes"
+	sqlctx "context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Webhooks tell downstream systems when accessDateUpdate extends or denies a
+// user's access to a table, and when the expiry sweeper (see sweeper.go)
+// finds a grant about to lapse or archives an expired one. Events are
+// written to the webhook_outbox table, one row per subscribed endpoint, in
+// the transaction that writes the grant, so an event exists exactly when
+// its grant does and survives restarts; a dispatcher then delivers due rows
+// and retries failures with exponential backoff until Max_attempts is
+// reached.
+//
+// The endpoints file is a JSON array, for example:
+//
+//	[
+//	  {"name": "billing", "url": "https://billing.example/hooks/abac", "secret": "s3cr3t"},
+//	  {"name": "siem", "url": "https://siem.example/in", "secret": "other", "events": ["grant.deny"], "max_attempts": 20}
+//	]
+//
+// Each delivery is a POST of the WebhookEvent JSON with these headers:
+//
+//	X-Webhook-Id         the outbox row id, stable across retries
+//	X-Webhook-Event      the event type
+//	X-Webhook-Timestamp  unix seconds when this attempt was signed
+//	X-Webhook-Signature  sha256=hex(HMAC-SHA256(secret, timestamp + "." + body))
+//
+// Delivery is at least once: receivers should ignore event ids they have
+// already seen.
+
+const (
+	WebhookGrantAllow    = "grant.allow"
+	WebhookGrantDeny     = "grant.deny"
+	WebhookGrantExpiring = "grant.expiring"
+	WebhookGrantExpired  = "grant.expired"
+
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryFailed    = "failed"
+
+	webhookIdHeader        = "X-Webhook-Id"
+	webhookEventHeader     = "X-Webhook-Event"
+	webhookTimestampHeader = "X-Webhook-Timestamp"
+	webhookSignatureHeader = "X-Webhook-Signature"
+
+	defaultWebhookAttempts = 10
+	webhookBaseBackoff     = 5 * time.Second
+	webhookMaxBackoff      = time.Hour
+	webhookSendTimeout     = 10 * time.Second
+	// a claimed row is retried after the lease if this process dies mid-send
+	webhookLease     = time.Minute
+	webhookPoll      = 5 * time.Second
+	webhookBatch     = 50
+	webhookErrorSize = 512
+)
+
+// LoadWebhookEndpoints reads and validates an endpoints file.
+func LoadWebhookEndpoints(path string) ([]WebhookEndpoint, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var endpoints []WebhookEndpoint
+	if err := json.Unmarshal(raw, &endpoints); err != nil {
+		return nil, fmt.Errorf("webhooks %s: %v", path, err)
+	}
+	seen := make(map[string]bool)
+	for i := range endpoints {
+		if err := endpoints[i].validate(); err != nil {
+			return nil, fmt.Errorf("webhooks %s: endpoint %d: %v", path, i, err)
+		}
+		if seen[endpoints[i].Name] {
+			return nil, fmt.Errorf("webhooks %s: duplicate endpoint name %q", path, endpoints[i].Name)
+		}
+		seen[endpoints[i].Name] = true
+	}
+	return endpoints, nil
+}
+
+func (e *WebhookEndpoint) validate() error {
+	if e.Name == "" {
+		return errors.New("name is required")
+	}
+	if e.Url == "" {
+		return errors.New("url is required")
+	}
+	u, err := url.Parse(e.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url %q must be an absolute http or https URL", e.Url)
+	}
+	if e.Secret == "" {
+		return errors.New("secret is required")
+	}
+	for _, ev := range e.Events {
+		switch ev {
+		case WebhookGrantAllow, WebhookGrantDeny, WebhookGrantExpiring, WebhookGrantExpired:
+		default:
+			return fmt.Errorf("unknown event %q", ev)
+		}
+	}
+	if e.Max_attempts < 0 {
+		return errors.New("max_attempts must not be negative")
+	}
+	if e.Max_attempts == 0 {
+		e.Max_attempts = defaultWebhookAttempts
+	}
+	return nil
+}
+
+func (e WebhookEndpoint) wants(event_type string) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, ev := range e.Events {
+		if ev == event_type {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookSignature is what receivers recompute to authenticate a delivery.
+func webhookSignature(secret string, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff is the wait before attempt+1: base*2^(attempt-1) capped at
+// webhookMaxBackoff, with up to half of it randomised so that endpoints
+// recovering from an outage are not hit by every retry at once.
+func webhookBackoff(attempt int) time.Duration {
+	d := webhookMaxBackoff
+	if attempt < 20 {
+		if b := webhookBaseBackoff << uint(attempt-1); b < d {
+			d = b
+		}
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+type webhookDispatcher struct {
+	endpoints map[string]WebhookEndpoint
+	order     []string
+	client    *http.Client
+	// wake cuts the poll short when a new event was enqueued
+	wake chan struct{}
+	done chan struct{}
+}
+
+func newWebhookDispatcher(endpoints []WebhookEndpoint) *webhookDispatcher {
+	d := &webhookDispatcher{endpoints: make(map[string]WebhookEndpoint), client: &http.Client{Timeout: webhookSendTimeout},
+		wake: make(chan struct{}, 1), done: make(chan struct{})}
+	for _, e := range endpoints {
+		d.endpoints[e.Name] = e
+		d.order = append(d.order, e.Name)
+	}
+	return d
+}
+
+func (d *webhookDispatcher) notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// queueWebhook writes ev to the outbox for every endpoint subscribed to its
+// type, in tx: the transaction that writes the grant the event is about, so
+// the event is queued exactly when the grant commits. Call
+// s.webhooks.notify() once tx has committed.
+func (s *Server) queueWebhook(ctx sqlctx.Context, tx *sql.Tx, ev WebhookEvent) error {
+	if len(s.webhooks.order) == 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+	ev.Id = newRequestID() + newRequestID()
+	ev.Ts = now.Format(time.RFC3339Nano)
+	ev.Request_id = metaFrom(ctx).requestID
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	ts := now.Format(auditTimeLayout)
+	for _, name := range s.webhooks.order {
+		if !s.webhooks.endpoints[name].wants(ev.Type) {
+			continue
+		}
+		done := timeQuery(ctx, InsertWebhookDeliveryQuery)
+		_, err := tx.ExecContext(ctx, InsertWebhookDeliveryQuery, ev.Id, name, ev.Type, string(payload), DeliveryPending, ts, ts)
+		done(err)
+		if err != nil {
+			return fmt.Errorf("queueing webhook %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// runWebhooks delivers due outbox rows until ctx is done.
+func (s *Server) runWebhooks(ctx sqlctx.Context) {
+	defer close(s.webhooks.done)
+	if len(s.webhooks.order) == 0 {
+		return
+	}
+	ticker := time.NewTicker(webhookPoll)
+	defer ticker.Stop()
+	for {
+		for {
+			n, err := s.deliverDue(ctx)
+			if err != nil && ctx.Err() == nil {
+				s.log.WarnContext(ctx, "webhook dispatch failed", "err", err)
+			}
+			if err != nil || n < webhookBatch {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.webhooks.wake:
+		}
+	}
+}
+
+// stopWebhooks waits for the dispatcher started by Run to finish its
+// current delivery.
+func (s *Server) stopWebhooks(timeout time.Duration) {
+	select {
+	case <-s.webhooks.done:
+	case <-time.After(timeout):
+		s.log.Warn("webhook dispatcher did not stop in time")
+	}
+}
+
+type dueDelivery struct {
+	id         int64
+	webhook    string
+	event_type string
+	payload    string
+	attempts   int
+	next       string
+}
+
+// deliverDue sends one batch of due rows and returns how many it found.
+func (s *Server) deliverDue(ctx sqlctx.Context) (int, error) {
+	now := time.Now().UTC().Format(auditTimeLayout)
+	done := timeQuery(ctx, ListDueWebhookDeliveriesQuery)
+	res, err := s.conn.QueryContext(ctx, ListDueWebhookDeliveriesQuery, DeliveryPending, now, webhookBatch)
+	done(err)
+	if err != nil {
+		return 0, err
+	}
+	var due []dueDelivery
+	for res.Next() {
+		var d dueDelivery
+		if err := res.Scan(&d.id, &d.webhook, &d.event_type, &d.payload, &d.attempts, &d.next); err != nil {
+			res.Close()
+			return 0, err
+		}
+		due = append(due, d)
+	}
+	res.Close()
+	if err := res.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, d := range due {
+		if ctx.Err() != nil {
+			return len(due), nil
+		}
+		claimed, err := s.claimDelivery(ctx, d)
+		if err != nil {
+			return len(due), err
+		}
+		if claimed {
+			s.deliver(ctx, d)
+		}
+	}
+	return len(due), nil
+}
+
+// claimDelivery pushes the row's next attempt out by the lease, so another
+// server sharing the database skips it while this one sends.
+func (s *Server) claimDelivery(ctx sqlctx.Context, d dueDelivery) (bool, error) {
+	lease := time.Now().UTC().Add(webhookLease).Format(auditTimeLayout)
+	done := timeQuery(ctx, ClaimWebhookDeliveryQuery)
+	res, err := s.conn.ExecContext(ctx, ClaimWebhookDeliveryQuery, lease, d.id, DeliveryPending, d.next)
+	done(err)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+func (s *Server) deliver(ctx sqlctx.Context, d dueDelivery) {
+	attempts := d.attempts
+	var status int
+	var sendErr error
+	endpoint, ok := s.webhooks.endpoints[d.webhook]
+	if ok {
+		attempts++
+		status, sendErr = s.post(ctx, endpoint, d)
+	} else {
+		// the endpoint was removed from the configuration; give up
+		sendErr = errors.New("webhook is no longer configured")
+	}
+
+	now := time.Now().UTC()
+	state, result := DeliveryPending, "retry"
+	next := now.Format(auditTimeLayout)
+	var delivered sql.NullString
+	var lastErr string
+	switch {
+	case sendErr == nil:
+		state, result = DeliveryDelivered, "delivered"
+		delivered = sql.NullString{String: next, Valid: true}
+	case !ok || attempts >= endpoint.Max_attempts:
+		state, result = DeliveryFailed, "failed"
+	default:
+		next = now.Add(webhookBackoff(attempts)).Format(auditTimeLayout)
+	}
+	if sendErr != nil {
+		lastErr = sendErr.Error()
+		if len(lastErr) > webhookErrorSize {
+			lastErr = lastErr[:webhookErrorSize]
+		}
+	}
+	webhookDeliveries.WithLabelValues(d.webhook, result).Inc()
+
+	// record the outcome even when shutting down mid-send
+	ctx = sqlctx.WithoutCancel(ctx)
+	done := timeQuery(ctx, UpdateWebhookDeliveryQuery)
+	_, err := s.conn.ExecContext(ctx, UpdateWebhookDeliveryQuery, state, attempts, next, status, lastErr, delivered, d.id)
+	done(err)
+	if err != nil {
+		s.log.ErrorContext(ctx, "unable to record webhook delivery", "id", d.id, "err", err)
+		return
+	}
+	if sendErr != nil {
+		s.log.WarnContext(ctx, "webhook delivery failed", "id", d.id, "webhook", d.webhook,
+			"attempts", attempts, "status", state, "err", sendErr)
+	}
+}
+
+// post makes one delivery attempt. Any 2xx answer counts as delivered.
+func (s *Server) post(ctx sqlctx.Context, endpoint WebhookEndpoint, d dueDelivery) (int, error) {
+	ctx, cancelfunc := sqlctx.WithTimeout(sqlctx.WithoutCancel(ctx), webhookSendTimeout)
+	defer cancelfunc()
+	body := []byte(d.payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookIdHeader, strconv.FormatInt(d.id, 10))
+	req.Header.Set(webhookEventHeader, d.event_type)
+	req.Header.Set(webhookTimestampHeader, ts)
+	req.Header.Set(webhookSignatureHeader, webhookSignature(endpoint.Secret, ts, body))
+
+	start := time.Now()
+	resp, err := s.webhooks.client.Do(req)
+	webhookDuration.WithLabelValues(d.webhook).Observe(time.Since(start).Seconds())
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint answered %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func deliveryFromRow(row map[string]string) WebhookDelivery {
+	var d WebhookDelivery
+	fmt.Sscan(row["id"], &d.Id)
+	fmt.Sscan(row["attempts"], &d.Attempts)
+	fmt.Sscan(row["last_status"], &d.Last_status)
+	d.Event_id, d.Webhook, d.Event_type = row["event_id"], row["webhook"], row["event_type"]
+	d.Payload, d.Status, d.Next_attempt_at = row["payload"], row["status"], row["next_attempt_at"]
+	d.Last_error, d.Created_at, d.Delivered_at = row["last_error"], row["created_at"], row["delivered_at"]
+	return d
+}
+
+// ListWebhooks serves /webhooks: the configured endpoints without secrets.
+func (s *Server) ListWebhooks() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		context.Header("Content-Type", "application/json")
+		endpoints := make([]WebhookEndpoint, 0, len(s.webhooks.order))
+		for _, name := range s.webhooks.order {
+			e := s.webhooks.endpoints[name]
+			e.Secret = ""
+			endpoints = append(endpoints, e)
+		}
+		ret, err := json.Marshal(endpoints)
+		if err != nil {
+			s.log.ErrorContext(context.Request.Context(), "marshal webhooks", "err", err)
+			return
+		}
+		context.String(http.StatusOK, string(ret))
+	}
+}
+
+var deliveryColumns = []string{"id", "event_id", "webhook", "event_type", "payload", "status", "attempts",
+	"next_attempt_at", "last_status", "last_error", "created_at", "delivered_at"}
+
+// ListWebhookDeliveries serves /webhooks/deliveries, newest first. Filters:
+// webhook, status, event_type and event_id.
+func (s *Server) ListWebhookDeliveries() gin.HandlerFunc {
+	spec := listSpec{
+		entity:   "webhook_delivery",
+		query:    ListWebhookDeliveriesQuery,
+		columns:  deliveryColumns,
+		keys:     []string{"id"},
+		sortable: []string{"id"},
+		order:    "desc",
+		build:    func(row map[string]string) interface{} { return deliveryFromRow(row) },
+		filter: func(q listQuery, f *listFilter) error {
+			for _, col := range []string{"webhook", "status", "event_type", "event_id"} {
+				if v := q.get(col); v != "" {
+					f.add(col+" = ?", v)
+				}
+			}
+			return nil
+		},
+	}
+	return func(context *gin.Context) {
+		s.list(context, spec)
+	}
+}
+
+func (s *Server) findDelivery(ctx sqlctx.Context, id int64) (WebhookDelivery, error) {
+	vals := make([]sql.NullString, len(deliveryColumns))
+	ptrs := make([]interface{}, len(vals))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	done := timeQuery(ctx, FindWebhookDeliveryQuery)
+	err := s.conn.QueryRowContext(ctx, FindWebhookDeliveryQuery, id).Scan(ptrs...)
+	done(err)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+	row := make(map[string]string, len(deliveryColumns))
+	for i, c := range deliveryColumns {
+		row[c] = vals[i].String
+	}
+	return deliveryFromRow(row), nil
+}
+
+func deliveryID(context *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(context.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		context.String(http.StatusBadRequest, "id must be a positive integer")
+		return 0, false
+	}
+	return id, true
+}
+
+// FindWebhookDelivery serves /webhooks/deliveries/:id.
+func (s *Server) FindWebhookDelivery() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		context.Header("Content-Type", "application/json")
+		id, ok := deliveryID(context)
+		if !ok {
+			return
+		}
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		d, err := s.findDelivery(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			context.String(http.StatusNotFound, `{"server_message": "no such delivery"}`)
+			return
+		}
+		if err != nil {
+			s.log.ErrorContext(ctx, "unable to find webhook delivery", "id", id, "err", err)
+			context.JSON(http.StatusBadRequest, nil)
+			return
+		}
+		ret, err := json.Marshal(d)
+		if err != nil {
+			s.log.ErrorContext(ctx, "marshal webhook delivery", "err", err)
+			return
+		}
+		context.String(http.StatusOK, string(ret))
+	}
+}
+
+// RetryWebhookDelivery serves POST /webhooks/deliveries/:id/retry. It puts a
+// failed delivery back in the queue with a fresh attempt budget.
+func (s *Server) RetryWebhookDelivery() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		context.Header("Content-Type", "application/json")
+		id, ok := deliveryID(context)
+		if !ok {
+			return
+		}
+		ctx, cancelfunc := sqlctx.WithTimeout(context.Request.Context(), queryTimeout)
+		defer cancelfunc()
+		now := time.Now().UTC().Format(auditTimeLayout)
+		done := timeQuery(ctx, RetryWebhookDeliveryQuery)
+		res, err := s.conn.ExecContext(ctx, RetryWebhookDeliveryQuery, DeliveryPending, now, id, DeliveryFailed)
+		done(err)
+		var n int64
+		if err == nil {
+			n, err = res.RowsAffected()
+		}
+		if err != nil {
+			s.log.ErrorContext(ctx, "unable to retry webhook delivery", "id", id, "err", err)
+			context.JSON(http.StatusBadRequest, nil)
+			return
+		}
+		if n == 0 {
+			context.String(http.StatusConflict, `{"server_message": "delivery not found or not failed"}`)
+			return
+		}
+		s.audit.Record(ctx, AuditRecord{Action: AuditRetry, Target_type: "webhook_delivery", Target_id: strconv.FormatInt(id, 10)})
+		s.webhooks.notify()
+		context.String(http.StatusOK, `{"server_message": "delivery queued"}`)
+	}
+}
@@ -0,0 +1,252 @@
+package app
+
+import (
+	sqlctx "context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+func TestWebhookSignature(t *testing.T) {
+	// echo -n '1700000000.{"id":"x"}' | openssl dgst -sha256 -hmac s3cr3t
+	got := webhookSignature("s3cr3t", "1700000000", []byte(`{"id":"x"}`))
+	if want := "sha256=2a8e217061b97ef3c7309b8ae4d803970484a757ecfc3a1219d18876526b3eef"; got != want {
+		t.Fatalf("signature = %q, want %q", got, want)
+	}
+	if got == webhookSignature("other", "1700000000", []byte(`{"id":"x"}`)) ||
+		got == webhookSignature("s3cr3t", "1700000001", []byte(`{"id":"x"}`)) ||
+		got == webhookSignature("s3cr3t", "1700000000", []byte(`{"id":"y"}`)) {
+		t.Error("the signature does not cover the secret, timestamp and body")
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	for _, tc := range []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{1, 5 * time.Second},
+		{2, 10 * time.Second},
+		{5, 80 * time.Second},
+		{11, time.Hour},
+		{40, time.Hour},
+	} {
+		for i := 0; i < 50; i++ {
+			if d := webhookBackoff(tc.attempt); d < tc.max/2 || d > tc.max {
+				t.Fatalf("webhookBackoff(%d) = %v, want within [%v, %v]", tc.attempt, d, tc.max/2, tc.max)
+			}
+		}
+	}
+}
+
+func TestLoadWebhookEndpoints(t *testing.T) {
+	write := func(body string) string {
+		path := filepath.Join(t.TempDir(), "webhooks.json")
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	endpoints, err := LoadWebhookEndpoints(write(`[
+		{"name": "billing", "url": "http://b", "secret": "s"},
+		{"name": "siem", "url": "http://s", "secret": "t", "events": ["grant.deny"], "max_attempts": 3}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if endpoints[0].Max_attempts != defaultWebhookAttempts || endpoints[1].Max_attempts != 3 {
+		t.Errorf("max_attempts = %d, %d", endpoints[0].Max_attempts, endpoints[1].Max_attempts)
+	}
+	if !endpoints[0].wants(WebhookGrantExpired) || !endpoints[1].wants(WebhookGrantDeny) || endpoints[1].wants(WebhookGrantAllow) {
+		t.Error("events do not filter deliveries")
+	}
+
+	for _, bad := range []string{
+		`{"name": "a"}`,
+		`[{"url": "http://a", "secret": "s"}]`,
+		`[{"name": "a", "secret": "s"}]`,
+		`[{"name": "a", "url": "b.example/hooks", "secret": "s"}]`,
+		`[{"name": "a", "url": "ftp://b.example/hooks", "secret": "s"}]`,
+		`[{"name": "a", "url": "https:///hooks", "secret": "s"}]`,
+		`[{"name": "a", "url": "http://%zz", "secret": "s"}]`,
+		`[{"name": "a", "url": "http://a"}]`,
+		`[{"name": "a", "url": "http://a", "secret": "s", "events": ["grant.maybe"]}]`,
+		`[{"name": "a", "url": "http://a", "secret": "s", "max_attempts": -1}]`,
+		`[{"name": "a", "url": "http://a", "secret": "s"}, {"name": "a", "url": "http://b", "secret": "t"}]`,
+	} {
+		if _, err := LoadWebhookEndpoints(write(bad)); err == nil {
+			t.Errorf("%s was accepted", bad)
+		}
+	}
+}
+
+// An event is queued once per subscribed endpoint, in the caller's
+// transaction.
+func TestQueueWebhook(t *testing.T) {
+	s, mock := newTestServer(t)
+	s.webhooks = newWebhookDispatcher([]WebhookEndpoint{
+		{Name: "billing", Url: "http://b", Secret: "s", Max_attempts: 1},
+		{Name: "siem", Url: "http://s", Secret: "t", Events: []string{WebhookGrantDeny}, Max_attempts: 1},
+	})
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(InsertWebhookDeliveryQuery)).
+		WithArgs(sqlmock.AnyArg(), "billing", WebhookGrantAllow, sqlmock.AnyArg(), DeliveryPending, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ctx := sqlctx.Background()
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.queueWebhook(ctx, tx, WebhookEvent{Type: WebhookGrantAllow, User_id: "alice", Table_name: "orders"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestQueueWebhookWithoutEndpoints(t *testing.T) {
+	s, mock := newTestServer(t)
+	s.webhooks = newWebhookDispatcher(nil)
+	mock.ExpectBegin()
+	tx, err := s.conn.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.queueWebhook(sqlctx.Background(), tx, WebhookEvent{Type: WebhookGrantAllow}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// deliverOnce runs one dispatcher pass over a single due row sent to url and
+// expects the outcome to be recorded as state after attempts tries.
+func deliverOnce(t *testing.T, url string, max_attempts int, prior int, state string) {
+	t.Helper()
+	s, mock := newTestServer(t)
+	s.webhooks = newWebhookDispatcher([]WebhookEndpoint{{Name: "billing", Url: url, Secret: "s3cr3t", Max_attempts: max_attempts}})
+	next := "2024-01-01 00:00:00.000000"
+	mock.ExpectQuery(regexp.QuoteMeta(ListDueWebhookDeliveriesQuery)).
+		WithArgs(DeliveryPending, sqlmock.AnyArg(), webhookBatch).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "webhook", "event_type", "payload", "attempts", "next_attempt_at"}).
+			AddRow(7, "billing", WebhookGrantAllow, `{"id":"ev1"}`, prior, next))
+	mock.ExpectExec(regexp.QuoteMeta(ClaimWebhookDeliveryQuery)).
+		WithArgs(sqlmock.AnyArg(), 7, DeliveryPending, next).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(UpdateWebhookDeliveryQuery)).
+		WithArgs(state, prior+1, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	n, err := s.deliverDue(sqlctx.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("deliverDue = %d, %v", n, err)
+	}
+}
+
+func TestDeliverSignsAndRecordsSuccess(t *testing.T) {
+	var got http.Header
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	deliverOnce(t, srv.URL, 3, 0, DeliveryDelivered)
+	if string(body) != `{"id":"ev1"}` {
+		t.Fatalf("body = %q", body)
+	}
+	if got.Get(webhookIdHeader) != "7" || got.Get(webhookEventHeader) != WebhookGrantAllow {
+		t.Errorf("headers = %v", got)
+	}
+	if want := webhookSignature("s3cr3t", got.Get(webhookTimestampHeader), body); got.Get(webhookSignatureHeader) != want {
+		t.Errorf("signature = %q, want %q", got.Get(webhookSignatureHeader), want)
+	}
+}
+
+func TestDeliverRetriesThenFails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	deliverOnce(t, srv.URL, 3, 0, DeliveryPending)
+	deliverOnce(t, srv.URL, 3, 2, DeliveryFailed)
+}
+
+// A row claimed by another server first is left alone.
+func TestDeliverSkipsRowsClaimedElsewhere(t *testing.T) {
+	s, mock := newTestServer(t)
+	s.webhooks = newWebhookDispatcher([]WebhookEndpoint{{Name: "billing", Url: "http://127.0.0.1:1", Secret: "s", Max_attempts: 1}})
+	mock.ExpectQuery(regexp.QuoteMeta(ListDueWebhookDeliveriesQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "webhook", "event_type", "payload", "attempts", "next_attempt_at"}).
+			AddRow(7, "billing", WebhookGrantAllow, `{}`, 0, "2024-01-01 00:00:00.000000"))
+	mock.ExpectExec(regexp.QuoteMeta(ClaimWebhookDeliveryQuery)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if _, err := s.deliverDue(sqlctx.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// The webhook routes are admin only, and never show endpoint secrets.
+func TestWebhookRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, mock := newTestServer(t)
+	withAdmin(s, "ops", "s3cret")
+	s.webhooks = newWebhookDispatcher([]WebhookEndpoint{{Name: "billing", Url: "http://b", Secret: "hunter2", Max_attempts: 1}})
+	r := gin.New()
+	r.GET("/webhooks", s.AdminOnly(), s.ListWebhooks())
+	r.POST("/webhooks/deliveries/:id/retry", s.AdminOnly(), s.RetryWebhookDelivery())
+
+	serve := func(method string, path string, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := serve(http.MethodGet, "/webhooks", ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("list without a token: status %d, want 401", w.Code)
+	}
+	w := serve(http.MethodGet, "/webhooks", "s3cret")
+	var endpoints []WebhookEndpoint
+	if err := json.Unmarshal(w.Body.Bytes(), &endpoints); err != nil || len(endpoints) != 1 || endpoints[0].Secret != "" {
+		t.Fatalf("list = %d %s", w.Code, w.Body)
+	}
+
+	if w := serve(http.MethodPost, "/webhooks/deliveries/7/retry", ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("retry without a token: status %d, want 401", w.Code)
+	}
+	if w := serve(http.MethodPost, "/webhooks/deliveries/x/retry", "s3cret"); w.Code != http.StatusBadRequest {
+		t.Fatalf("retry of a bad id: status %d, want 400", w.Code)
+	}
+	mock.ExpectExec(regexp.QuoteMeta(RetryWebhookDeliveryQuery)).
+		WithArgs(DeliveryPending, sqlmock.AnyArg(), 8, DeliveryFailed).WillReturnResult(sqlmock.NewResult(0, 0))
+	if w := serve(http.MethodPost, "/webhooks/deliveries/8/retry", "s3cret"); w.Code != http.StatusConflict {
+		t.Fatalf("retry of a delivery that has not failed: status %d, want 409", w.Code)
+	}
+	mock.ExpectExec(regexp.QuoteMeta(RetryWebhookDeliveryQuery)).
+		WithArgs(DeliveryPending, sqlmock.AnyArg(), 7, DeliveryFailed).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectAudit(mock, "admin:ops", AuditRetry)
+	if w := serve(http.MethodPost, "/webhooks/deliveries/7/retry", "s3cret"); w.Code != http.StatusOK {
+		t.Fatalf("retry: status %d, want 200", w.Code)
+	}
+	select {
+	case <-s.webhooks.wake:
+	default:
+		t.Error("a retry did not wake the dispatcher")
+	}
+}
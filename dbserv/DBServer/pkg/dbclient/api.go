@@ -0,0 +1,699 @@
+package dbclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"RemoteTestServer/pkg/apiclient"
+)
+
+type (
+	UserAttrs             = apiclient.UserAttrs
+	UserCheckInfo         = apiclient.UserCheckInfo
+	UserList              = apiclient.UserList
+	NewUser               = apiclient.InsertUserAttrsRequest
+	DevAttrs              = apiclient.DevAttrs
+	DevActions            = apiclient.DevActions
+	DevCheckInfo          = apiclient.DevCheckInfo
+	DevInfo               = apiclient.DevInfo
+	DeviceList            = apiclient.DeviceList
+	NewDevice             = apiclient.InsertDevInfoFullRequest
+	Policy                = apiclient.Policy
+	PolicyList            = apiclient.PolicyList
+	PolicyTest            = apiclient.PolicyTest
+	PolicyTestReport      = apiclient.PolicyTestReport
+	PolicyTestResult      = apiclient.PolicyTestResult
+	LineRange             = apiclient.LineRange
+	Hierarchy             = apiclient.Hierarchy
+	HierarchyList         = apiclient.HierarchyList
+	Grant                 = apiclient.DBAccess
+	GrantList             = apiclient.DBAccessList
+	GrantUpdate           = apiclient.GrantUpdate
+	GrantSpec             = apiclient.GrantSpec
+	GrantSpecDecision     = apiclient.GrantSpecDecision
+	IssueGrantRequest     = apiclient.IssueGrantRequest
+	IssuedGrant           = apiclient.IssuedGrant
+	ArchivedGrant         = apiclient.ArchivedGrant
+	ArchivedGrantList     = apiclient.ArchivedGrantList
+	AccessRequest         = apiclient.AccessRequest
+	AccessRequestEvent    = apiclient.AccessRequestEvent
+	AccessRequestList     = apiclient.AccessRequestList
+	NewAccessRequest      = apiclient.NewAccessRequest
+	AccessRequestStatus   = apiclient.AccessRequestStatus
+	AccessRequestAction   = apiclient.AccessRequestEventAction
+	Role                  = apiclient.Role
+	RoleGrant             = apiclient.RoleGrant
+	RoleList              = apiclient.RoleList
+	RoleMember            = apiclient.RoleMember
+	RoleMemberKind        = apiclient.RoleMemberKind
+	UserRole              = apiclient.UserRole
+	GroupMembers          = apiclient.GroupMembers
+	ObjectNode            = apiclient.ObjectNode
+	ObjectPolicy          = apiclient.ObjectPolicy
+	ObjectList            = apiclient.ObjectList
+	PolicyChain           = apiclient.PolicyChain
+	PolicyChainLink       = apiclient.PolicyChainLink
+	SimTuple              = apiclient.SimTuple
+	SimulateRequest       = apiclient.SimulateRequest
+	SimulateReport        = apiclient.SimulateReport
+	SimResult             = apiclient.SimResult
+	AuditRecord           = apiclient.AuditRecord
+	AuditList             = apiclient.AuditList
+	ChangeEvent           = apiclient.ChangeEvent
+	ChangeEventEntityType = apiclient.ChangeEventEntityType
+	ImportReport          = apiclient.ImportReport
+	ImportRowError        = apiclient.ImportRowError
+	WebhookEndpoint       = apiclient.WebhookEndpoint
+	WebhookDelivery       = apiclient.WebhookDelivery
+	DeliveryList          = apiclient.WebhookDeliveryList
+	ReadyReport           = apiclient.ReadyReport
+	StatusReport          = apiclient.StatusReport
+)
+
+// Bulk formats and entities, as accepted by Import and Export.
+const (
+	FormatJSONL = "jsonl"
+	FormatCSV   = "csv"
+
+	EntityUsers       = "users"
+	EntityDevices     = "devices"
+	EntityPolicies    = "policies"
+	EntityHierarchies = "hierarchies"
+	EntityGrants      = "grants"
+)
+
+// Role member kinds, as taken by AssignRole and UnassignRole.
+const (
+	MemberUser  RoleMemberKind = apiclient.RoleMemberKindUser
+	MemberGroup RoleMemberKind = apiclient.RoleMemberKindGroup
+)
+
+// ListOptions pages through a collection. Filters takes the query parameters
+// the collection understands, e.g. prefix, dev_type or expires_before; see
+// the OpenAPI document for each.
+type ListOptions struct {
+	Limit   int
+	Sort    string
+	Order   string
+	Cursor  string
+	Filters map[string]string
+}
+
+func (o ListOptions) query() url.Values {
+	q := url.Values{}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Sort != "" {
+		q.Set("sort", o.Sort)
+	}
+	if o.Order != "" {
+		q.Set("order", o.Order)
+	}
+	if o.Cursor != "" {
+		q.Set("cursor", o.Cursor)
+	}
+	for k, v := range o.Filters {
+		q.Set(k, v)
+	}
+	return q
+}
+
+// WatchOptions selects a change stream: events after seq Since, of the
+// entity types listed (policy, user, device; empty means all).
+type WatchOptions struct {
+	Since    int64
+	Entities []string
+}
+
+// API is every DBServer operation. Client implements it over HTTP and Mock
+// in memory. Writes return the number of rows they touched.
+type API interface {
+	ListUsers(ctx context.Context, opts ListOptions) (*UserList, error)
+	CreateUser(ctx context.Context, user NewUser) (int64, error)
+	GetUser(ctx context.Context, userID string) (*UserAttrs, error)
+	UpdateUserAttrs(ctx context.Context, userID string, attrs string) (int64, error)
+	GetUserCheckInfo(ctx context.Context, userID string) (*UserCheckInfo, error)
+
+	ListDevices(ctx context.Context, opts ListOptions) (*DeviceList, error)
+	CreateDevice(ctx context.Context, dev NewDevice) (int64, error)
+	GetDevice(ctx context.Context, devID string) (*DevAttrs, error)
+	GetDeviceActions(ctx context.Context, devID string) (*DevActions, error)
+	GetDeviceCheckInfo(ctx context.Context, devID string) (*DevCheckInfo, error)
+
+	ListPolicies(ctx context.Context, opts ListOptions) (*PolicyList, error)
+	CreatePolicy(ctx context.Context, p Policy) (int64, error)
+	GetPolicy(ctx context.Context, ref string) (*Policy, error)
+	UpdatePolicy(ctx context.Context, ref string, content string) (int64, error)
+	ListPolicyTests(ctx context.Context, ref string) ([]PolicyTest, error)
+	PutPolicyTest(ctx context.Context, ref string, name string, content string) (*PolicyTest, error)
+	DeletePolicyTest(ctx context.Context, ref string, name string) error
+	RunPolicyTests(ctx context.Context, ref string, content string) (*PolicyTestReport, error)
+
+	ListHierarchies(ctx context.Context, opts ListOptions) (*HierarchyList, error)
+	CreateHierarchy(ctx context.Context, h Hierarchy) (int64, error)
+	GetHierarchy(ctx context.Context, objID string, action string) (*Hierarchy, error)
+	UpdateHierarchy(ctx context.Context, objID string, action string, hierarchy string) (int64, error)
+
+	ListGrants(ctx context.Context, opts ListOptions) (*GrantList, error)
+	CreateGrant(ctx context.Context, g Grant) (int64, error)
+	GetGrant(ctx context.Context, userID string, table string) (*Grant, error)
+	UpdateGrant(ctx context.Context, userID string, table string, u GrantUpdate) (int64, error)
+	SubmitGrantToken(ctx context.Context, token string) error
+	ListGrantHistory(ctx context.Context, opts ListOptions) (*ArchivedGrantList, error)
+	IssueGrantToken(ctx context.Context, req IssueGrantRequest) (*IssuedGrant, error)
+
+	ListAccessRequests(ctx context.Context, opts ListOptions) (*AccessRequestList, error)
+	SubmitAccessRequest(ctx context.Context, user string, password string, req NewAccessRequest) (*AccessRequest, error)
+	GetAccessRequest(ctx context.Context, id int64) (*AccessRequest, error)
+	ApproveAccessRequest(ctx context.Context, id int64, comment string) (*AccessRequest, error)
+	RejectAccessRequest(ctx context.Context, id int64, comment string) (*AccessRequest, error)
+
+	ListRoles(ctx context.Context, opts ListOptions) (*RoleList, error)
+	CreateRole(ctx context.Context, r Role) (*Role, error)
+	GetRole(ctx context.Context, name string) (*Role, error)
+	UpdateRole(ctx context.Context, name string, r Role) (*Role, error)
+	DeleteRole(ctx context.Context, name string) error
+	AssignRole(ctx context.Context, name string, kind RoleMemberKind, id string) (int64, error)
+	UnassignRole(ctx context.Context, name string, kind RoleMemberKind, id string) (int64, error)
+	GetGroup(ctx context.Context, group string) (*GroupMembers, error)
+	AddGroupMember(ctx context.Context, group string, userID string) (int64, error)
+	RemoveGroupMember(ctx context.Context, group string, userID string) (int64, error)
+	GetUserRoles(ctx context.Context, userID string) ([]UserRole, error)
+
+	ListObjects(ctx context.Context, opts ListOptions) (*ObjectList, error)
+	CreateObject(ctx context.Context, o ObjectNode) (*ObjectNode, error)
+	GetObject(ctx context.Context, objID string) (*ObjectNode, error)
+	UpdateObject(ctx context.Context, objID string, o ObjectNode) (*ObjectNode, error)
+	DeleteObject(ctx context.Context, objID string) error
+	SetObjectPolicies(ctx context.Context, objID string, action string, refs []string) (*ObjectNode, error)
+	GetPolicyChain(ctx context.Context, objID string, action string) (*PolicyChain, error)
+
+	Simulate(ctx context.Context, req SimulateRequest, flippedOnly bool) (*SimulateReport, error)
+
+	ListAudit(ctx context.Context, opts ListOptions) (*AuditList, error)
+	WatchChanges(ctx context.Context, opts WatchOptions, fn func(ChangeEvent) error) error
+
+	Import(ctx context.Context, entity string, format string, r io.Reader, dryRun bool) (*ImportReport, error)
+	Export(ctx context.Context, entity string, format string, w io.Writer) error
+
+	ListWebhooks(ctx context.Context) ([]WebhookEndpoint, error)
+	ListWebhookDeliveries(ctx context.Context, opts ListOptions) (*DeliveryList, error)
+	GetWebhookDelivery(ctx context.Context, id int64) (*WebhookDelivery, error)
+	RetryWebhookDelivery(ctx context.Context, id int64) error
+
+	Ready(ctx context.Context) (*ReadyReport, error)
+	Status(ctx context.Context) (*StatusReport, error)
+}
+
+var _ API = (*Client)(nil)
+
+func get[T any](c *Client, ctx context.Context, path string, q url.Values) (*T, error) {
+	var out T
+	if err := c.do(ctx, request{method: http.MethodGet, path: path, query: q}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) write(ctx context.Context, method string, path string, body interface{}) (int64, error) {
+	var out apiclient.WriteResult
+	if err := c.do(ctx, request{method: method, path: path, body: body}, &out); err != nil {
+		return 0, err
+	}
+	return out.Rows, nil
+}
+
+func (c *Client) ListUsers(ctx context.Context, opts ListOptions) (*UserList, error) {
+	return get[UserList](c, ctx, route("v1", "users"), opts.query())
+}
+
+func (c *Client) CreateUser(ctx context.Context, user NewUser) (int64, error) {
+	return c.write(ctx, http.MethodPost, route("v1", "users"), user)
+}
+
+func (c *Client) GetUser(ctx context.Context, userID string) (*UserAttrs, error) {
+	return get[UserAttrs](c, ctx, route("v1", "users", userID), nil)
+}
+
+func (c *Client) UpdateUserAttrs(ctx context.Context, userID string, attrs string) (int64, error) {
+	return c.write(ctx, http.MethodPut, route("v1", "users", userID), apiclient.UserAttrsUpdate{Attrs: attrs})
+}
+
+func (c *Client) GetUserCheckInfo(ctx context.Context, userID string) (*UserCheckInfo, error) {
+	return get[UserCheckInfo](c, ctx, route("v1", "users", userID, "check_info"), nil)
+}
+
+func (c *Client) ListDevices(ctx context.Context, opts ListOptions) (*DeviceList, error) {
+	return get[DeviceList](c, ctx, route("v1", "devices"), opts.query())
+}
+
+func (c *Client) CreateDevice(ctx context.Context, dev NewDevice) (int64, error) {
+	return c.write(ctx, http.MethodPost, route("v1", "devices"), dev)
+}
+
+func (c *Client) GetDevice(ctx context.Context, devID string) (*DevAttrs, error) {
+	return get[DevAttrs](c, ctx, route("v1", "devices", devID), nil)
+}
+
+func (c *Client) GetDeviceActions(ctx context.Context, devID string) (*DevActions, error) {
+	return get[DevActions](c, ctx, route("v1", "devices", devID, "actions"), nil)
+}
+
+func (c *Client) GetDeviceCheckInfo(ctx context.Context, devID string) (*DevCheckInfo, error) {
+	return get[DevCheckInfo](c, ctx, route("v1", "devices", devID, "check_info"), nil)
+}
+
+func (c *Client) ListPolicies(ctx context.Context, opts ListOptions) (*PolicyList, error) {
+	return get[PolicyList](c, ctx, route("v1", "policies"), opts.query())
+}
+
+func (c *Client) CreatePolicy(ctx context.Context, p Policy) (int64, error) {
+	return c.write(ctx, http.MethodPost, route("v1", "policies"), p)
+}
+
+func (c *Client) GetPolicy(ctx context.Context, ref string) (*Policy, error) {
+	return get[Policy](c, ctx, route("v1", "policies", ref), nil)
+}
+
+// UpdatePolicy replaces a policy's content. When the policy has stored
+// tests they must pass against the new content, or the update is refused
+// with a 422.
+func (c *Client) UpdatePolicy(ctx context.Context, ref string, content string) (int64, error) {
+	return c.write(ctx, http.MethodPut, route("v1", "policies", ref), apiclient.PolicyUpdate{Content: content})
+}
+
+// ListPolicyTests reads the Rego test modules stored with a policy.
+func (c *Client) ListPolicyTests(ctx context.Context, ref string) ([]PolicyTest, error) {
+	out, err := get[[]PolicyTest](c, ctx, route("v1", "policies", ref, "tests"), nil)
+	if err != nil {
+		return nil, err
+	}
+	return *out, nil
+}
+
+// PutPolicyTest stores a test module with a policy, replacing one of the
+// same name. It is not run.
+func (c *Client) PutPolicyTest(ctx context.Context, ref string, name string, content string) (*PolicyTest, error) {
+	var out PolicyTest
+	if err := c.do(ctx, request{method: http.MethodPut, path: route("v1", "policies", ref, "tests", name), body: PolicyTest{Content: content}}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) DeletePolicyTest(ctx context.Context, ref string, name string) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: route("v1", "policies", ref, "tests", name)}, nil)
+}
+
+// RunPolicyTests runs a policy's stored tests, against content when it is
+// not empty and against the stored policy otherwise. Failing tests are
+// reported, not returned as an error.
+func (c *Client) RunPolicyTests(ctx context.Context, ref string, content string) (*PolicyTestReport, error) {
+	var body interface{}
+	if content != "" {
+		body = apiclient.PolicyUpdate{Content: content}
+	}
+	var out PolicyTestReport
+	if err := c.do(ctx, request{method: http.MethodPost, path: route("v1", "policies", ref, "tests", "run"), body: body}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) ListHierarchies(ctx context.Context, opts ListOptions) (*HierarchyList, error) {
+	return get[HierarchyList](c, ctx, route("v1", "hierarchies"), opts.query())
+}
+
+func (c *Client) CreateHierarchy(ctx context.Context, h Hierarchy) (int64, error) {
+	return c.write(ctx, http.MethodPost, route("v1", "hierarchies"), h)
+}
+
+func (c *Client) GetHierarchy(ctx context.Context, objID string, action string) (*Hierarchy, error) {
+	return get[Hierarchy](c, ctx, route("v1", "hierarchies", objID, action), nil)
+}
+
+func (c *Client) UpdateHierarchy(ctx context.Context, objID string, action string, hierarchy string) (int64, error) {
+	return c.write(ctx, http.MethodPut, route("v1", "hierarchies", objID, action), apiclient.HierarchyUpdate{Hierarchy: hierarchy})
+}
+
+func (c *Client) ListGrants(ctx context.Context, opts ListOptions) (*GrantList, error) {
+	return get[GrantList](c, ctx, route("v1", "grants"), opts.query())
+}
+
+func (c *Client) CreateGrant(ctx context.Context, g Grant) (int64, error) {
+	return c.write(ctx, http.MethodPost, route("v1", "grants"), g)
+}
+
+func (c *Client) GetGrant(ctx context.Context, userID string, table string) (*Grant, error) {
+	return get[Grant](c, ctx, route("v1", "grants", userID, table), nil)
+}
+
+func (c *Client) UpdateGrant(ctx context.Context, userID string, table string, u GrantUpdate) (int64, error) {
+	return c.write(ctx, http.MethodPatch, route("v1", "grants", userID, table), u)
+}
+
+// SubmitGrantToken applies a signed grant token. A token the server rejects
+// is an APIError with status 401; one naming a table the user has no grant
+// row for is a 409, and the token stays unused.
+func (c *Client) SubmitGrantToken(ctx context.Context, token string) error {
+	return c.do(ctx, request{method: http.MethodPost, path: route("v1", "grants", "tokens"), body: apiclient.JWTRequest{ClientMessage: token}}, nil)
+}
+
+// ListGrantHistory pages through grants the server's expiry sweeper
+// archived, newest first. Filters are user_id and table_name.
+func (c *Client) ListGrantHistory(ctx context.Context, opts ListOptions) (*ArchivedGrantList, error) {
+	return get[ArchivedGrantList](c, ctx, route("v1", "grants", "history"), opts.query())
+}
+
+// IssueGrantToken asks the server to mint a grant token. The client's Token
+// must be a grant issuer token; the server answers 403 when the request is
+// beyond that issuer's limits.
+func (c *Client) IssueGrantToken(ctx context.Context, req IssueGrantRequest) (*IssuedGrant, error) {
+	var out IssuedGrant
+	if err := c.do(ctx, request{method: http.MethodPost, path: route("v1", "grants", "tokens", "issue"), body: req}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAccessRequests pages through access requests, newest first. Filters
+// are user_id, table_name and status.
+func (c *Client) ListAccessRequests(ctx context.Context, opts ListOptions) (*AccessRequestList, error) {
+	return get[AccessRequestList](c, ctx, route("v1", "access_requests"), opts.query())
+}
+
+// SubmitAccessRequest files a request for user, who signs in with their
+// password; the request is always filed for them.
+func (c *Client) SubmitAccessRequest(ctx context.Context, user string, password string, req NewAccessRequest) (*AccessRequest, error) {
+	req.UserId = &user
+	var out AccessRequest
+	r := request{method: http.MethodPost, path: route("v1", "access_requests"), body: req, basic: url.UserPassword(user, password)}
+	if err := c.do(ctx, r, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAccessRequest reads one request with its history.
+func (c *Client) GetAccessRequest(ctx context.Context, id int64) (*AccessRequest, error) {
+	return get[AccessRequest](c, ctx, route("v1", "access_requests", strconv.FormatInt(id, 10)), nil)
+}
+
+// ApproveAccessRequest approves a pending request, which extends the user's
+// access. The client's Token must be a grant issuer token whose limits cover
+// the request; a request that was already decided is a 409.
+func (c *Client) ApproveAccessRequest(ctx context.Context, id int64, comment string) (*AccessRequest, error) {
+	return c.decideAccessRequest(ctx, id, "approve", comment)
+}
+
+// RejectAccessRequest rejects a pending request, under the same rules as
+// ApproveAccessRequest.
+func (c *Client) RejectAccessRequest(ctx context.Context, id int64, comment string) (*AccessRequest, error) {
+	return c.decideAccessRequest(ctx, id, "reject", comment)
+}
+
+func (c *Client) decideAccessRequest(ctx context.Context, id int64, verb string, comment string) (*AccessRequest, error) {
+	var out AccessRequest
+	body := apiclient.AccessRequestDecision{}
+	if comment != "" {
+		body.Comment = &comment
+	}
+	if err := c.do(ctx, request{method: http.MethodPost, path: route("v1", "access_requests", strconv.FormatInt(id, 10), verb), body: body}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRoles pages through roles, without their grants and members. The
+// filter is prefix.
+func (c *Client) ListRoles(ctx context.Context, opts ListOptions) (*RoleList, error) {
+	return get[RoleList](c, ctx, route("v1", "roles"), opts.query())
+}
+
+// CreateRole creates a role with its grants; a taken name is a 409.
+func (c *Client) CreateRole(ctx context.Context, r Role) (*Role, error) {
+	var out Role
+	if err := c.do(ctx, request{method: http.MethodPost, path: route("v1", "roles"), body: r}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRole reads one role with its grants and members.
+func (c *Client) GetRole(ctx context.Context, name string) (*Role, error) {
+	return get[Role](c, ctx, route("v1", "roles", name), nil)
+}
+
+// UpdateRole replaces a role's description, attribute defaults and grants.
+// Its members are kept.
+func (c *Client) UpdateRole(ctx context.Context, name string, r Role) (*Role, error) {
+	var out Role
+	if err := c.do(ctx, request{method: http.MethodPut, path: route("v1", "roles", name), body: r}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteRole deletes a role with its grants and assignments.
+func (c *Client) DeleteRole(ctx context.Context, name string) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: route("v1", "roles", name)}, nil)
+}
+
+// AssignRole gives the role to a user or a group. Assigning it twice
+// writes no rows.
+func (c *Client) AssignRole(ctx context.Context, name string, kind RoleMemberKind, id string) (int64, error) {
+	return c.write(ctx, http.MethodPut, route("v1", "roles", name, "members", string(kind), id), nil)
+}
+
+func (c *Client) UnassignRole(ctx context.Context, name string, kind RoleMemberKind, id string) (int64, error) {
+	return c.write(ctx, http.MethodDelete, route("v1", "roles", name, "members", string(kind), id), nil)
+}
+
+// GetGroup lists a group's members; a group without members is not found.
+func (c *Client) GetGroup(ctx context.Context, group string) (*GroupMembers, error) {
+	return get[GroupMembers](c, ctx, route("v1", "groups", group), nil)
+}
+
+func (c *Client) AddGroupMember(ctx context.Context, group string, userID string) (int64, error) {
+	return c.write(ctx, http.MethodPut, route("v1", "groups", group, "members", userID), nil)
+}
+
+func (c *Client) RemoveGroupMember(ctx context.Context, group string, userID string) (int64, error) {
+	return c.write(ctx, http.MethodDelete, route("v1", "groups", group, "members", userID), nil)
+}
+
+// GetUserRoles lists the roles a user holds, once per way of holding each.
+func (c *Client) GetUserRoles(ctx context.Context, userID string) ([]UserRole, error) {
+	out, err := get[[]UserRole](c, ctx, route("v1", "users", userID, "roles"), nil)
+	if err != nil {
+		return nil, err
+	}
+	return *out, nil
+}
+
+// ListObjects pages through the object tree, without policies. Filters
+// are prefix, parent_id, kind and root=true.
+func (c *Client) ListObjects(ctx context.Context, opts ListOptions) (*ObjectList, error) {
+	return get[ObjectList](c, ctx, route("v1", "objects"), opts.query())
+}
+
+// CreateObject adds an object under an existing parent, or as a root when
+// ParentId is nil.
+func (c *Client) CreateObject(ctx context.Context, o ObjectNode) (*ObjectNode, error) {
+	var out ObjectNode
+	if err := c.do(ctx, request{method: http.MethodPost, path: route("v1", "objects"), body: o}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetObject reads one object with its policies and children.
+func (c *Client) GetObject(ctx context.Context, objID string) (*ObjectNode, error) {
+	return get[ObjectNode](c, ctx, route("v1", "objects", objID), nil)
+}
+
+// UpdateObject moves an object and sets its kind. A move that would make
+// the object its own ancestor is a 400.
+func (c *Client) UpdateObject(ctx context.Context, objID string, o ObjectNode) (*ObjectNode, error) {
+	var out ObjectNode
+	if err := c.do(ctx, request{method: http.MethodPut, path: route("v1", "objects", objID), body: o}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteObject deletes an object without children; one with children is a
+// 409.
+func (c *Client) DeleteObject(ctx context.Context, objID string) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: route("v1", "objects", objID)}, nil)
+}
+
+// SetObjectPolicies replaces the refs attached to an object for action.
+func (c *Client) SetObjectPolicies(ctx context.Context, objID string, action string, refs []string) (*ObjectNode, error) {
+	var out ObjectNode
+	body := apiclient.PolicyRefs{PolicyRefs: append([]string{}, refs...)}
+	if err := c.do(ctx, request{method: http.MethodPut, path: route("v1", "objects", objID, "policies", action), body: body}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPolicyChain resolves the refs an object inherits for action, from the
+// root down.
+func (c *Client) GetPolicyChain(ctx context.Context, objID string, action string) (*PolicyChain, error) {
+	return get[PolicyChain](c, ctx, route("v1", "objects", objID, "effective", action), nil)
+}
+
+// Simulate reports which decisions the proposed policies and attributes in
+// req would flip. Nothing is stored. With flippedOnly the report lists only
+// flipped and failed decisions; the counts cover all of them.
+func (c *Client) Simulate(ctx context.Context, req SimulateRequest, flippedOnly bool) (*SimulateReport, error) {
+	var q url.Values
+	if flippedOnly {
+		q = url.Values{"flipped": {"true"}}
+	}
+	var out SimulateReport
+	if err := c.do(ctx, request{method: http.MethodPost, path: route("v1", "simulate"), query: q, body: req}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAudit pages through the audit log, newest first. Filters are actor,
+// action, target_type, target_id, decision, request_id, since and until.
+func (c *Client) ListAudit(ctx context.Context, opts ListOptions) (*AuditList, error) {
+	return get[AuditList](c, ctx, route("audit"), opts.query())
+}
+
+// WatchChanges follows the server's change stream, calling fn for every
+// event until ctx is done, fn returns an error or the stream breaks. To
+// resume after an error, call it again with Since set to the last Seq seen.
+// The stream is long-lived, so it is not subject to Config.Timeout.
+func (c *Client) WatchChanges(ctx context.Context, opts WatchOptions, fn func(ChangeEvent) error) error {
+	q := url.Values{}
+	if opts.Since > 0 {
+		q.Set("since", strconv.FormatInt(opts.Since, 10))
+	}
+	if len(opts.Entities) > 0 {
+		q.Set("entity", strings.Join(opts.Entities, ","))
+	}
+	stream := *c
+	streamClient := *c.http
+	streamClient.Timeout = 0
+	stream.http = &streamClient
+	resp, err := stream.send(ctx, request{method: http.MethodGet, path: route("changes"), query: q})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	sc := bufio.NewScanner(resp.Body)
+	var data strings.Builder
+	for sc.Scan() {
+		line := sc.Text()
+		switch {
+		case line == "":
+			if data.Len() == 0 {
+				continue
+			}
+			var ev ChangeEvent
+			if err := json.Unmarshal([]byte(data.String()), &ev); err != nil {
+				return fmt.Errorf("dbclient: change stream: %w", err)
+			}
+			data.Reset()
+			if err := fn(ev); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := sc.Err(); err != nil {
+		return fmt.Errorf("dbclient: change stream: %w", err)
+	}
+	return io.ErrUnexpectedEOF
+}
+
+// Import uploads a JSON Lines or CSV file. When rows fail validation the
+// report is returned together with an APIError (status 400) and nothing
+// has been written.
+func (c *Client) Import(ctx context.Context, entity string, format string, r io.Reader, dryRun bool) (*ImportReport, error) {
+	q := url.Values{"format": {format}}
+	if dryRun {
+		q.Set("dry_run", "true")
+	}
+	contentType := "application/x-ndjson"
+	if format == FormatCSV {
+		contentType = "text/csv"
+	}
+	resp, err := c.send(ctx, request{method: http.MethodPost, path: route("import", entity), query: q, body: r, contentType: contentType})
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+			var report ImportReport
+			if json.Unmarshal([]byte(apiErr.Message), &report) == nil && report.Entity != "" {
+				return &report, err
+			}
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var report ImportReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return nil, fmt.Errorf("dbclient: import: decode response: %w", err)
+	}
+	return &report, nil
+}
+
+// Export streams every row of entity to w.
+func (c *Client) Export(ctx context.Context, entity string, format string, w io.Writer) error {
+	resp, err := c.send(ctx, request{method: http.MethodGet, path: route("export", entity), query: url.Values{"format": {format}}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+func (c *Client) ListWebhooks(ctx context.Context) ([]WebhookEndpoint, error) {
+	out, err := get[[]WebhookEndpoint](c, ctx, route("webhooks"), nil)
+	if err != nil {
+		return nil, err
+	}
+	return *out, nil
+}
+
+func (c *Client) ListWebhookDeliveries(ctx context.Context, opts ListOptions) (*DeliveryList, error) {
+	return get[DeliveryList](c, ctx, route("webhooks", "deliveries"), opts.query())
+}
+
+func (c *Client) GetWebhookDelivery(ctx context.Context, id int64) (*WebhookDelivery, error) {
+	return get[WebhookDelivery](c, ctx, route("webhooks", "deliveries", strconv.FormatInt(id, 10)), nil)
+}
+
+func (c *Client) RetryWebhookDelivery(ctx context.Context, id int64) error {
+	return c.do(ctx, request{method: http.MethodPost, path: route("webhooks", "deliveries", strconv.FormatInt(id, 10), "retry")}, nil)
+}
+
+// Ready reports the server's readiness checks. A server that is not ready
+// answers 503, which comes back as an APIError after the retries.
+func (c *Client) Ready(ctx context.Context) (*ReadyReport, error) {
+	return get[ReadyReport](c, ctx, route("readyz"), nil)
+}
+
+func (c *Client) Status(ctx context.Context) (*StatusReport, error) {
+	return get[StatusReport](c, ctx, route("debug", "status"), nil)
+}
@@ -0,0 +1,308 @@
+// Package dbclient is the Go SDK for DBServer. Client talks to the /v1 API
+// over HTTP with a configurable base URL, timeouts, retries with backoff and
+// bearer token injection; Mock is an in-memory implementation of the same
+// API interface for tests.
+//
+// Request and response types are those of pkg/apiclient, which is generated
+// from the server's OpenAPI document, so the SDK and the spec cannot drift.
+package dbclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultTimeout    = 30 * time.Second
+	defaultMaxRetries = 3
+	defaultBackoff    = 100 * time.Millisecond
+	defaultMaxBackoff = 5 * time.Second
+)
+
+var (
+	// ErrNotFound matches an APIError for a row that does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrForbidden matches an APIError for a read the server refused.
+	ErrForbidden = errors.New("forbidden")
+)
+
+// Config configures a Client. Only BaseURL is required.
+type Config struct {
+	// BaseURL is the server's root, e.g. http://localhost:3333.
+	BaseURL string
+	// Timeout bounds each attempt, including reading the body. Zero means
+	// 30s. Calls are also bounded by their context.
+	Timeout time.Duration
+	// MaxRetries is how often an idempotent call (GET, PUT, PATCH) is
+	// retried after a network error, 429 or 5xx. Zero means 3; negative
+	// disables retries.
+	MaxRetries int
+	// Backoff is the first retry delay, doubled per attempt up to
+	// MaxBackoff and jittered. A Retry-After header from the server wins.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// Token is sent as a bearer token. TokenSource, if set, is asked for a
+	// token before every attempt instead, so that it can refresh it.
+	Token       string
+	TokenSource func(ctx context.Context) (string, error)
+	// HTTPClient replaces the default client; Timeout is then ignored.
+	HTTPClient *http.Client
+}
+
+// Client calls DBServer over HTTP. It is safe for concurrent use.
+type Client struct {
+	base       *url.URL
+	http       *http.Client
+	maxRetries int
+	backoff    time.Duration
+	maxBackoff time.Duration
+	token      func(ctx context.Context) (string, error)
+}
+
+// New returns a Client for cfg.
+func New(cfg Config) (*Client, error) {
+	base, err := url.Parse(strings.TrimRight(cfg.BaseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("dbclient: base url: %w", err)
+	}
+	if base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("dbclient: base url %q must be absolute", cfg.BaseURL)
+	}
+	c := &Client{
+		base:       base,
+		http:       cfg.HTTPClient,
+		maxRetries: cfg.MaxRetries,
+		backoff:    cfg.Backoff,
+		maxBackoff: cfg.MaxBackoff,
+		token:      cfg.TokenSource,
+	}
+	if c.http == nil {
+		timeout := cfg.Timeout
+		if timeout == 0 {
+			timeout = defaultTimeout
+		}
+		c.http = &http.Client{Timeout: timeout}
+	}
+	if c.maxRetries == 0 {
+		c.maxRetries = defaultMaxRetries
+	} else if c.maxRetries < 0 {
+		c.maxRetries = 0
+	}
+	if c.backoff <= 0 {
+		c.backoff = defaultBackoff
+	}
+	if c.maxBackoff <= 0 {
+		c.maxBackoff = defaultMaxBackoff
+	}
+	if c.token == nil && cfg.Token != "" {
+		token := cfg.Token
+		c.token = func(context.Context) (string, error) { return token, nil }
+	}
+	return c, nil
+}
+
+// APIError is a response outside 2xx. Message is the server's error text.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("dbclient: %s %s: %d %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+// request describes one call. path is escaped (see route). body is
+// JSON-encoded unless it is an io.Reader, which is sent as is with
+// contentType.
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	body        interface{}
+	contentType string
+	// basic, when set, is sent as HTTP Basic credentials instead of the
+	// bearer token.
+	basic *url.Userinfo
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func retryable(code int) bool {
+	return code == http.StatusTooManyRequests || (code >= 500 && code != http.StatusNotImplemented)
+}
+
+// send performs req with retries and returns the successful response, whose
+// body the caller must close.
+func (c *Client) send(ctx context.Context, req request) (*http.Response, error) {
+	var payload []byte
+	var stream io.Reader
+	contentType := req.contentType
+	switch b := req.body.(type) {
+	case nil:
+	case io.Reader:
+		stream = b
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("dbclient: encode request: %w", err)
+		}
+		payload = data
+		contentType = "application/json"
+	}
+
+	target := c.base.String() + req.path
+	if len(req.query) > 0 {
+		target += "?" + req.query.Encode()
+	}
+
+	// a streamed body cannot be replayed, so it gets one attempt
+	retries := c.maxRetries
+	if stream != nil || !idempotent(req.method) {
+		retries = 0
+	}
+	for attempt := 0; ; attempt++ {
+		body := stream
+		if payload != nil {
+			body = bytes.NewReader(payload)
+		}
+		hreq, err := http.NewRequestWithContext(ctx, req.method, target, body)
+		if err != nil {
+			return nil, fmt.Errorf("dbclient: %w", err)
+		}
+		if contentType != "" {
+			hreq.Header.Set("Content-Type", contentType)
+		}
+		hreq.Header.Set("Accept", "application/json")
+		if req.basic != nil {
+			password, _ := req.basic.Password()
+			hreq.SetBasicAuth(req.basic.Username(), password)
+		} else if c.token != nil {
+			token, err := c.token(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("dbclient: token: %w", err)
+			}
+			hreq.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err := c.http.Do(hreq)
+		var wait time.Duration
+		switch {
+		case err != nil:
+			if ctx.Err() != nil || attempt >= retries {
+				return nil, fmt.Errorf("dbclient: %s %s: %w", req.method, req.path, err)
+			}
+		case resp.StatusCode >= 200 && resp.StatusCode < 300:
+			return resp, nil
+		default:
+			apiErr := readAPIError(resp, req)
+			if !retryable(resp.StatusCode) || attempt >= retries {
+				return nil, apiErr
+			}
+			wait = retryAfter(resp.Header.Get("Retry-After"))
+		}
+		if wait == 0 {
+			wait = c.delay(attempt)
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, fmt.Errorf("dbclient: %s %s: %w", req.method, req.path, ctx.Err())
+		case <-t.C:
+		}
+	}
+}
+
+// delay is the backoff before retry attempt+1: exponential with full
+// jitter, capped at maxBackoff.
+func (c *Client) delay(attempt int) time.Duration {
+	d := c.backoff << uint(attempt)
+	if d <= 0 || d > c.maxBackoff {
+		d = c.maxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(d)) + 1)
+}
+
+func retryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+func readAPIError(resp *http.Response, req request) *APIError {
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	msg := strings.TrimSpace(string(data))
+	var body struct {
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(data, &body) == nil && body.Error != "" {
+		msg = body.Error
+	}
+	return &APIError{StatusCode: resp.StatusCode, Method: req.method, Path: req.path, Message: msg}
+}
+
+// do performs req and decodes a JSON response into out, if not nil.
+func (c *Client) do(ctx context.Context, req request, out interface{}) error {
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if out == nil {
+		_, err := io.Copy(io.Discard, resp.Body)
+		return err
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("dbclient: %s %s: decode response: %w", req.method, req.path, err)
+	}
+	return nil
+}
+
+// route joins path segments, escaping each; ids may contain slashes or
+// other reserved characters.
+func route(segments ...string) string {
+	var b strings.Builder
+	for _, seg := range segments {
+		b.WriteByte('/')
+		b.WriteString(url.PathEscape(seg))
+	}
+	return b.String()
+}
@@ -0,0 +1,158 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a Client for srv that retries quickly.
+func newTestClient(t *testing.T, srv *httptest.Server, cfg Config) *Client {
+	t.Helper()
+	cfg.BaseURL = srv.URL
+	cfg.Backoff = time.Millisecond
+	cfg.MaxBackoff = time.Millisecond
+	c, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestNewNeedsAnAbsoluteURL(t *testing.T) {
+	for _, base := range []string{"", "localhost:3333", "/v1", "http://%zz"} {
+		if _, err := New(Config{BaseURL: base}); err == nil {
+			t.Errorf("base url %q was accepted", base)
+		}
+	}
+}
+
+// Idempotent calls are retried after a 5xx, with a fresh token each time.
+func TestRetriesIdempotentCalls(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		if got, want := r.Header.Get("Authorization"), fmt.Sprintf("Bearer t%d", n); got != want {
+			t.Errorf("attempt %d: Authorization = %q, want %q", n, got, want)
+		}
+		if r.URL.EscapedPath() != "/v1/users/a%2Fb" {
+			t.Errorf("path = %q, want the id escaped", r.URL.EscapedPath())
+		}
+		if n < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, `{"user_id": "a/b", "attrs": "{}"}`)
+	}))
+	defer srv.Close()
+
+	var tokens atomic.Int32
+	c := newTestClient(t, srv, Config{TokenSource: func(context.Context) (string, error) {
+		return fmt.Sprintf("t%d", tokens.Add(1)), nil
+	}})
+	u, err := c.GetUser(context.Background(), "a/b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.UserId != "a/b" || calls.Load() != 3 {
+		t.Fatalf("user %+v after %d calls", u, calls.Load())
+	}
+}
+
+func TestGivesUpAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+		io.WriteString(w, `{"error": "slow down"}`)
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv, Config{MaxRetries: 2})
+	_, err := c.GetPolicy(context.Background(), "p1")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests || apiErr.Message != "slow down" {
+		t.Fatalf("err = %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("%d calls, want 3", calls.Load())
+	}
+}
+
+// A POST may have taken effect before failing, so it is not retried; nor
+// is a 4xx.
+func TestDoesNotRetryUnsafeCalls(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"error": "policy not found"}`)
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv, Config{})
+	if _, err := c.CreatePolicy(context.Background(), Policy{Ref: "p1"}); err == nil || calls.Load() != 1 {
+		t.Fatalf("create: %v after %d calls", err, calls.Load())
+	}
+	calls.Store(0)
+	_, err := c.GetPolicy(context.Background(), "p1")
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrForbidden) || calls.Load() != 1 {
+		t.Fatalf("get: %v after %d calls", err, calls.Load())
+	}
+}
+
+func TestRetryStopsWithTheContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv, Config{})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := c.GetPolicy(ctx, "p1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want the context's deadline", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Error("the retry waited out Retry-After past the context deadline")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	if got := retryAfter("3"); got != 3*time.Second {
+		t.Errorf("retryAfter(3) = %v", got)
+	}
+	if got := retryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)); got <= 50*time.Second || got > time.Minute {
+		t.Errorf("retryAfter(date) = %v", got)
+	}
+	for _, v := range []string{"", "0", "-1", "soon", "Mon, 01 Jan 2001 00:00:00 GMT"} {
+		if got := retryAfter(v); got != 0 {
+			t.Errorf("retryAfter(%q) = %v, want 0", v, got)
+		}
+	}
+}
+
+func TestDelayIsCapped(t *testing.T) {
+	c, err := New(Config{BaseURL: "http://db", Backoff: 100 * time.Millisecond, MaxBackoff: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for attempt := 0; attempt < 70; attempt++ {
+		if d := c.delay(attempt); d <= 0 || d > time.Second {
+			t.Fatalf("delay(%d) = %v", attempt, d)
+		}
+	}
+}
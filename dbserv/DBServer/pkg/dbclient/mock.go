@@ -0,0 +1,1358 @@
+package dbclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mock is an in-memory API for tests. Writes are kept in maps and reads
+// answer from them with the errors the server would give: a missing row is
+// an APIError with status 404 (matching ErrNotFound), a duplicate key one
+// with status 500. Lists honour Limit, Order, Cursor and the prefix filter
+// only.
+//
+// Errors injects a failure: when it has an entry for a method name (e.g.
+// "GetUser"), that method returns the error without touching the store.
+// Calls records the method names in order. Audit, Webhooks and Deliveries
+// are served as set by the test. WatchChanges replays the changes the Mock
+// has recorded and returns; it does not block for new ones.
+//
+// The zero value is ready to use.
+type Mock struct {
+	mu sync.Mutex
+
+	Users       map[string]NewUser
+	Devices     map[string]NewDevice
+	Policies    map[string]Policy
+	Hierarchies map[string]Hierarchy
+	Grants      map[string]Grant
+	// Tokens holds every token passed to SubmitGrantToken. The Mock
+	// cannot verify them; inject an error to simulate a rejected one.
+	Tokens []string
+	// Issued holds every token minted by IssueGrantToken. The Mock applies
+	// no issuer limits; inject an error to simulate a refusal.
+	Issued []IssuedGrant
+	// History is returned by ListGrantHistory as given; the Mock has no
+	// sweeper.
+	History []ArchivedGrant
+	// Requests holds access requests in submission order. Approving one
+	// moves its grant's access date like the server does.
+	Requests []AccessRequest
+	// Roles keep their members in Members; Groups map a group to its
+	// users. GetUser does not merge role defaults into a user's attrs.
+	Roles  map[string]Role
+	Groups map[string][]string
+	// Objects is the object tree, with each object's Policies but not its
+	// Children, which are worked out on reads.
+	Objects map[string]ObjectNode
+	// Simulations holds every request passed to Simulate. The Mock cannot
+	// evaluate Rego: it returns Simulation when set, and otherwise reports
+	// each tuple as unchanged with no decisions.
+	Simulations []SimulateRequest
+	Simulation  *SimulateReport
+	// PolicyTests holds each policy's tests by ref. The Mock cannot run
+	// Rego: RunPolicyTests returns TestReports[ref] when set and otherwise
+	// a pass, and UpdatePolicy refuses with a 422 when TestReports[ref] has
+	// not passed.
+	PolicyTests map[string][]PolicyTest
+	TestReports map[string]PolicyTestReport
+
+	Audit      []AuditRecord
+	Webhooks   []WebhookEndpoint
+	Deliveries []WebhookDelivery
+	Changes    []ChangeEvent
+
+	Errors map[string]error
+	Calls  []string
+}
+
+var _ API = (*Mock)(nil)
+
+// call records name and returns the injected error for it, if any.
+func (m *Mock) call(name string) error {
+	m.Calls = append(m.Calls, name)
+	return m.Errors[name]
+}
+
+func mockNotFound(what string) error {
+	return &APIError{StatusCode: http.StatusNotFound, Method: "MOCK", Path: what, Message: what + " not found"}
+}
+
+func mockDuplicate(what string) error {
+	return &APIError{StatusCode: http.StatusInternalServerError, Method: "MOCK", Path: what, Message: "database error"}
+}
+
+func (m *Mock) changed(entity string, id string) {
+	var seq int64 = 1
+	if n := len(m.Changes); n > 0 {
+		seq = m.Changes[n-1].Seq + 1
+	}
+	m.Changes = append(m.Changes, ChangeEvent{Seq: seq, Ts: time.Now().UTC().Format(time.RFC3339Nano),
+		EntityType: ChangeEventEntityType(entity), EntityId: id, Version: seq})
+}
+
+// page returns the keys of one page: sorted, after opts.Cursor, with the
+// prefix filter applied, and the cursor for the next page.
+func page(keys []string, opts ListOptions) ([]string, *string) {
+	sort.Strings(keys)
+	if opts.Order == "desc" {
+		sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	prefix := opts.Filters["prefix"]
+	var out []string
+	for _, k := range keys {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		if opts.Cursor != "" {
+			if opts.Order == "desc" && k >= opts.Cursor || opts.Order != "desc" && k <= opts.Cursor {
+				continue
+			}
+		}
+		if len(out) == limit {
+			next := out[len(out)-1]
+			return out, &next
+		}
+		out = append(out, k)
+	}
+	return out, nil
+}
+
+func keysOf[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func grantKey(userID string, table string) string {
+	return userID + "/" + table
+}
+
+func (m *Mock) ListUsers(ctx context.Context, opts ListOptions) (*UserList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListUsers"); err != nil {
+		return nil, err
+	}
+	keys, next := page(keysOf(m.Users), opts)
+	out := &UserList{Items: []UserAttrs{}, NextCursor: next}
+	for _, k := range keys {
+		out.Items = append(out.Items, UserAttrs{UserId: k, Attrs: m.Users[k].Attrs})
+	}
+	return out, nil
+}
+
+func (m *Mock) CreateUser(ctx context.Context, user NewUser) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("CreateUser"); err != nil {
+		return 0, err
+	}
+	if _, ok := m.Users[user.UserId]; ok {
+		return 0, mockDuplicate("user " + user.UserId)
+	}
+	if m.Users == nil {
+		m.Users = map[string]NewUser{}
+	}
+	m.Users[user.UserId] = user
+	m.changed("user", user.UserId)
+	return 1, nil
+}
+
+func (m *Mock) GetUser(ctx context.Context, userID string) (*UserAttrs, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetUser"); err != nil {
+		return nil, err
+	}
+	u, ok := m.Users[userID]
+	if !ok {
+		return nil, mockNotFound("user " + userID)
+	}
+	return &UserAttrs{UserId: u.UserId, Attrs: u.Attrs}, nil
+}
+
+func (m *Mock) UpdateUserAttrs(ctx context.Context, userID string, attrs string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("UpdateUserAttrs"); err != nil {
+		return 0, err
+	}
+	u, ok := m.Users[userID]
+	if !ok {
+		return 0, nil
+	}
+	u.Attrs = attrs
+	m.Users[userID] = u
+	m.changed("user", userID)
+	return 1, nil
+}
+
+func (m *Mock) GetUserCheckInfo(ctx context.Context, userID string) (*UserCheckInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetUserCheckInfo"); err != nil {
+		return nil, err
+	}
+	u, ok := m.Users[userID]
+	if !ok {
+		return nil, mockNotFound("user " + userID)
+	}
+	return &UserCheckInfo{UserId: u.UserId, Password: u.Password}, nil
+}
+
+func (m *Mock) ListDevices(ctx context.Context, opts ListOptions) (*DeviceList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListDevices"); err != nil {
+		return nil, err
+	}
+	keys, next := page(keysOf(m.Devices), opts)
+	out := &DeviceList{Items: []DevInfo{}, NextCursor: next}
+	for _, k := range keys {
+		d := m.Devices[k]
+		out.Items = append(out.Items, DevInfo{DevId: d.DevId, DevType: d.DevType, Actions: d.Action, Attrs: d.Attrs})
+	}
+	return out, nil
+}
+
+func (m *Mock) CreateDevice(ctx context.Context, dev NewDevice) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("CreateDevice"); err != nil {
+		return 0, err
+	}
+	if _, ok := m.Devices[dev.DevId]; ok {
+		return 0, mockDuplicate("device " + dev.DevId)
+	}
+	if m.Devices == nil {
+		m.Devices = map[string]NewDevice{}
+	}
+	m.Devices[dev.DevId] = dev
+	m.changed("device", dev.DevId)
+	return 1, nil
+}
+
+func (m *Mock) device(name string, devID string) (NewDevice, error) {
+	if err := m.call(name); err != nil {
+		return NewDevice{}, err
+	}
+	d, ok := m.Devices[devID]
+	if !ok {
+		return d, mockNotFound("device " + devID)
+	}
+	return d, nil
+}
+
+func (m *Mock) GetDevice(ctx context.Context, devID string) (*DevAttrs, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	d, err := m.device("GetDevice", devID)
+	if err != nil {
+		return nil, err
+	}
+	return &DevAttrs{DevId: d.DevId, Attrs: d.Attrs}, nil
+}
+
+func (m *Mock) GetDeviceActions(ctx context.Context, devID string) (*DevActions, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	d, err := m.device("GetDeviceActions", devID)
+	if err != nil {
+		return nil, err
+	}
+	return &DevActions{DevId: d.DevId, Actions: d.Action}, nil
+}
+
+func (m *Mock) GetDeviceCheckInfo(ctx context.Context, devID string) (*DevCheckInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	d, err := m.device("GetDeviceCheckInfo", devID)
+	if err != nil {
+		return nil, err
+	}
+	return &DevCheckInfo{DevId: d.DevId, DevType: d.DevType, Token: d.Token}, nil
+}
+
+func (m *Mock) ListPolicies(ctx context.Context, opts ListOptions) (*PolicyList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListPolicies"); err != nil {
+		return nil, err
+	}
+	keys, next := page(keysOf(m.Policies), opts)
+	out := &PolicyList{Items: []Policy{}, NextCursor: next}
+	for _, k := range keys {
+		out.Items = append(out.Items, m.Policies[k])
+	}
+	return out, nil
+}
+
+func (m *Mock) CreatePolicy(ctx context.Context, p Policy) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("CreatePolicy"); err != nil {
+		return 0, err
+	}
+	if _, ok := m.Policies[p.Ref]; ok {
+		return 0, mockDuplicate("policy " + p.Ref)
+	}
+	if m.Policies == nil {
+		m.Policies = map[string]Policy{}
+	}
+	m.Policies[p.Ref] = p
+	m.changed("policy", p.Ref)
+	return 1, nil
+}
+
+func (m *Mock) GetPolicy(ctx context.Context, ref string) (*Policy, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetPolicy"); err != nil {
+		return nil, err
+	}
+	p, ok := m.Policies[ref]
+	if !ok {
+		return nil, mockNotFound("policy " + ref)
+	}
+	return &p, nil
+}
+
+func (m *Mock) UpdatePolicy(ctx context.Context, ref string, content string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("UpdatePolicy"); err != nil {
+		return 0, err
+	}
+	if _, ok := m.Policies[ref]; !ok {
+		return 0, nil
+	}
+	if r, ok := m.TestReports[ref]; ok && !r.Passed && len(m.PolicyTests[ref]) > 0 {
+		return 0, &APIError{StatusCode: http.StatusUnprocessableEntity, Method: "MOCK", Path: "policy " + ref, Message: "tests for policy " + ref + " failed"}
+	}
+	m.Policies[ref] = Policy{Ref: ref, Content: content}
+	m.changed("policy", ref)
+	return 1, nil
+}
+
+func (m *Mock) ListPolicyTests(ctx context.Context, ref string) ([]PolicyTest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListPolicyTests"); err != nil {
+		return nil, err
+	}
+	if _, ok := m.Policies[ref]; !ok {
+		return nil, mockNotFound("policy " + ref)
+	}
+	return append([]PolicyTest{}, m.PolicyTests[ref]...), nil
+}
+
+func (m *Mock) PutPolicyTest(ctx context.Context, ref string, name string, content string) (*PolicyTest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("PutPolicyTest"); err != nil {
+		return nil, err
+	}
+	if _, ok := m.Policies[ref]; !ok {
+		return nil, mockNotFound("policy " + ref)
+	}
+	if m.PolicyTests == nil {
+		m.PolicyTests = map[string][]PolicyTest{}
+	}
+	t := PolicyTest{PolicyRef: &ref, Name: &name, Content: content}
+	tests := m.PolicyTests[ref]
+	for i, have := range tests {
+		if deref(have.Name) == name {
+			tests[i] = t
+			return &t, nil
+		}
+	}
+	tests = append(tests, t)
+	sort.Slice(tests, func(i, j int) bool { return deref(tests[i].Name) < deref(tests[j].Name) })
+	m.PolicyTests[ref] = tests
+	return &t, nil
+}
+
+func (m *Mock) DeletePolicyTest(ctx context.Context, ref string, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("DeletePolicyTest"); err != nil {
+		return err
+	}
+	tests := m.PolicyTests[ref]
+	for i, have := range tests {
+		if deref(have.Name) == name {
+			m.PolicyTests[ref] = append(tests[:i:i], tests[i+1:]...)
+			return nil
+		}
+	}
+	return mockNotFound("policy test " + ref + "/" + name)
+}
+
+func (m *Mock) RunPolicyTests(ctx context.Context, ref string, content string) (*PolicyTestReport, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("RunPolicyTests"); err != nil {
+		return nil, err
+	}
+	if _, ok := m.Policies[ref]; !ok {
+		return nil, mockNotFound("policy " + ref)
+	}
+	if r, ok := m.TestReports[ref]; ok {
+		return &r, nil
+	}
+	return &PolicyTestReport{PolicyRef: ref, Passed: true, Results: []PolicyTestResult{}}, nil
+}
+
+func (m *Mock) ListHierarchies(ctx context.Context, opts ListOptions) (*HierarchyList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListHierarchies"); err != nil {
+		return nil, err
+	}
+	keys, next := page(keysOf(m.Hierarchies), opts)
+	out := &HierarchyList{Items: []Hierarchy{}, NextCursor: next}
+	for _, k := range keys {
+		out.Items = append(out.Items, m.Hierarchies[k])
+	}
+	return out, nil
+}
+
+func (m *Mock) CreateHierarchy(ctx context.Context, h Hierarchy) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("CreateHierarchy"); err != nil {
+		return 0, err
+	}
+	key := h.ObjId + "/" + h.Action
+	if _, ok := m.Hierarchies[key]; ok {
+		return 0, mockDuplicate("hierarchy " + key)
+	}
+	if m.Hierarchies == nil {
+		m.Hierarchies = map[string]Hierarchy{}
+	}
+	m.Hierarchies[key] = h
+	return 1, nil
+}
+
+func (m *Mock) GetHierarchy(ctx context.Context, objID string, action string) (*Hierarchy, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetHierarchy"); err != nil {
+		return nil, err
+	}
+	h, ok := m.Hierarchies[objID+"/"+action]
+	if !ok {
+		return nil, mockNotFound("hierarchy " + objID + "/" + action)
+	}
+	return &h, nil
+}
+
+func (m *Mock) UpdateHierarchy(ctx context.Context, objID string, action string, hierarchy string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("UpdateHierarchy"); err != nil {
+		return 0, err
+	}
+	key := objID + "/" + action
+	if _, ok := m.Hierarchies[key]; !ok {
+		return 0, nil
+	}
+	m.Hierarchies[key] = Hierarchy{ObjId: objID, Action: action, Hierarchy: hierarchy}
+	return 1, nil
+}
+
+func (m *Mock) ListGrants(ctx context.Context, opts ListOptions) (*GrantList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListGrants"); err != nil {
+		return nil, err
+	}
+	keys, next := page(keysOf(m.Grants), opts)
+	out := &GrantList{Items: []Grant{}, NextCursor: next}
+	for _, k := range keys {
+		out.Items = append(out.Items, m.Grants[k])
+	}
+	return out, nil
+}
+
+func (m *Mock) CreateGrant(ctx context.Context, g Grant) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("CreateGrant"); err != nil {
+		return 0, err
+	}
+	key := grantKey(g.UserId, g.TableName)
+	if _, ok := m.Grants[key]; ok {
+		return 0, mockDuplicate("grant " + key)
+	}
+	if m.Grants == nil {
+		m.Grants = map[string]Grant{}
+	}
+	m.Grants[key] = g
+	return 1, nil
+}
+
+func (m *Mock) GetGrant(ctx context.Context, userID string, table string) (*Grant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetGrant"); err != nil {
+		return nil, err
+	}
+	g, ok := m.Grants[grantKey(userID, table)]
+	if !ok {
+		return nil, mockNotFound("grant " + grantKey(userID, table))
+	}
+	return &g, nil
+}
+
+func (m *Mock) UpdateGrant(ctx context.Context, userID string, table string, u GrantUpdate) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("UpdateGrant"); err != nil {
+		return 0, err
+	}
+	if u.DbAccessDate == nil && u.DbDenyDate == nil {
+		return 0, &APIError{StatusCode: http.StatusBadRequest, Method: "MOCK", Path: "grant", Message: "db_access_date or db_deny_date is required"}
+	}
+	key := grantKey(userID, table)
+	g, ok := m.Grants[key]
+	if !ok {
+		return 0, nil
+	}
+	if u.DbAccessDate != nil {
+		g.DbAccessDate = *u.DbAccessDate
+	}
+	if u.DbDenyDate != nil {
+		g.DbDenyDate = *u.DbDenyDate
+	}
+	m.Grants[key] = g
+	return 1, nil
+}
+
+func (m *Mock) SubmitGrantToken(ctx context.Context, token string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("SubmitGrantToken"); err != nil {
+		return err
+	}
+	m.Tokens = append(m.Tokens, token)
+	return nil
+}
+
+func (m *Mock) ListGrantHistory(ctx context.Context, opts ListOptions) (*ArchivedGrantList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListGrantHistory"); err != nil {
+		return nil, err
+	}
+	return &ArchivedGrantList{Items: append([]ArchivedGrant{}, m.History...)}, nil
+}
+
+func (m *Mock) IssueGrantToken(ctx context.Context, req IssueGrantRequest) (*IssuedGrant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("IssueGrantToken"); err != nil {
+		return nil, err
+	}
+	pairs := make([]string, 0, len(req.Grants))
+	for _, g := range req.Grants {
+		pairs = append(pairs, g.TableName+":"+mockRule(g))
+	}
+	now := time.Now().UTC()
+	ttl := 600
+	if req.TtlSeconds != nil {
+		ttl = *req.TtlSeconds
+	}
+	jti := fmt.Sprintf("mock-%d", len(m.Issued)+1)
+	issued := IssuedGrant{Token: "mock." + jti, Jti: jti, Issuer: "mock", UserId: req.UserId, Sub: strings.Join(pairs, ","),
+		IssuedAt: now.Format(time.RFC3339), ExpiresAt: now.Add(time.Duration(ttl) * time.Second).Format(time.RFC3339)}
+	m.Issued = append(m.Issued, issued)
+	return &issued, nil
+}
+
+// mockRule renders a grant the way the server puts it in a token's sub.
+func mockRule(g GrantSpec) string {
+	switch {
+	case g.Always != nil && *g.Always:
+		return string(g.Decision) + " always"
+	case g.Once != nil && *g.Once:
+		return string(g.Decision) + " once"
+	case g.Days != nil:
+		return fmt.Sprintf("%s %d days", g.Decision, *g.Days)
+	}
+	return fmt.Sprintf("%s 0 days", g.Decision)
+}
+
+func (m *Mock) ListAccessRequests(ctx context.Context, opts ListOptions) (*AccessRequestList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListAccessRequests"); err != nil {
+		return nil, err
+	}
+	out := &AccessRequestList{Items: []AccessRequest{}}
+	for i := len(m.Requests) - 1; i >= 0; i-- {
+		r := m.Requests[i]
+		r.History = nil
+		out.Items = append(out.Items, r)
+	}
+	return out, nil
+}
+
+func (m *Mock) SubmitAccessRequest(ctx context.Context, user string, password string, req NewAccessRequest) (*AccessRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("SubmitAccessRequest"); err != nil {
+		return nil, err
+	}
+	if u, ok := m.Users[user]; !ok || u.Password != password {
+		return nil, &APIError{StatusCode: http.StatusUnauthorized, Method: "MOCK", Path: "access_request", Message: "a user name and password are required"}
+	}
+	if _, ok := m.Grants[grantKey(user, req.TableName)]; !ok {
+		return nil, &APIError{StatusCode: http.StatusBadRequest, Method: "MOCK", Path: "access_request", Message: "user has no grant row for this table"}
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	history := []AccessRequestEvent{{Ts: now, Actor: "mock", Action: "submitted", Comment: req.Reason}}
+	r := AccessRequest{Id: int64(len(m.Requests) + 1), UserId: user, TableName: req.TableName, Days: req.Days,
+		Reason: req.Reason, Status: "pending", CreatedAt: now, History: &history}
+	m.Requests = append(m.Requests, r)
+	return &r, nil
+}
+
+func (m *Mock) GetAccessRequest(ctx context.Context, id int64) (*AccessRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetAccessRequest"); err != nil {
+		return nil, err
+	}
+	if id <= 0 || id > int64(len(m.Requests)) {
+		return nil, mockNotFound(fmt.Sprintf("access request %d", id))
+	}
+	r := m.Requests[id-1]
+	return &r, nil
+}
+
+func (m *Mock) ApproveAccessRequest(ctx context.Context, id int64, comment string) (*AccessRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ApproveAccessRequest"); err != nil {
+		return nil, err
+	}
+	return m.decide(id, "approved", comment)
+}
+
+func (m *Mock) RejectAccessRequest(ctx context.Context, id int64, comment string) (*AccessRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("RejectAccessRequest"); err != nil {
+		return nil, err
+	}
+	return m.decide(id, "rejected", comment)
+}
+
+func (m *Mock) decide(id int64, status AccessRequestStatus, comment string) (*AccessRequest, error) {
+	if id <= 0 || id > int64(len(m.Requests)) {
+		return nil, mockNotFound(fmt.Sprintf("access request %d", id))
+	}
+	r := &m.Requests[id-1]
+	if r.Status != "pending" {
+		return nil, &APIError{StatusCode: http.StatusConflict, Method: "MOCK", Path: "access_request", Message: "access request has already been decided"}
+	}
+	now := time.Now().UTC()
+	by := "mock"
+	at := now.Format(time.RFC3339)
+	r.Status, r.DecidedBy, r.DecidedAt = status, &by, &at
+	ev := AccessRequestEvent{Ts: at, Actor: by, Action: AccessRequestAction(status)}
+	if comment != "" {
+		ev.Comment = &comment
+	}
+	history := append(*r.History, ev)
+	r.History = &history
+	if status == "approved" {
+		key := grantKey(r.UserId, r.TableName)
+		g := m.Grants[key]
+		g.DbAccessDate = now.AddDate(0, 0, r.Days).Format("2006-01-02")
+		m.Grants[key] = g
+	}
+	out := *r
+	return &out, nil
+}
+
+func (m *Mock) ListRoles(ctx context.Context, opts ListOptions) (*RoleList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListRoles"); err != nil {
+		return nil, err
+	}
+	keys, next := page(keysOf(m.Roles), opts)
+	out := &RoleList{Items: []Role{}, NextCursor: next}
+	for _, k := range keys {
+		r := m.Roles[k]
+		r.Grants, r.Members = nil, nil
+		out.Items = append(out.Items, r)
+	}
+	return out, nil
+}
+
+func (m *Mock) CreateRole(ctx context.Context, r Role) (*Role, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("CreateRole"); err != nil {
+		return nil, err
+	}
+	if _, ok := m.Roles[r.Name]; ok {
+		return nil, &APIError{StatusCode: http.StatusConflict, Method: "MOCK", Path: "role " + r.Name, Message: "role already exists"}
+	}
+	if m.Roles == nil {
+		m.Roles = map[string]Role{}
+	}
+	r.Members = nil
+	m.Roles[r.Name] = mockRole(r)
+	out := m.Roles[r.Name]
+	return &out, nil
+}
+
+func (m *Mock) GetRole(ctx context.Context, name string) (*Role, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetRole"); err != nil {
+		return nil, err
+	}
+	r, ok := m.Roles[name]
+	if !ok {
+		return nil, mockNotFound("role")
+	}
+	return &r, nil
+}
+
+func (m *Mock) UpdateRole(ctx context.Context, name string, r Role) (*Role, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("UpdateRole"); err != nil {
+		return nil, err
+	}
+	old, ok := m.Roles[name]
+	if !ok {
+		return nil, mockNotFound("role")
+	}
+	r.Name, r.Members = name, old.Members
+	m.Roles[name] = mockRole(r)
+	out := m.Roles[name]
+	return &out, nil
+}
+
+// mockRole fills in the defaults the server gives a saved role.
+func mockRole(r Role) Role {
+	now := time.Now().UTC().Format(time.RFC3339)
+	r.UpdatedAt = &now
+	if r.Grants != nil {
+		grants := append([]RoleGrant{}, *r.Grants...)
+		for i := range grants {
+			if grants[i].DbAccessDate == nil {
+				forever := "9999-12-31"
+				grants[i].DbAccessDate = &forever
+			}
+		}
+		r.Grants = &grants
+	}
+	return r
+}
+
+func (m *Mock) DeleteRole(ctx context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("DeleteRole"); err != nil {
+		return err
+	}
+	if _, ok := m.Roles[name]; !ok {
+		return mockNotFound("role")
+	}
+	delete(m.Roles, name)
+	return nil
+}
+
+func (m *Mock) AssignRole(ctx context.Context, name string, kind RoleMemberKind, id string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("AssignRole"); err != nil {
+		return 0, err
+	}
+	return m.assign(name, RoleMember{Kind: kind, Id: id}, true)
+}
+
+func (m *Mock) UnassignRole(ctx context.Context, name string, kind RoleMemberKind, id string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("UnassignRole"); err != nil {
+		return 0, err
+	}
+	return m.assign(name, RoleMember{Kind: kind, Id: id}, false)
+}
+
+func (m *Mock) assign(name string, member RoleMember, add bool) (int64, error) {
+	r, ok := m.Roles[name]
+	if !ok {
+		return 0, mockNotFound("role")
+	}
+	var members []RoleMember
+	if r.Members != nil {
+		members = *r.Members
+	}
+	for i, have := range members {
+		if have != member {
+			continue
+		}
+		if add {
+			return 0, nil
+		}
+		members = append(members[:i:i], members[i+1:]...)
+		r.Members = &members
+		m.Roles[name] = r
+		return 1, nil
+	}
+	if !add {
+		return 0, nil
+	}
+	members = append(members, member)
+	r.Members = &members
+	m.Roles[name] = r
+	return 1, nil
+}
+
+func (m *Mock) GetGroup(ctx context.Context, group string) (*GroupMembers, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetGroup"); err != nil {
+		return nil, err
+	}
+	users := m.Groups[group]
+	if len(users) == 0 {
+		return nil, mockNotFound("group")
+	}
+	members := append([]string{}, users...)
+	sort.Strings(members)
+	return &GroupMembers{Group: group, Members: members}, nil
+}
+
+func (m *Mock) AddGroupMember(ctx context.Context, group string, userID string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("AddGroupMember"); err != nil {
+		return 0, err
+	}
+	for _, u := range m.Groups[group] {
+		if u == userID {
+			return 0, nil
+		}
+	}
+	if m.Groups == nil {
+		m.Groups = map[string][]string{}
+	}
+	m.Groups[group] = append(m.Groups[group], userID)
+	return 1, nil
+}
+
+func (m *Mock) RemoveGroupMember(ctx context.Context, group string, userID string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("RemoveGroupMember"); err != nil {
+		return 0, err
+	}
+	users := m.Groups[group]
+	for i, u := range users {
+		if u == userID {
+			m.Groups[group] = append(users[:i:i], users[i+1:]...)
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (m *Mock) GetUserRoles(ctx context.Context, userID string) ([]UserRole, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetUserRoles"); err != nil {
+		return nil, err
+	}
+	out := []UserRole{}
+	for _, name := range keysOf(m.Roles) {
+		r := m.Roles[name]
+		if r.Members == nil {
+			continue
+		}
+		for _, member := range *r.Members {
+			switch {
+			case member.Kind == MemberUser && member.Id == userID:
+				out = append(out, UserRole{Role: name, Via: "user"})
+			case member.Kind == MemberGroup && contains(m.Groups[member.Id], userID):
+				out = append(out, UserRole{Role: name, Via: "group:" + member.Id})
+			}
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Role < out[j].Role })
+	return out, nil
+}
+
+func (m *Mock) ListObjects(ctx context.Context, opts ListOptions) (*ObjectList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListObjects"); err != nil {
+		return nil, err
+	}
+	keys, next := page(keysOf(m.Objects), opts)
+	out := &ObjectList{Items: []ObjectNode{}, NextCursor: next}
+	for _, k := range keys {
+		o := m.Objects[k]
+		o.Policies = nil
+		out.Items = append(out.Items, o)
+	}
+	return out, nil
+}
+
+func (m *Mock) CreateObject(ctx context.Context, o ObjectNode) (*ObjectNode, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("CreateObject"); err != nil {
+		return nil, err
+	}
+	if _, ok := m.Objects[o.ObjId]; ok {
+		return nil, &APIError{StatusCode: http.StatusConflict, Method: "MOCK", Path: "object " + o.ObjId, Message: "object already exists"}
+	}
+	if err := m.checkParent(o.ObjId, o.ParentId); err != nil {
+		return nil, err
+	}
+	if m.Objects == nil {
+		m.Objects = map[string]ObjectNode{}
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	o.UpdatedAt, o.Policies, o.Children = &now, nil, nil
+	m.Objects[o.ObjId] = o
+	return m.object(o.ObjId), nil
+}
+
+func (m *Mock) GetObject(ctx context.Context, objID string) (*ObjectNode, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetObject"); err != nil {
+		return nil, err
+	}
+	if _, ok := m.Objects[objID]; !ok {
+		return nil, mockNotFound("object")
+	}
+	return m.object(objID), nil
+}
+
+func (m *Mock) UpdateObject(ctx context.Context, objID string, o ObjectNode) (*ObjectNode, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("UpdateObject"); err != nil {
+		return nil, err
+	}
+	old, ok := m.Objects[objID]
+	if !ok {
+		return nil, mockNotFound("object")
+	}
+	if err := m.checkParent(objID, o.ParentId); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	old.ParentId, old.Kind, old.UpdatedAt = o.ParentId, o.Kind, &now
+	m.Objects[objID] = old
+	return m.object(objID), nil
+}
+
+func (m *Mock) DeleteObject(ctx context.Context, objID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("DeleteObject"); err != nil {
+		return err
+	}
+	if _, ok := m.Objects[objID]; !ok {
+		return mockNotFound("object")
+	}
+	if o := m.object(objID); o.Children != nil {
+		return &APIError{StatusCode: http.StatusConflict, Method: "MOCK", Path: "object " + objID, Message: "object has children"}
+	}
+	delete(m.Objects, objID)
+	return nil
+}
+
+func (m *Mock) SetObjectPolicies(ctx context.Context, objID string, action string, refs []string) (*ObjectNode, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("SetObjectPolicies"); err != nil {
+		return nil, err
+	}
+	o, ok := m.Objects[objID]
+	if !ok {
+		return nil, mockNotFound("object")
+	}
+	for _, ref := range refs {
+		if _, ok := m.Policies[ref]; !ok {
+			return nil, &APIError{StatusCode: http.StatusBadRequest, Method: "MOCK", Path: "object " + objID, Message: "policy " + ref + " does not exist"}
+		}
+	}
+	var policies []ObjectPolicy
+	if o.Policies != nil {
+		for _, p := range *o.Policies {
+			if p.Action != action {
+				policies = append(policies, p)
+			}
+		}
+	}
+	if len(refs) > 0 {
+		policies = append(policies, ObjectPolicy{Action: action, PolicyRefs: append([]string{}, refs...)})
+		sort.Slice(policies, func(i, j int) bool { return policies[i].Action < policies[j].Action })
+	}
+	o.Policies = nil
+	if len(policies) > 0 {
+		o.Policies = &policies
+	}
+	m.Objects[objID] = o
+	return m.object(objID), nil
+}
+
+func (m *Mock) GetPolicyChain(ctx context.Context, objID string, action string) (*PolicyChain, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetPolicyChain"); err != nil {
+		return nil, err
+	}
+	if _, ok := m.Objects[objID]; !ok {
+		return nil, mockNotFound("object")
+	}
+	var path []string
+	for cur := objID; cur != ""; cur = deref(m.Objects[cur].ParentId) {
+		path = append([]string{cur}, path...)
+	}
+	out := &PolicyChain{ObjId: objID, Action: action, Chain: []PolicyChainLink{}, PolicyRefs: []string{}}
+	for _, id := range path {
+		link := PolicyChainLink{ObjId: id, PolicyRefs: []string{}}
+		if ps := m.Objects[id].Policies; ps != nil {
+			for _, p := range *ps {
+				if p.Action == action {
+					link.PolicyRefs = append(link.PolicyRefs, p.PolicyRefs...)
+				}
+			}
+		}
+		for _, ref := range link.PolicyRefs {
+			if !contains(out.PolicyRefs, ref) {
+				out.PolicyRefs = append(out.PolicyRefs, ref)
+			}
+		}
+		out.Chain = append(out.Chain, link)
+	}
+	return out, nil
+}
+
+func (m *Mock) Simulate(ctx context.Context, req SimulateRequest, flippedOnly bool) (*SimulateReport, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("Simulate"); err != nil {
+		return nil, err
+	}
+	m.Simulations = append(m.Simulations, req)
+	if m.Simulation != nil {
+		out := *m.Simulation
+		if flippedOnly {
+			out.Results = []SimResult{}
+			for _, r := range m.Simulation.Results {
+				if r.Flipped || r.Error != nil {
+					out.Results = append(out.Results, r)
+				}
+			}
+		}
+		return &out, nil
+	}
+	out := &SimulateReport{Results: []SimResult{}}
+	for _, t := range req.Tuples {
+		out.Evaluated++
+		if !flippedOnly {
+			out.Results = append(out.Results, SimResult{Subject: t.Subject, Object: t.Object, Action: t.Action})
+		}
+	}
+	return out, nil
+}
+
+// checkParent refuses a missing parent and one that is objID or below it.
+func (m *Mock) checkParent(objID string, parent *string) error {
+	for cur := deref(parent); cur != ""; cur = deref(m.Objects[cur].ParentId) {
+		if cur == objID {
+			return &APIError{StatusCode: http.StatusBadRequest, Method: "MOCK", Path: "object " + objID, Message: "object tree would contain a cycle"}
+		}
+		if _, ok := m.Objects[cur]; !ok {
+			return &APIError{StatusCode: http.StatusBadRequest, Method: "MOCK", Path: "object " + objID, Message: "parent " + cur + " does not exist"}
+		}
+	}
+	return nil
+}
+
+// object returns a copy of an object with its children filled in.
+func (m *Mock) object(objID string) *ObjectNode {
+	o := m.Objects[objID]
+	var children []string
+	for id, child := range m.Objects {
+		if deref(child.ParentId) == objID {
+			children = append(children, id)
+		}
+	}
+	if len(children) > 0 {
+		sort.Strings(children)
+		o.Children = &children
+	}
+	return &o
+}
+
+func (m *Mock) ListAudit(ctx context.Context, opts ListOptions) (*AuditList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListAudit"); err != nil {
+		return nil, err
+	}
+	return &AuditList{Items: append([]AuditRecord{}, m.Audit...)}, nil
+}
+
+func (m *Mock) WatchChanges(ctx context.Context, opts WatchOptions, fn func(ChangeEvent) error) error {
+	m.mu.Lock()
+	if err := m.call("WatchChanges"); err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	events := append([]ChangeEvent{}, m.Changes...)
+	m.mu.Unlock()
+
+	for _, ev := range events {
+		if ev.Seq <= opts.Since {
+			continue
+		}
+		if len(opts.Entities) > 0 && !contains(opts.Entities, string(ev.EntityType)) {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Import stores JSON Lines rows through the Create methods. CSV is not
+// supported by the Mock.
+func (m *Mock) Import(ctx context.Context, entity string, format string, r io.Reader, dryRun bool) (*ImportReport, error) {
+	m.mu.Lock()
+	err := m.call("Import")
+	m.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if format != FormatJSONL {
+		return nil, fmt.Errorf("dbclient: mock import supports %s only", FormatJSONL)
+	}
+	report := &ImportReport{Entity: entity, Format: format, DryRun: dryRun, Errors: []ImportRowError{}}
+	var rows []func() (int64, error)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		report.Total++
+		var create func() (int64, error)
+		var err error
+		switch entity {
+		case EntityUsers:
+			var v NewUser
+			err = json.Unmarshal([]byte(line), &v)
+			create = func() (int64, error) { return m.CreateUser(ctx, v) }
+		case EntityDevices:
+			var v NewDevice
+			err = json.Unmarshal([]byte(line), &v)
+			create = func() (int64, error) { return m.CreateDevice(ctx, v) }
+		case EntityPolicies:
+			var v Policy
+			err = json.Unmarshal([]byte(line), &v)
+			create = func() (int64, error) { return m.CreatePolicy(ctx, v) }
+		case EntityHierarchies:
+			var v Hierarchy
+			err = json.Unmarshal([]byte(line), &v)
+			create = func() (int64, error) { return m.CreateHierarchy(ctx, v) }
+		case EntityGrants:
+			var v Grant
+			err = json.Unmarshal([]byte(line), &v)
+			create = func() (int64, error) { return m.CreateGrant(ctx, v) }
+		default:
+			return nil, &APIError{StatusCode: http.StatusBadRequest, Method: "MOCK", Path: "import", Message: "unknown entity " + entity}
+		}
+		if err != nil {
+			report.Failed++
+			report.Errors = append(report.Errors, ImportRowError{Row: report.Total, Error: err.Error()})
+			continue
+		}
+		report.Valid++
+		rows = append(rows, create)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if report.Failed > 0 {
+		return report, &APIError{StatusCode: http.StatusBadRequest, Method: "MOCK", Path: "import", Message: "rows failed validation"}
+	}
+	if dryRun {
+		return report, nil
+	}
+	for _, create := range rows {
+		if _, err := create(); err != nil {
+			return report, err
+		}
+	}
+	report.Committed = true
+	return report, nil
+}
+
+// Export writes the stored rows of entity as JSON Lines. CSV is not
+// supported by the Mock.
+func (m *Mock) Export(ctx context.Context, entity string, format string, w io.Writer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("Export"); err != nil {
+		return err
+	}
+	if format != FormatJSONL {
+		return fmt.Errorf("dbclient: mock export supports %s only", FormatJSONL)
+	}
+	var rows []interface{}
+	switch entity {
+	case EntityUsers:
+		for _, k := range sortedKeys(m.Users) {
+			rows = append(rows, m.Users[k])
+		}
+	case EntityDevices:
+		for _, k := range sortedKeys(m.Devices) {
+			rows = append(rows, m.Devices[k])
+		}
+	case EntityPolicies:
+		for _, k := range sortedKeys(m.Policies) {
+			rows = append(rows, m.Policies[k])
+		}
+	case EntityHierarchies:
+		for _, k := range sortedKeys(m.Hierarchies) {
+			rows = append(rows, m.Hierarchies[k])
+		}
+	case EntityGrants:
+		for _, k := range sortedKeys(m.Grants) {
+			rows = append(rows, m.Grants[k])
+		}
+	default:
+		return &APIError{StatusCode: http.StatusBadRequest, Method: "MOCK", Path: "export", Message: "unknown entity " + entity}
+	}
+	enc := json.NewEncoder(w)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := keysOf(m)
+	sort.Strings(keys)
+	return keys
+}
+
+func (m *Mock) ListWebhooks(ctx context.Context) ([]WebhookEndpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListWebhooks"); err != nil {
+		return nil, err
+	}
+	return append([]WebhookEndpoint{}, m.Webhooks...), nil
+}
+
+func (m *Mock) ListWebhookDeliveries(ctx context.Context, opts ListOptions) (*DeliveryList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("ListWebhookDeliveries"); err != nil {
+		return nil, err
+	}
+	return &DeliveryList{Items: append([]WebhookDelivery{}, m.Deliveries...)}, nil
+}
+
+func (m *Mock) GetWebhookDelivery(ctx context.Context, id int64) (*WebhookDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("GetWebhookDelivery"); err != nil {
+		return nil, err
+	}
+	for _, d := range m.Deliveries {
+		if d.Id == id {
+			return &d, nil
+		}
+	}
+	return nil, mockNotFound(fmt.Sprintf("delivery %d", id))
+}
+
+func (m *Mock) RetryWebhookDelivery(ctx context.Context, id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("RetryWebhookDelivery"); err != nil {
+		return err
+	}
+	for i, d := range m.Deliveries {
+		if d.Id == id && d.Status == "failed" {
+			m.Deliveries[i].Status = "pending"
+			return nil
+		}
+	}
+	return &APIError{StatusCode: http.StatusConflict, Method: "MOCK", Path: "retry", Message: "delivery is not failed"}
+}
+
+func (m *Mock) Ready(ctx context.Context) (*ReadyReport, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("Ready"); err != nil {
+		return nil, err
+	}
+	return &ReadyReport{Status: "ok", Checks: map[string]string{}}, nil
+}
+
+func (m *Mock) Status(ctx context.Context) (*StatusReport, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.call("Status"); err != nil {
+		return nil, err
+	}
+	return &StatusReport{Version: "mock", Config: map[string]string{}}, nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
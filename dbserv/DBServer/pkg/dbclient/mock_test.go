@@ -0,0 +1,58 @@
+package dbclient
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMockUsers(t *testing.T) {
+	ctx := context.Background()
+	var m Mock
+	for _, id := range []string{"carol", "alice", "bob", "dave"} {
+		if _, err := m.CreateUser(ctx, NewUser{UserId: id, Attrs: `{"team":"a"}`}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var apiErr *APIError
+	if _, err := m.CreateUser(ctx, NewUser{UserId: "bob"}); !errors.As(err, &apiErr) || apiErr.StatusCode != 500 {
+		t.Errorf("duplicate user: %v", err)
+	}
+	if _, err := m.GetUser(ctx, "erin"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("missing user: %v", err)
+	}
+
+	first, err := m.ListUsers(ctx, ListOptions{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Items) != 2 || first.Items[0].UserId != "alice" || first.NextCursor == nil {
+		t.Fatalf("first page = %+v", first)
+	}
+	rest, err := m.ListUsers(ctx, ListOptions{Limit: 2, Cursor: *first.NextCursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest.Items) != 2 || rest.Items[0].UserId != "carol" || rest.NextCursor != nil {
+		t.Fatalf("second page = %+v", rest)
+	}
+	if len(m.Changes) != 4 || m.Changes[3].Seq != 4 || m.Changes[3].EntityId != "dave" {
+		t.Errorf("changes = %+v", m.Changes)
+	}
+}
+
+func TestMockInjectedErrors(t *testing.T) {
+	ctx := context.Background()
+	boom := errors.New("boom")
+	m := Mock{Errors: map[string]error{"GetUser": boom}}
+	if _, err := m.CreateUser(ctx, NewUser{UserId: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.GetUser(ctx, "alice"); err != boom {
+		t.Errorf("GetUser = %v, want the injected error", err)
+	}
+	if want := []string{"CreateUser", "GetUser"}; !reflect.DeepEqual(m.Calls, want) {
+		t.Errorf("calls = %v, want %v", m.Calls, want)
+	}
+}
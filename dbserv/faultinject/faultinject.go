@@ -0,0 +1,229 @@
+// Package faultinject adds latency, errors and forced allow/deny decisions
+// to gin routes for load and reordering experiments. It replaces the sleeps
+// that used to sit in the servers' handlers and is off unless a rules file
+// is configured, so real traffic is not slowed.
+//
+// The rules file is a JSON array, for example:
+//
+//	[
+//	  {"route": "/find_uesr_attrs/:id", "latency": {"distribution": "normal", "mean_ms": 100, "stddev_ms": 30}},
+//	  {"route": "/find_db_access/:user_id/:table_name", "latency_rate": 0.5, "latency": {"distribution": "fixed", "mean_ms": 100}},
+//	  {"route": "*", "method": "POST", "error_rate": 0.01, "error_status": 503},
+//	  {"route": "/reorder_test/:count", "decision_rate": 1, "allow_ratio": 0.8}
+//	]
+//
+// The first rule whose route (the gin pattern, or "*") and method match a
+// request applies to it.
+package faultinject
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	LatencyFixed       = "fixed"
+	LatencyUniform     = "uniform"
+	LatencyNormal      = "normal"
+	LatencyExponential = "exponential"
+)
+
+type LatencySpec struct {
+	Distribution string  `json:"distribution"`
+	Min_ms       float64 `json:"min_ms,omitempty"`
+	Max_ms       float64 `json:"max_ms,omitempty"`
+	Mean_ms      float64 `json:"mean_ms,omitempty"`
+	Stddev_ms    float64 `json:"stddev_ms,omitempty"`
+}
+
+type Rule struct {
+	Route  string `json:"route"`
+	Method string `json:"method,omitempty"`
+	// Latency is added before the handler runs, to a Latency_rate share of
+	// requests (all of them when Latency_rate is 0).
+	Latency      *LatencySpec `json:"latency,omitempty"`
+	Latency_rate float64      `json:"latency_rate,omitempty"`
+	// Error_rate of requests are answered with Error_status (default 503)
+	// without reaching the handler.
+	Error_rate   float64 `json:"error_rate,omitempty"`
+	Error_status int     `json:"error_status,omitempty"`
+	// Decision_rate of requests carry a random decision, allowed with
+	// probability Allow_ratio, that the handler uses in place of its own
+	// (see Decision).
+	Decision_rate float64 `json:"decision_rate,omitempty"`
+	Allow_ratio   float64 `json:"allow_ratio,omitempty"`
+}
+
+// Load reads and validates a rules file.
+func Load(path string) ([]Rule, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rules []Rule
+	if err := json.Unmarshal(raw, &rules); err != nil {
+		return nil, fmt.Errorf("fault rules %s: %v", path, err)
+	}
+	for i := range rules {
+		if err := rules[i].validate(); err != nil {
+			return nil, fmt.Errorf("fault rules %s: rule %d: %v", path, i, err)
+		}
+	}
+	return rules, nil
+}
+
+func (r *Rule) validate() error {
+	if r.Route == "" {
+		return fmt.Errorf("route is required")
+	}
+	for name, rate := range map[string]float64{"latency_rate": r.Latency_rate, "error_rate": r.Error_rate,
+		"decision_rate": r.Decision_rate, "allow_ratio": r.Allow_ratio} {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("%s must be between 0 and 1", name)
+		}
+	}
+	if r.Error_status == 0 {
+		r.Error_status = http.StatusServiceUnavailable
+	}
+	if r.Error_status < 400 || r.Error_status > 599 {
+		return fmt.Errorf("error_status must be a 4xx or 5xx code")
+	}
+	if r.Latency == nil {
+		return nil
+	}
+	l := r.Latency
+	if l.Min_ms < 0 || l.Max_ms < 0 || l.Mean_ms < 0 || l.Stddev_ms < 0 {
+		return fmt.Errorf("latency values must not be negative")
+	}
+	switch l.Distribution {
+	case LatencyFixed, LatencyNormal, LatencyExponential:
+	case LatencyUniform:
+		if l.Max_ms < l.Min_ms {
+			return fmt.Errorf("latency max_ms is below min_ms")
+		}
+	default:
+		return fmt.Errorf("unknown latency distribution %q", l.Distribution)
+	}
+	if r.Latency_rate == 0 {
+		r.Latency_rate = 1
+	}
+	return nil
+}
+
+func (l LatencySpec) sample() time.Duration {
+	var ms float64
+	switch l.Distribution {
+	case LatencyUniform:
+		ms = l.Min_ms + rand.Float64()*(l.Max_ms-l.Min_ms)
+	case LatencyNormal:
+		ms = l.Mean_ms + rand.NormFloat64()*l.Stddev_ms
+	case LatencyExponential:
+		ms = rand.ExpFloat64() * l.Mean_ms
+	default:
+		ms = l.Mean_ms
+	}
+	if ms < 0 {
+		ms = 0
+	}
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+// Kinds of fault passed to Injector.Observe.
+const (
+	KindLatency  = "latency"
+	KindError    = "error"
+	KindDecision = "decision"
+)
+
+// Injector applies a set of rules.
+type Injector struct {
+	rules []Rule
+	// Observe, when set, is called for every fault added, with the
+	// request's context and route, for metrics and logging.
+	Observe func(ctx context.Context, route string, kind string)
+}
+
+// New returns an Injector for rules, which Load has validated.
+func New(rules []Rule) *Injector {
+	return &Injector{rules: rules}
+}
+
+// Len returns the number of rules; an Injector without rules does nothing.
+func (in *Injector) Len() int {
+	if in == nil {
+		return 0
+	}
+	return len(in.rules)
+}
+
+func (in *Injector) rule(method string, route string) *Rule {
+	for i := range in.rules {
+		r := &in.rules[i]
+		if (r.Route == "*" || r.Route == route) && (r.Method == "" || r.Method == method) {
+			return r
+		}
+	}
+	return nil
+}
+
+func (in *Injector) observe(ctx context.Context, route string, kind string) {
+	if in.Observe != nil {
+		in.Observe(ctx, route, kind)
+	}
+}
+
+type decisionKey struct{}
+
+// Decision returns the decision forced by a rule for the request that ctx
+// belongs to, if any. A forced decision is not a real permission check and
+// callers should not record it as one.
+func Decision(ctx context.Context) (allowed bool, ok bool) {
+	allowed, ok = ctx.Value(decisionKey{}).(bool)
+	return allowed, ok
+}
+
+// Middleware applies the rules to each request.
+func (in *Injector) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		rule := in.rule(c.Request.Method, route)
+		if rule == nil {
+			c.Next()
+			return
+		}
+		ctx := c.Request.Context()
+
+		if rule.Latency != nil && rand.Float64() < rule.Latency_rate {
+			delay := rule.Latency.sample()
+			in.observe(ctx, route, KindLatency)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				c.Abort()
+				return
+			}
+		}
+
+		if rand.Float64() < rule.Error_rate {
+			in.observe(ctx, route, KindError)
+			c.String(rule.Error_status, `{"server_message": "injected fault"}`)
+			c.Abort()
+			return
+		}
+
+		if rand.Float64() < rule.Decision_rate {
+			allowed := rand.Float64() < rule.Allow_ratio
+			in.observe(ctx, route, KindDecision)
+			c.Request = c.Request.WithContext(context.WithValue(ctx, decisionKey{}, allowed))
+		}
+
+		c.Next()
+	}
+}
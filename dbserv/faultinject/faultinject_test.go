@@ -0,0 +1,124 @@
+package faultinject
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func writeRules(t *testing.T, rules string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "faults.json")
+	if err := os.WriteFile(path, []byte(rules), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	rules, err := Load(writeRules(t, `[
+		{"route": "/a", "error_rate": 0.5},
+		{"route": "*", "latency": {"distribution": "uniform", "min_ms": 1, "max_ms": 2}}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules[0].Error_status != http.StatusServiceUnavailable || rules[1].Latency_rate != 1 {
+		t.Fatalf("defaults not applied: %+v", rules)
+	}
+
+	for _, bad := range []string{
+		`[{"error_rate": 0.5}]`,
+		`[{"route": "/a", "error_rate": 1.5}]`,
+		`[{"route": "/a", "error_rate": 1, "error_status": 200}]`,
+		`[{"route": "/a", "latency": {"distribution": "pareto"}}]`,
+		`[{"route": "/a", "latency": {"distribution": "uniform", "min_ms": 5, "max_ms": 1}}]`,
+		`{"route": "/a"}`,
+	} {
+		if _, err := Load(writeRules(t, bad)); err == nil {
+			t.Errorf("Load accepted %s", bad)
+		}
+	}
+}
+
+func TestLatencySample(t *testing.T) {
+	for _, l := range []LatencySpec{
+		{Distribution: LatencyFixed, Mean_ms: 3},
+		{Distribution: LatencyUniform, Min_ms: 1, Max_ms: 2},
+		{Distribution: LatencyNormal, Mean_ms: 1, Stddev_ms: 10},
+		{Distribution: LatencyExponential, Mean_ms: 1},
+	} {
+		for i := 0; i < 100; i++ {
+			if d := l.sample(); d < 0 {
+				t.Fatalf("%s sampled a negative delay %v", l.Distribution, d)
+			}
+		}
+	}
+	if d := (LatencySpec{Distribution: LatencyFixed, Mean_ms: 3}).sample(); d != 3*time.Millisecond {
+		t.Errorf("fixed latency %v, want 3ms", d)
+	}
+}
+
+func serve(in *Injector, method string, path string) (*httptest.ResponseRecorder, bool, bool) {
+	var decided, allowed bool
+	r := gin.New()
+	r.Use(in.Middleware())
+	handler := func(c *gin.Context) {
+		allowed, decided = Decision(c.Request.Context())
+		c.Status(http.StatusOK)
+	}
+	r.GET("/reorder_test/:count", handler)
+	r.POST("/reorder_test/:count", handler)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+	return w, decided, allowed
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var observed []string
+	in := New([]Rule{
+		{Route: "/reorder_test/:count", Method: http.MethodPost, Error_rate: 1, Error_status: http.StatusTeapot},
+		{Route: "/reorder_test/:count", Decision_rate: 1, Allow_ratio: 1},
+	})
+	in.Observe = func(_ context.Context, route string, kind string) { observed = append(observed, route+" "+kind) }
+
+	w, decided, _ := serve(in, http.MethodPost, "/reorder_test/1")
+	if w.Code != http.StatusTeapot || decided {
+		t.Errorf("POST: status %d, decided %v; want the injected error before the handler", w.Code, decided)
+	}
+	w, decided, allowed := serve(in, http.MethodGet, "/reorder_test/1")
+	if w.Code != http.StatusOK || !decided || !allowed {
+		t.Errorf("GET: status %d, decided %v, allowed %v; want a forced allow", w.Code, decided, allowed)
+	}
+	if len(observed) != 2 || observed[0] != "/reorder_test/:count "+KindError || observed[1] != "/reorder_test/:count "+KindDecision {
+		t.Errorf("observed %q", observed)
+	}
+
+	if New(nil).Len() != 0 || (*Injector)(nil).Len() != 0 {
+		t.Error("an injector without rules reports rules")
+	}
+}
+
+// A client that leaves during injected latency is not served.
+func TestMiddlewareLatencyHonoursCancellation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	in := New([]Rule{{Route: "*", Latency_rate: 1, Latency: &LatencySpec{Distribution: LatencyFixed, Mean_ms: 5000}}})
+	reached := false
+	r := gin.New()
+	r.Use(in.Middleware())
+	r.GET("/slow", func(c *gin.Context) { reached = true })
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/slow", nil).WithContext(ctx))
+	if reached || time.Since(start) > time.Second {
+		t.Fatalf("handler reached %v after %v", reached, time.Since(start))
+	}
+}
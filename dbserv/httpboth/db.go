@@ -3,6 +3,7 @@ package main
 import (
 	"RemoteTestServer/pkg/app"
 	"database/sql"
+	"faultinject"
 	"fmt"
 	"os"
 
@@ -50,6 +51,13 @@ func run() error {
 	router.Use(cors.Default())
 
 	server := app.NewServer(router, db)
+	if path := os.Getenv("FAULTS_FILE"); path != "" {
+		rules, err := faultinject.Load(path)
+		if err != nil {
+			return err
+		}
+		server.UseFaultRules(rules)
+	}
 
 	err = server.Run()
 
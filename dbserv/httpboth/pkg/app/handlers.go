@@ -3,12 +3,12 @@ package app
 import (
 	"database/sql"
 	"encoding/json"
+	"faultinject"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"math/rand"
 	"net/http"
 	"strconv"
-	"time"
 )
 
 type userAttrs struct {
@@ -54,8 +54,6 @@ func (s *Server) Query() gin.HandlerFunc {
 
 		fmt.Printf("attrs: %v\n", attributes.attrs)
 
-		time.Sleep(100 * time.Millisecond)
-
 		context.String(http.StatusOK, attributes.attrs)
 	}
 }
@@ -99,8 +97,6 @@ func (s *Server) QueryObj() gin.HandlerFunc {
 
 		fmt.Printf("attrs: %v\n", attributes.attrs)
 
-		time.Sleep(100 * time.Millisecond)
-
 		context.String(http.StatusOK, attributes.attrs)
 	}
 }
@@ -108,18 +104,20 @@ func (s *Server) QueryObj() gin.HandlerFunc {
 func (s *Server) ReorderTest() gin.HandlerFunc {
 	return func(context *gin.Context) {
 		context.Header("Content-Type", "application/json")
-		count, err := strconv.Atoi(context.Param("count"))
-		if err != nil {
+		if _, err := strconv.Atoi(context.Param("count")); err != nil {
 			fmt.Printf("count cannot convert to integer\n")
 			context.JSON(http.StatusBadRequest, nil)
 			return
 		}
 
-		rd := rand.Intn(2)
+		allowed, ok := faultinject.Decision(context.Request.Context())
+		if !ok {
+			allowed = rand.Intn(2) == 0
+		}
 
 		respContentMap := make(map[string]bool)
 
-		respContentMap["allowed"] = rd == 0
+		respContentMap["allowed"] = allowed
 
 		respContent, err := json.Marshal(respContentMap)
 
@@ -129,11 +127,6 @@ func (s *Server) ReorderTest() gin.HandlerFunc {
 			return
 		}
 
-		if count%200 >= 100 {
-			time.Sleep(100 * time.Millisecond)
-			count++
-		}
-
 		context.String(http.StatusOK, string(respContent))
 	}
 }
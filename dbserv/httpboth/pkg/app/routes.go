@@ -4,6 +4,9 @@ import "github.com/gin-gonic/gin"
 
 func (s *Server) Routes() *gin.Engine {
 	router := s.router
+	if s.faults.Len() > 0 {
+		router.Use(s.faults.Middleware())
+	}
 
 	// group all routes under /v1/api
 	v1 := router.Group("/columbia")
@@ -2,6 +2,7 @@ package app
 
 import (
 	"database/sql"
+	"faultinject"
 	"github.com/gin-gonic/gin"
 	"log"
 )
@@ -9,6 +10,7 @@ import (
 type Server struct {
 	router *gin.Engine
 	conn   *sql.DB
+	faults *faultinject.Injector
 }
 
 func NewServer(router *gin.Engine, conn *sql.DB) *Server {
@@ -27,3 +29,8 @@ func (s *Server) Run() error {
 
 	return nil
 }
+
+// UseFaultRules enables fault injection; call it before Run.
+func (s *Server) UseFaultRules(rules []faultinject.Rule) {
+	s.faults = faultinject.New(rules)
+}